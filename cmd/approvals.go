@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/spf13/cobra"
+)
+
+// Approvals command flags representatives
+var (
+	// --env flag
+	// UTILITY: environments to review (repeatable)
+	approvalEnvs []string
+
+	// --comment (-m) flag
+	// UTILITY: review comment
+	approvalComment string
+
+	// --run-id flag
+	// UTILITY: only list the approvals of a run
+	approvalRunID int64
+)
+
+// Command: approvals
+//
+// Example usage:
+//   - uniflow approvals list
+//   - uniflow approvals approve 123456789 --env production
+var approvalsCmd = &cobra.Command{
+	Use:   "approvals",
+	Short: "Review the runs waiting for an approval",
+	Long: `Review the runs waiting for an approval (eg: deployments to GitHub
+environments protected by required reviewers).
+
+Available subcommands:
+	list	 - List the runs waiting for an approval
+	approve	 - Approve the pending deployments of a run
+	reject	 - Reject the pending deployments of a run`,
+}
+
+// Command: approvals
+// subcommand: list
+//
+// Example usage:
+//   - uniflow approvals list deploy.yml
+var approvalsListCmd = &cobra.Command{
+	Use:   "list [workflow]",
+	Short: "List the runs waiting for an approval",
+	Long: `List the runs waiting for an approval, with their environment, reviewers and wait time.
+
+Example:
+	# Every waiting run
+	uniflow approvals list
+
+	# The waiting runs of a workflow
+	uniflow approvals list deploy.yml
+
+	# The pending environments of a run
+	uniflow approvals list --run-id 123456789`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runApprovalsList,
+}
+
+// Command: approvals
+// subcommand: approve
+//
+// Example usage:
+//   - uniflow approvals approve 123456789 --env production --comment "release v1.2.0"
+var approvalsApproveCmd = &cobra.Command{
+	Use:   "approve <run-id>",
+	Short: "Approve the pending deployments of a run",
+	Long: `Approve the pending deployments of a run, so it goes on.
+
+Without --env, the run must wait for a single environment.
+
+Example:
+	uniflow approvals approve 123456789 --env production --comment "release v1.2.0"
+
+	# Several environments at once
+	uniflow approvals approve 123456789 --env staging --env production`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runApprovalsReview(cmd, args, true)
+	},
+}
+
+// Command: approvals
+// subcommand: reject
+//
+// Example usage:
+//   - uniflow approvals reject 123456789 --env production --comment "wrong tag"
+var approvalsRejectCmd = &cobra.Command{
+	Use:   "reject <run-id>",
+	Short: "Reject the pending deployments of a run",
+	Long: `Reject the pending deployments of a run, the run fails.
+
+Without --env, the run must wait for a single environment.
+
+Example:
+	uniflow approvals reject 123456789 --env production --comment "wrong tag"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runApprovalsReview(cmd, args, false)
+	},
+}
+
+// Commands and subcommands configuration
+func init() {
+	approvalsCmd.PersistentFlags().StringVarP(&profileName, "profile", "p", "default", "Config profile to use")
+	approvalsCmd.PersistentFlags().StringVar(&platformFlag, "platform", "github", "Platform (github, jenkins, gitlab, circleci). Auto-detected by default")
+
+	approvalsListCmd.Flags().Int64Var(&approvalRunID, "run-id", 0, "Only list the approvals of a run")
+
+	for _, reviewCmd := range []*cobra.Command{approvalsApproveCmd, approvalsRejectCmd} {
+		reviewCmd.Flags().StringSliceVar(&approvalEnvs, "env", nil, "Environment to review (repeat it for several)")
+		reviewCmd.Flags().StringVarP(&approvalComment, "comment", "m", "", "Review comment")
+	}
+
+	// Subcommands: list, approve, reject
+	approvalsCmd.AddCommand(approvalsListCmd)
+	approvalsCmd.AddCommand(approvalsApproveCmd)
+	approvalsCmd.AddCommand(approvalsRejectCmd)
+
+	// Command: approvals
+	rootCmd.AddCommand(approvalsCmd)
+}
+
+// runApprovalsList lists the runs waiting for an approval
+func runApprovalsList(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	client, err := loadPlatformClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	req := &types.ListApprovalsRequest{RunID: approvalRunID}
+	if len(args) > 0 {
+		req.Name = args[0]
+	}
+
+	approvals, err := client.ListPendingApprovals(ctx, req)
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to list pending approvals.\n<?> Error: %w", err)
+	}
+
+	printApprovals(os.Stdout, approvals)
+
+	return nil
+}
+
+// runApprovalsReview approves or rejects the pending deployments of a run
+func runApprovalsReview(cmd *cobra.Command, args []string, approve bool) error {
+	id, err := parseRunID(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client, err := loadPlatformClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	environments := approvalEnvs
+	if len(environments) == 0 {
+		approvals, err := client.ListPendingApprovals(ctx, &types.ListApprovalsRequest{RunID: id})
+		if err != nil {
+			return fmt.Errorf("<?> Error: Failed to list pending approvals.\n<?> Error: %w", err)
+		}
+
+		if environments, err = singleEnvironment(id, approvals); err != nil {
+			return err
+		}
+	}
+
+	req := &types.ReviewApprovalRequest{RunID: id, Environments: environments, Approve: approve, Comment: approvalComment}
+	if err := client.ReviewApproval(ctx, req); err != nil {
+		return fmt.Errorf("<?> Error: Failed to review run %d.\n<?> Error: %w", id, err)
+	}
+
+	verb := "Rejected"
+	if approve {
+		verb = "Approved"
+	}
+
+	fmt.Printf("✓ %s %s for run %d\n", verb, strings.Join(environments, ", "), id)
+
+	return nil
+}
+
+// singleEnvironment is the environment a run waits for, when reviewed without --env
+func singleEnvironment(runID int64, approvals []*types.PendingApproval) ([]string, error) {
+	switch len(approvals) {
+	case 0:
+		return nil, fmt.Errorf("<?> Error: Run %d isn't waiting for an approval", runID)
+	case 1:
+		return []string{approvals[0].Environment}, nil
+	}
+
+	names := make([]string, 0, len(approvals))
+	for _, approval := range approvals {
+		names = append(names, approval.Environment)
+	}
+
+	return nil, fmt.Errorf("<?> Error: Run %d waits for several environments (%s), pick them with --env", runID, strings.Join(names, ", "))
+}
+
+// printApprovals shows the pending approvals, with the commands to review them
+func printApprovals(out io.Writer, approvals []*types.PendingApproval) {
+	if len(approvals) == 0 {
+		fmt.Fprintln(out, "</> Info: No run is waiting for an approval")
+		return
+	}
+
+	fmt.Fprintf(out, "❯ Pending approvals (%d)\n\n", len(approvals))
+
+	for _, approval := range approvals {
+		fmt.Fprintf(out, "   Run #%d: %s", approval.RunNumber, approval.WorkflowName)
+		if approval.Branch != "" {
+			fmt.Fprintf(out, " (%s)", approval.Branch)
+		}
+		fmt.Fprintln(out)
+
+		fmt.Fprintf(out, "   Environment: %s\n", approval.Environment)
+
+		if len(approval.Reviewers) > 0 {
+			fmt.Fprintf(out, "   Reviewers: %s\n", strings.Join(approval.Reviewers, ", "))
+		}
+
+		if !approval.WaitingSince.IsZero() {
+			fmt.Fprintf(out, "   Waiting for: %s\n", helpers.FormatWaitTime(approval.WaitingSince))
+		}
+
+		if approval.URL != "" {
+			fmt.Fprintf(out, "   View at: %s\n", approval.URL)
+		}
+
+		if approval.CanApprove {
+			fmt.Fprintf(out, "   Approve with: uniflow approvals approve %d --env %s\n", approval.RunID, approval.Environment)
+		} else {
+			fmt.Fprintln(out, "   <!> You aren't a reviewer of this environment")
+		}
+
+		fmt.Fprintln(out, strings.Repeat("─", 80))
+	}
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// Test approvals subcommands
+func TestApprovalsCmdSubcommands(t *testing.T) {
+	for _, name := range []string{"list", "approve", "reject"} {
+		found, _, err := approvalsCmd.Find([]string{name})
+		if err != nil || found.Name() != name {
+			t.Errorf("subcommand %s does not exist", name)
+		}
+	}
+
+	for _, name := range []string{"env", "comment"} {
+		if approvalsRejectCmd.Flags().Lookup(name) == nil {
+			t.Errorf("flag %s does not exist", name)
+		}
+	}
+}
+
+// Test reviewing without --env
+func TestSingleEnvironment(t *testing.T) {
+	environments, err := singleEnvironment(42, []*types.PendingApproval{{Environment: "production"}})
+	if err != nil || len(environments) != 1 || environments[0] != "production" {
+		t.Errorf("singleEnvironment() = %v, %v, want [production]", environments, err)
+	}
+
+	if _, err := singleEnvironment(42, nil); err == nil {
+		t.Error("singleEnvironment() without pending approval should fail")
+	}
+
+	_, err = singleEnvironment(42, []*types.PendingApproval{{Environment: "staging"}, {Environment: "production"}})
+	if err == nil || !strings.Contains(err.Error(), "staging, production") {
+		t.Errorf("singleEnvironment() error = %v, want the pending environments", err)
+	}
+}
+
+// Test printing the pending approvals
+func TestPrintApprovals(t *testing.T) {
+	var out bytes.Buffer
+	printApprovals(&out, []*types.PendingApproval{
+		{
+			RunID:        123456,
+			RunNumber:    47,
+			WorkflowName: "Deploy",
+			Branch:       "main",
+			Environment:  "production",
+			Reviewers:    []string{"octocat", "ignorant05/release-managers"},
+			CanApprove:   true,
+			WaitingSince: time.Now().Add(-12 * time.Minute),
+		},
+	})
+
+	for _, want := range []string{
+		"Pending approvals (1)",
+		"Run #47: Deploy (main)",
+		"Environment: production",
+		"Reviewers: octocat, ignorant05/release-managers",
+		"Waiting for: 12m",
+		"uniflow approvals approve 123456 --env production",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, out.String())
+		}
+	}
+
+	out.Reset()
+	printApprovals(&out, nil)
+	if !strings.Contains(out.String(), "No run is waiting for an approval") {
+		t.Errorf("output = %q, want no approvals", out.String())
+	}
+}
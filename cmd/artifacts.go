@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/spf13/cobra"
+)
+
+// Artifacts command flags representatives
+var (
+	// --name flag
+	// UTILITY: artifacts to download (repeatable)
+	artifactNames []string
+
+	// --dest flag
+	// UTILITY: directory the artifacts are downloaded to
+	artifactDest string
+
+	// --extract flag
+	// UTILITY: unpack the downloaded zips
+	artifactExtract bool
+
+	// --parallel flag
+	// UTILITY: maximum number of concurrent downloads
+	artifactParallel int
+)
+
+// Command: artifacts
+//
+// Example usage:
+//   - uniflow artifacts list 123456789
+//   - uniflow artifacts download 123456789 --name dist --extract
+var artifactsCmd = &cobra.Command{
+	Use:   "artifacts",
+	Short: "List and download the artifacts of a run",
+	Long: `List and download the artifacts of a run (eg: build outputs, test reports).
+
+Available subcommands:
+	list	 - List the artifacts of a run
+	download - Download the artifacts of a run`,
+}
+
+// Command: artifacts
+// subcommand: list
+//
+// Example usage:
+//   - uniflow artifacts list 123456789
+var artifactsListCmd = &cobra.Command{
+	Use:   "list <run-id>",
+	Short: "List the artifacts of a run",
+	Long: `List the artifacts of a run, with their size and expiry.
+
+Example:
+	uniflow artifacts list 123456789`,
+	Args: cobra.ExactArgs(1),
+	RunE: runArtifactsList,
+}
+
+// Command: artifacts
+// subcommand: download
+//
+// Example usage:
+//   - uniflow artifacts download 123456789 --name dist --dest ./out --extract
+var artifactsDownloadCmd = &cobra.Command{
+	Use:   "download <run-id>",
+	Short: "Download the artifacts of a run",
+	Long: `Download the artifacts of a run, in parallel.
+
+Each artifact is saved as <dest>/<name>.zip (or unpacked into <dest>/<name> with --extract).
+Without --name, every artifact of the run is downloaded (expired ones are skipped).
+
+Example:
+	# Every artifact of the run
+	uniflow artifacts download 123456789
+
+	# Unpack an artifact into ./out/dist
+	uniflow artifacts download 123456789 --name dist --dest ./out --extract
+
+	# Several artifacts
+	uniflow artifacts download 123456789 --name dist --name coverage`,
+	Args: cobra.ExactArgs(1),
+	RunE: runArtifactsDownload,
+}
+
+// Commands and subcommands configuration
+func init() {
+	artifactsCmd.PersistentFlags().StringVarP(&profileName, "profile", "p", "default", "Config profile to use")
+	artifactsCmd.PersistentFlags().StringVar(&platformFlag, "platform", "github", "Platform (github, jenkins, gitlab, circleci). Auto-detected by default")
+
+	artifactsDownloadCmd.Flags().StringSliceVar(&artifactNames, "name", nil, "Artifact to download (repeat it for several, default: all)")
+	artifactsDownloadCmd.Flags().StringVar(&artifactDest, "dest", ".", "Directory the artifacts are downloaded to")
+	artifactsDownloadCmd.Flags().BoolVar(&artifactExtract, "extract", false, "Unpack the downloaded zips")
+	artifactsDownloadCmd.Flags().IntVar(&artifactParallel, "parallel", 4, "Maximum number of concurrent downloads")
+
+	// Subcommands: list, download
+	artifactsCmd.AddCommand(artifactsListCmd)
+	artifactsCmd.AddCommand(artifactsDownloadCmd)
+
+	// Command: artifacts
+	rootCmd.AddCommand(artifactsCmd)
+}
+
+// runArtifactsList lists the artifacts of a run
+func runArtifactsList(cmd *cobra.Command, args []string) error {
+	id, err := parseRunID(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client, err := loadPlatformClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	artifacts, err := client.ListArtifacts(ctx, &types.ListArtifactsRequest{RunID: id})
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to list artifacts of run %d.\n<?> Error: %w", id, err)
+	}
+
+	printArtifacts(os.Stdout, id, artifacts)
+
+	return nil
+}
+
+// runArtifactsDownload downloads the artifacts of a run
+func runArtifactsDownload(cmd *cobra.Command, args []string) error {
+	id, err := parseRunID(args[0])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	client, err := loadPlatformClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	artifacts, err := client.ListArtifacts(ctx, &types.ListArtifactsRequest{RunID: id})
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to list artifacts of run %d.\n<?> Error: %w", id, err)
+	}
+
+	selected, skipped, err := selectArtifacts(id, artifacts, artifactNames)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range skipped {
+		fmt.Printf("<!> Warn:  Skipping expired artifact %s\n", name)
+	}
+
+	if len(selected) == 0 {
+		fmt.Printf("</> Info: Run %d has no artifact to download\n", id)
+		return nil
+	}
+
+	progress := helpers.NewDownloadProgress(os.Stdout, selected, helpers.IsInteractive())
+	req := types.DownloadArtifactRequest{RunID: id, Dest: artifactDest, Extract: artifactExtract}
+
+	results := platforms.DownloadArtifacts(ctx, client, req, selected, artifactParallel, progress.Update, func(result *platforms.ArtifactResult) {
+		var size int64
+		if result.Response != nil {
+			size = result.Response.Size
+		}
+
+		progress.Done(result.Artifact, size, result.Err)
+	})
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			continue
+		}
+
+		if result.Response.Files > 0 {
+			fmt.Printf("  %s: %d files in %s\n", result.Artifact.Name, result.Response.Files, result.Response.Path)
+		} else {
+			fmt.Printf("  %s: %s\n", result.Artifact.Name, result.Response.Path)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("<?> Error: Failed to download %d of %d artifacts", failed, len(results))
+	}
+
+	return nil
+}
+
+// selectArtifacts picks the artifacts to download (all the unexpired ones without names)
+// NOTE: explicitly named artifacts must exist and not be expired
+func selectArtifacts(runID int64, artifacts []*types.Artifact, names []string) ([]*types.Artifact, []string, error) {
+	var (
+		selected []*types.Artifact
+		skipped  []string
+	)
+
+	if len(names) == 0 {
+		for _, artifact := range artifacts {
+			if artifact.Expired {
+				skipped = append(skipped, artifact.Name)
+				continue
+			}
+
+			selected = append(selected, artifact)
+		}
+
+		return selected, skipped, nil
+	}
+
+	for _, name := range names {
+		idx := slices.IndexFunc(artifacts, func(artifact *types.Artifact) bool {
+			return artifact.Name == name
+		})
+
+		if idx < 0 {
+			available := make([]string, 0, len(artifacts))
+			for _, artifact := range artifacts {
+				available = append(available, artifact.Name)
+			}
+
+			return nil, nil, fmt.Errorf("<?> Error: Run %d has no artifact named %s (available: %s)", runID, name, strings.Join(available, ", "))
+		}
+
+		if artifacts[idx].Expired {
+			return nil, nil, fmt.Errorf("<?> Error: Artifact %s of run %d expired", name, runID)
+		}
+
+		if !slices.Contains(selected, artifacts[idx]) {
+			selected = append(selected, artifacts[idx])
+		}
+	}
+
+	return selected, nil, nil
+}
+
+// printArtifacts shows the artifacts of a run in columns
+func printArtifacts(out io.Writer, runID int64, artifacts []*types.Artifact) {
+	if len(artifacts) == 0 {
+		fmt.Fprintf(out, "</> Info: Run %d has no artifact\n", runID)
+		return
+	}
+
+	width := len("NAME")
+	for _, artifact := range artifacts {
+		width = max(width, len(artifact.Name))
+	}
+
+	fmt.Fprintf(out, "❯ Artifacts of run %d (%d)\n\n", runID, len(artifacts))
+	fmt.Fprintf(out, "   %-*s  %10s  %s\n", width, "NAME", "SIZE", "EXPIRES")
+
+	for _, artifact := range artifacts {
+		fmt.Fprintf(out, "   %-*s  %10s  %s\n", width, artifact.Name, helpers.FormatSize(artifact.Size), helpers.FormatExpiry(artifact.ExpiresAt, artifact.Expired))
+	}
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test artifacts subcommands and flags
+func TestArtifactsCmdSubcommands(t *testing.T) {
+	for _, name := range []string{"list", "download"} {
+		found, _, err := artifactsCmd.Find([]string{name})
+		if err != nil || found.Name() != name {
+			t.Errorf("subcommand %s does not exist", name)
+		}
+	}
+
+	tests := []struct {
+		flagName     string
+		defaultValue string
+	}{
+		{flagName: "name", defaultValue: "[]"},
+		{flagName: "dest", defaultValue: "."},
+		{flagName: "extract", defaultValue: "false"},
+		{flagName: "parallel", defaultValue: "4"},
+	}
+
+	for _, tt := range tests {
+		flag := artifactsDownloadCmd.Flags().Lookup(tt.flagName)
+		require.NotNil(t, flag, "flag %s does not exist", tt.flagName)
+		assert.Equal(t, tt.defaultValue, flag.DefValue)
+	}
+}
+
+// Testing the downloaded artifacts are picked by name, expired ones are skipped
+func TestSelectArtifacts(t *testing.T) {
+	dist := &types.Artifact{Name: "dist"}
+	coverage := &types.Artifact{Name: "coverage", Expired: true}
+	docs := &types.Artifact{Name: "docs"}
+	artifacts := []*types.Artifact{dist, coverage, docs}
+
+	selected, skipped, err := selectArtifacts(42, artifacts, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*types.Artifact{dist, docs}, selected)
+	assert.Equal(t, []string{"coverage"}, skipped)
+
+	selected, skipped, err = selectArtifacts(42, artifacts, []string{"docs", "docs"})
+	require.NoError(t, err)
+	assert.Equal(t, []*types.Artifact{docs}, selected)
+	assert.Empty(t, skipped)
+
+	_, _, err = selectArtifacts(42, artifacts, []string{"coverage"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expired")
+
+	_, _, err = selectArtifacts(42, artifacts, []string{"binaries"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dist, coverage, docs")
+}
+
+// Testing printing the artifacts of a run
+func TestPrintArtifacts(t *testing.T) {
+	var out bytes.Buffer
+	printArtifacts(&out, 42, []*types.Artifact{
+		{Name: "dist", Size: 3 << 20, ExpiresAt: time.Now().Add(72*time.Hour + time.Minute)},
+		{Name: "coverage-report", Size: 512, Expired: true},
+	})
+
+	for _, want := range []string{
+		"Artifacts of run 42 (2)",
+		"NAME",
+		"dist                 3.0 MB  in 3d",
+		"coverage-report       512 B  expired",
+	} {
+		assert.Contains(t, out.String(), want)
+	}
+
+	out.Reset()
+	printArtifacts(&out, 42, nil)
+	assert.Contains(t, out.String(), "Run 42 has no artifact")
+}
+
+// Testing the progress display prints a line per download without a terminal
+func TestDownloadProgress(t *testing.T) {
+	dist := &types.Artifact{Name: "dist", Size: 2048}
+	docs := &types.Artifact{Name: "docs", Size: 100}
+
+	var out bytes.Buffer
+	progress := helpers.NewDownloadProgress(&out, []*types.Artifact{dist, docs}, false)
+
+	progress.Update(dist, 1024)
+	assert.Empty(t, out.String())
+
+	progress.Done(dist, 2048, nil)
+	progress.Done(docs, 0, errors.New("connection reset"))
+
+	assert.Equal(t, "✓ dist (2.0 KB)\n✗ docs: connection reset\n", out.String())
+
+	// On a terminal, the bars are redrawn in place
+	out.Reset()
+	progress = helpers.NewDownloadProgress(&out, []*types.Artifact{dist, docs}, true)
+
+	progress.Update(dist, 1024)
+	progress.Done(docs, 100, nil)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[0], "dist  "+strings.Repeat("█", 15)+strings.Repeat("░", 15)+" 1.0 KB / 2.0 KB")
+	assert.True(t, strings.HasPrefix(lines[2], "\033[2A"), "the second draw should move up: %q", lines[2])
+	assert.Contains(t, lines[3], "docs  "+strings.Repeat("█", 30)+" ✓ 100 B")
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/internal/cache"
+	"github.com/spf13/cobra"
+)
+
+// Cache command flags representatives
+var (
+	// --max-size flag
+	// UTILITY: size the cache is pruned to
+	cacheMaxSize string
+
+	// --all flag
+	// UTILITY: empty the cache
+	cacheAll bool
+)
+
+// Command: cache
+//
+// Example usage:
+//   - uniflow cache stats
+//   - uniflow cache prune --max-size 100MB
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the logs cache",
+	Long: `Manage the logs cache (~/.uniflow/cache/logs).
+
+The logs of completed runs never change: logs, logs search and logs --failed
+serve them from the cache, so they also work offline.
+The least recently used logs are evicted when the cache grows too big.
+
+Available subcommands:
+	stats	 - Show the cache size and entries
+	prune	 - Evict the least recently used logs`,
+}
+
+// Command: cache
+// subcommand: stats
+//
+// Example usage:
+//   - uniflow cache stats
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show the cache size and entries",
+	Args:  cobra.NoArgs,
+	RunE:  runCacheStats,
+}
+
+// Command: cache
+// subcommand: prune
+//
+// Example usage:
+//   - uniflow cache prune --max-size 100MB
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Evict the least recently used logs",
+	Long: `Evict the least recently used logs until the cache fits in --max-size.
+
+Example:
+	# Fit in the default size
+	uniflow cache prune
+
+	# Fit in 100MB
+	uniflow cache prune --max-size 100MB
+
+	# Empty the cache
+	uniflow cache prune --all`,
+	Args: cobra.NoArgs,
+	RunE: runCachePrune,
+}
+
+// Commands and subcommands configuration
+func init() {
+	cachePruneCmd.Flags().StringVar(&cacheMaxSize, "max-size", "", "Size to fit in (eg: 100MB, default: the cache limit)")
+	cachePruneCmd.Flags().BoolVar(&cacheAll, "all", false, "Empty the cache")
+
+	// Subcommands: stats, prune
+	cacheCmd.AddCommand(cacheStatsCmd)
+	cacheCmd.AddCommand(cachePruneCmd)
+
+	// Command: cache
+	rootCmd.AddCommand(cacheCmd)
+}
+
+// runCacheStats shows the cache size and entries
+func runCacheStats(cmd *cobra.Command, args []string) error {
+	store, err := cache.NewLogsCache()
+	if err != nil {
+		return err
+	}
+
+	stats, err := store.Stats()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("❯ Logs cache: %s\n", store.Dir)
+	fmt.Printf("  Entries:      %d\n", stats.Entries)
+	fmt.Printf("  Size:         %s / %s\n", helpers.FormatSize(stats.Size), helpers.FormatSize(stats.MaxSize))
+
+	if stats.Entries > 0 {
+		fmt.Printf("  Oldest use:   %s\n", helpers.FormatTime(stats.Oldest))
+		fmt.Printf("  Latest use:   %s\n", helpers.FormatTime(stats.Newest))
+	}
+
+	return nil
+}
+
+// runCachePrune evicts the least recently used logs
+func runCachePrune(cmd *cobra.Command, args []string) error {
+	store, err := cache.NewLogsCache()
+	if err != nil {
+		return err
+	}
+
+	maxSize := store.MaxSize
+	switch {
+	case cacheAll:
+		maxSize = 0
+	case cacheMaxSize != "":
+		if maxSize, err = helpers.ParseSize(cacheMaxSize); err != nil {
+			return err
+		}
+	}
+
+	removed, freed, err := store.Prune(maxSize)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Evicted %d entries (%s freed)\n", removed, helpers.FormatSize(freed))
+
+	return nil
+}
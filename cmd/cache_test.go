@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+)
+
+// Test cache subcommands
+func TestCacheCmdSubcommands(t *testing.T) {
+	for _, name := range []string{"stats", "prune"} {
+		found, _, err := cacheCmd.Find([]string{name})
+		if err != nil || found.Name() != name {
+			t.Errorf("subcommand %s does not exist", name)
+		}
+	}
+}
+
+// Test parsing sizes
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		size    string
+		want    int64
+		wantErr bool
+	}{
+		{size: "512", want: 512},
+		{size: "100K", want: 100 << 10},
+		{size: "64MB", want: 64 << 20},
+		{size: "1.5g", want: 3 << 29},
+		{size: "lots", wantErr: true},
+		{size: "-1M", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.size, func(t *testing.T) {
+			got, err := helpers.ParseSize(tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSize(%q) error = %v, wantErr %v", tt.size, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseSize(%q) = %d, want %d", tt.size, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/internal/config"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/spf13/cobra"
+)
+
+// cancel command flags
+var (
+	// --yes (-y)
+	// UTILITY: skip the confirmation of runs on protected branches
+	assumeYes bool
+
+	// --workflow (-w)
+	// UTILITY: workflow of the run (the job for jenkins, shared with rerun)
+	runWorkflow string
+)
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel <run-id>",
+	Short: "Cancel a running workflow run",
+	Long: `Cancel stops a queued or running workflow run.
+
+Runs of protected branches (or of the default branch, for platforms
+without branch protection) are only cancelled once confirmed.
+
+Example:
+	uniflow cancel 123456789
+
+	# Skip the confirmation (required without a terminal)
+	uniflow cancel 123456789 --yes
+
+	# Wait for the run to be cancelled
+	uniflow cancel 123456789 --wait
+
+	# Cancel a build of another jenkins job than the configured one
+	uniflow cancel 42 --platform jenkins --workflow nightly
+
+Exit codes (with --wait):
+	0  cancelled (or succeeded before it could be)
+	1  uniflow error
+	2  failure
+	4  timeout`,
+	Args: cobra.ExactArgs(1),
+	Run:  runCancelCmd,
+}
+
+func init() {
+	cancelCmd.Flags().StringVarP(&profileName, "profile", "p", "default", "Config profile to use")
+	cancelCmd.Flags().StringVar(&platformFlag, "platform", "github", "Platform (github, jenkins, gitlab, circleci). Auto-detected by default")
+	cancelCmd.Flags().StringVarP(&runWorkflow, "workflow", "w", "", "Workflow of the run (the job for jenkins, defaults to the configured job_name)")
+	cancelCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "Don't ask before cancelling runs of protected branches")
+	cancelCmd.Flags().BoolVar(&waitForRun, "wait", false, "Wait for the run to complete")
+	cancelCmd.Flags().DurationVar(&waitTimeout, "timeout", constants.DEFAULT_WAIT_TIMEOUT, "Maximum waiting time with --wait (0 waits forever)")
+
+	rootCmd.AddCommand(cancelCmd)
+}
+
+// cancel command main function
+func runCancelCmd(cmd *cobra.Command, args []string) {
+	id, err := parseRunID(args[0])
+	if err != nil {
+		errorhandling.HandleError(err)
+	}
+
+	ctx := context.Background()
+	cfg, err := config.Load()
+	if err != nil {
+		errorhandling.HandleError(err)
+	}
+
+	client, _, err := newPlatformClient(ctx, cmd, cfg)
+	if err != nil {
+		errorhandling.HandleError(err)
+	}
+
+	status, err := client.GetStatus(ctx, &types.StatusRequest{Name: runWorkflow, RunID: id})
+	if err != nil {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to get the status of run %d.\n<?> Error: %w", id, err))
+	}
+
+	if types.IsCompleted(status.Status) {
+		fmt.Printf("</> Info: Run #%d is already completed: %s\n", status.RunNumber, helpers.FormatConclusion(status.Conclusion))
+		return
+	}
+
+	prompter := helpers.NewPrompter(os.Stdin, os.Stdout)
+	confirmed, err := confirmProtectedCancel(ctx, client, prompter, status, assumeYes, helpers.IsInteractive())
+	if err != nil {
+		errorhandling.HandleError(err)
+	}
+
+	if !confirmed {
+		fmt.Println("</> Info: Run not cancelled.")
+		return
+	}
+
+	if err := client.Cancel(ctx, &types.Run{RunID: id, Branch: status.Branch, WorkflowName: runWorkflow}); err != nil {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to cancel run %d.\n<?> Error: %w", id, err))
+	}
+
+	fmt.Printf("✓ Cancellation of run #%d requested\n", status.RunNumber)
+	if status.URL != "" {
+		fmt.Printf("   View at: %s\n", status.URL)
+	}
+
+	if waitForRun {
+		os.Exit(waitForRunCompletion(ctx, client, &types.StatusRequest{Name: runWorkflow, RunID: id}, waitTimeout, helpers.CancelExitCode))
+	}
+}
+
+// confirmProtectedCancel asks before cancelling a run of a protected branch
+// NOTE: when the protection can't be checked, the branch is considered protected
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - prompter: prompter of the confirmation
+//   - status: status of the run
+//   - yes: skips the confirmation (--yes)
+//   - interactive: whether the confirmation can be asked
+//
+// Errors possible causes:
+//   - protected branch without a terminal (and without --yes)
+//   - prompt input closed
+func confirmProtectedCancel(ctx context.Context, client platforms.PlatformClient, prompter *helpers.Prompter, status *types.Status, yes, interactive bool) (bool, error) {
+	if yes || status.Branch == "" {
+		return true, nil
+	}
+
+	protected, err := isProtectedBranch(ctx, client, status.Branch)
+	if err != nil {
+		fmt.Fprintf(prompter.Out, "<!> Warn:  Couldn't check the protection of %s: %v\n", status.Branch, err)
+		protected = true
+	}
+
+	if !protected {
+		return true, nil
+	}
+
+	if !interactive {
+		return false, fmt.Errorf("<?> Error: Run #%d is on the protected branch %s, use --yes to cancel it", status.RunNumber, status.Branch)
+	}
+
+	return prompter.Confirm(fmt.Sprintf("Run #%d is on the protected branch %s, cancel it?", status.RunNumber, status.Branch))
+}
+
+// isProtectedBranch tells whether a branch is protected (the default branch, for platforms without branch protection)
+func isProtectedBranch(ctx context.Context, client platforms.PlatformClient, branch string) (bool, error) {
+	if checker, ok := client.(platforms.BranchProtectionChecker); ok {
+		return checker.IsProtectedBranch(ctx, branch)
+	}
+
+	info, err := client.GetRepositoryInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return info.DefaultBranch == branch, nil
+}
+
+// parseRunID parses the run ID argument of the run commands
+func parseRunID(arg string) (int64, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("<?> Error: Invalid run ID: %s", arg)
+	}
+
+	return id, nil
+}
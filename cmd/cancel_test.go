@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// protectionClient tells protected branches (or only the default branch, without checker)
+type protectionClient struct {
+	platforms.PlatformClient
+
+	defaultBranch string
+}
+
+func (c *protectionClient) GetRepositoryInfo(ctx context.Context) (*types.RepositoryInfo, error) {
+	return &types.RepositoryInfo{DefaultBranch: c.defaultBranch}, nil
+}
+
+// checkerClient checks the branch protection
+type checkerClient struct {
+	protectionClient
+
+	protected map[string]bool
+	err       error
+}
+
+func (c *checkerClient) IsProtectedBranch(ctx context.Context, branch string) (bool, error) {
+	return c.protected[branch], c.err
+}
+
+// Test cancel and rerun flags
+func TestCancelRerunFlags(t *testing.T) {
+	tests := []struct {
+		name         string
+		flagName     string
+		defaultValue string
+	}{
+		{name: "cancel yes flag", flagName: "yes", defaultValue: "false"},
+		{name: "cancel wait flag", flagName: "wait", defaultValue: "false"},
+		{name: "cancel timeout flag", flagName: "timeout", defaultValue: "30m0s"},
+		{name: "rerun failed-only flag", flagName: "failed-only", defaultValue: "false"},
+		{name: "rerun debug flag", flagName: "debug", defaultValue: "false"},
+		{name: "rerun wait flag", flagName: "wait", defaultValue: "false"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command := cancelCmd
+			if strings.HasPrefix(tt.name, "rerun") {
+				command = rerunCmd
+			}
+
+			flag := command.Flags().Lookup(tt.flagName)
+			require.NotNil(t, flag, "flag %s does not exist", tt.flagName)
+			assert.Equal(t, tt.defaultValue, flag.DefValue)
+		})
+	}
+}
+
+// Testing the run ID argument
+func TestParseRunID(t *testing.T) {
+	id, err := parseRunID("123456789")
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456789), id)
+
+	for _, arg := range []string{"abc", "0", "-3", "#12"} {
+		_, err := parseRunID(arg)
+		assert.Error(t, err, arg)
+	}
+}
+
+// Testing runs of protected branches are only cancelled once confirmed
+func TestConfirmProtectedCancel(t *testing.T) {
+	checker := &checkerClient{protectionClient: protectionClient{defaultBranch: "main"}, protected: map[string]bool{"release": true}}
+
+	tests := []struct {
+		name        string
+		client      platforms.PlatformClient
+		branch      string
+		answers     string
+		yes         bool
+		interactive bool
+		confirmed   bool
+		asked       bool
+		err         bool
+	}{
+		{name: "unprotected branch", client: checker, branch: "feature", interactive: true, confirmed: true},
+		{name: "protected branch confirmed", client: checker, branch: "release", answers: "y\n", interactive: true, confirmed: true, asked: true},
+		{name: "protected branch declined", client: checker, branch: "release", answers: "\n", interactive: true, asked: true},
+		{name: "protected branch with --yes", client: checker, branch: "release", yes: true, confirmed: true},
+		{name: "protected branch without terminal", client: checker, branch: "release", err: true},
+		{name: "default branch without checker", client: &protectionClient{defaultBranch: "main"}, branch: "main", answers: "yes\n", interactive: true, confirmed: true, asked: true},
+		{name: "other branch without checker", client: &protectionClient{defaultBranch: "main"}, branch: "feature", confirmed: true},
+		{name: "unknown branch", client: checker, confirmed: true},
+		{name: "protection not checked", client: &checkerClient{err: errors.New("forbidden")}, branch: "feature", answers: "n\n", interactive: true, asked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			prompter := helpers.NewPrompter(strings.NewReader(tt.answers), &out)
+
+			status := &types.Status{RunID: 42, RunNumber: 7, Branch: tt.branch}
+			confirmed, err := confirmProtectedCancel(context.Background(), tt.client, prompter, status, tt.yes, tt.interactive)
+
+			if tt.err {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "--yes")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.confirmed, confirmed)
+			assert.Equal(t, tt.asked, strings.Contains(out.String(), "cancel it?"))
+		})
+	}
+}
+
+// Testing cancelled runs are the expected outcome of cancel --wait
+func TestCancelExitCode(t *testing.T) {
+	assert.Equal(t, 0, helpers.CancelExitCode("cancelled"))
+	assert.Equal(t, 0, helpers.CancelExitCode("success"))
+	assert.Equal(t, 2, helpers.CancelExitCode("failure"))
+}
@@ -153,8 +153,7 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 }
 
-// runConfigList lists all profiles for a specific platform
-// NOTE: for now it only works for github
+// runConfigList lists the configured platforms of a profile
 func runConfigList(cmd *cobra.Command, args []string) error {
 	// Loading configuration
 	cfg, err := config.Load()
@@ -162,6 +161,15 @@ func runConfigList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if helpers.IsStructuredOutput(outputFormat) {
+		platforms, err := configPlatforms(cfg, profileFlag)
+		if err != nil {
+			return err
+		}
+
+		return helpers.WriteOutput(cmd.OutOrStdout(), outputFormat, platforms, outputColumns)
+	}
+
 	fmt.Printf("</> Info: Configuration (Profile: %s)\n", profileFlag)
 	fmt.Println(strings.Repeat("─", 60))
 
@@ -174,12 +182,27 @@ func runConfigList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// if github is configured for this profile
-	if profile.Github != nil {
-		fmt.Println("\nGitHub:")
-		fmt.Printf("  Base URL:     %s\n", profile.Github.BaseURL)
-		fmt.Printf("  Default Repository: %s\n", helpers.ValueOrEmpty(profile.Github.DefaultRepository))
-		fmt.Printf("  Token:        %s\n", helpers.MaskSecret(profile.Github.Token, showSecrets, force))
+	// the configured platforms of this profile
+	for _, section := range config.Sections() {
+		decoded, err := profile.Section(section)
+		if err != nil {
+			fmt.Printf("\n<!> Warn:  Invalid %s section: %v\n", section.Title, err)
+			continue
+		}
+
+		if decoded == nil {
+			continue
+		}
+
+		fmt.Printf("\n%s:\n", section.Title)
+		for _, field := range section.Fields(decoded) {
+			value := helpers.ValueOrEmpty(field.Value)
+			if field.Secret && field.Value != "" {
+				value = helpers.MaskSecret(field.Value, showSecrets, force)
+			}
+
+			fmt.Printf("  %-22s %s\n", field.Name+":", value)
+		}
 	}
 
 	fmt.Println()
@@ -188,6 +211,62 @@ func runConfigList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// configPlatform is a configured platform of a profile (config list --output)
+type configPlatform struct {
+	Profile  string `json:"profile"`
+	Platform string `json:"platform"`
+	Default  bool   `json:"default"`
+
+	// Settings are the fields of the platform section, by name (secrets are masked)
+	Settings map[string]string `json:"settings"`
+}
+
+// configPlatforms lists the configured platforms of a profile, the secrets are masked (see --show-secrets)
+//
+// Parameters:
+//   - cfg: configuration settings struct
+//   - name: profile name
+//
+// Errors possible causes:
+//   - unknown profile
+//   - invalid platform section
+func configPlatforms(cfg *config.Config, name string) ([]*configPlatform, error) {
+	profile, err := cfg.GetProfile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	platforms := []*configPlatform{}
+	for _, section := range config.Sections() {
+		decoded, err := profile.Section(section)
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Invalid %s section.\n<?> Error: %w", section.Name, err)
+		}
+
+		if decoded == nil {
+			continue
+		}
+
+		entry := &configPlatform{
+			Profile:  name,
+			Platform: section.Name,
+			Default:  section.Name == cfg.DefaultPlatform,
+			Settings: make(map[string]string),
+		}
+
+		for _, field := range section.Fields(decoded) {
+			entry.Settings[field.Name] = field.Value
+			if field.Secret {
+				entry.Settings[field.Name] = helpers.MaskSecret(field.Value, showSecrets, force)
+			}
+		}
+
+		platforms = append(platforms, entry)
+	}
+
+	return platforms, nil
+}
+
 // runConfigSet sets field depending on user input
 func runConfigSet(cmd *cobra.Command, args []string) error {
 	// Verify args length (we need two at a time)
@@ -315,21 +394,21 @@ func getProfileNames(cfg *config.Config) []string {
 //   - invalid field
 //   - invalid platform
 func getPlatformFileValue(profile *config.Profile, platform, field string) (string, error) {
-	switch platform {
-	case constants.GITHUB:
-		{
-			switch field {
-			case constants.TOKEN_FIELD:
-				return profile.Github.Token, nil
-			case constants.DEFAULT_REPOSITORY_FIELD:
-				return profile.Github.DefaultRepository, nil
-			case constants.BASE_URL_FIELD:
-				return profile.Github.BaseURL, nil
-			default:
-				return "", fmt.Errorf("<?> Error: Invalid field: %s", field)
-			}
+	section, ok := config.LookupSection(platform)
+	if !ok {
+		return "", fmt.Errorf("<?> Error: Unknown platform: %s", platform)
+	}
+
+	decoded, err := profile.GetPlatform(platform)
+	if err != nil {
+		return "", err
+	}
+
+	for _, sectionField := range section.Fields(decoded) {
+		if sectionField.Name == field {
+			return sectionField.Value, nil
 		}
 	}
 
-	return "", fmt.Errorf("<?> Error: Unknown platform: %s", platform)
+	return "", fmt.Errorf("<?> Error: Invalid field: %s", field)
 }
@@ -0,0 +1,18 @@
+package constants
+
+import "time"
+
+// Default dashboard values
+const (
+	// DEFAULT_DASHBOARD_INTERVAL is the polling interval while runs are active (or changing)
+	DEFAULT_DASHBOARD_INTERVAL = 5 * time.Second
+
+	// DASHBOARD_MAX_INTERVAL is the polling interval the dashboard backs off to when the runs are idle
+	DASHBOARD_MAX_INTERVAL = time.Minute
+
+	// DASHBOARD_REDRAW_INTERVAL refreshes the elapsed times between two polls
+	DASHBOARD_REDRAW_INTERVAL = time.Second
+
+	// DEFAULT_DASHBOARD_LIMIT is the default number of listed runs
+	DEFAULT_DASHBOARD_LIMIT = 15
+)
@@ -0,0 +1,25 @@
+package constants
+
+// logs --failed
+const (
+	// DEFAULT_FAILED_TAIL is the number of lines shown per failed step (without --tail)
+	DEFAULT_FAILED_TAIL = 50
+)
+
+// logs --format and --report values
+const (
+	// LOGS_FORMAT_TEXT prints the logs (colorized unless --no-color)
+	LOGS_FORMAT_TEXT = "text"
+
+	// LOGS_FORMAT_JSONL prints one JSON object per line
+	LOGS_FORMAT_JSONL = "jsonl"
+
+	// LOGS_FORMAT_HTML prints a self-contained HTML page
+	LOGS_FORMAT_HTML = "html"
+
+	// LOGS_REPORT_JUNIT writes the job and step conclusions as a JUnit XML report
+	LOGS_REPORT_JUNIT = "junit"
+
+	// DEFAULT_REPORT_FILE is the default file of --report
+	DEFAULT_REPORT_FILE = "junit.xml"
+)
@@ -0,0 +1,16 @@
+package constants
+
+// --output formats
+const (
+	// OUTPUT_TEXT is the human readable text (default)
+	OUTPUT_TEXT = "text"
+
+	// OUTPUT_JSON is an indented json document
+	OUTPUT_JSON = "json"
+
+	// OUTPUT_YAML is a yaml document (same schema as OUTPUT_JSON)
+	OUTPUT_YAML = "yaml"
+
+	// OUTPUT_TABLE is a plain table, one row per item (see --columns)
+	OUTPUT_TABLE = "table"
+)
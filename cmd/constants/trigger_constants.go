@@ -0,0 +1,27 @@
+package constants
+
+import "time"
+
+// trigger --wait exit codes
+const (
+	// EXIT_SUCCESS the run succeeded (or was skipped)
+	EXIT_SUCCESS = 0
+
+	// EXIT_ERROR uniflow failed (configuration, api, unidentified run, ...)
+	EXIT_ERROR = 1
+
+	// EXIT_FAILURE the run failed
+	EXIT_FAILURE = 2
+
+	// EXIT_CANCELLED the run was cancelled
+	EXIT_CANCELLED = 3
+
+	// EXIT_TIMEOUT the run didn't complete within --timeout
+	EXIT_TIMEOUT = 4
+)
+
+// Default trigger values
+const (
+	// DEFAULT_WAIT_TIMEOUT is the default maximum waiting time of trigger --wait
+	DEFAULT_WAIT_TIMEOUT = 30 * time.Minute
+)
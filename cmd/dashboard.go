@@ -0,0 +1,681 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/spf13/cobra"
+)
+
+// Dashboard command flags representatives
+var (
+	// --interval flag
+	// UTILITY: polling interval while runs are active
+	dashboardInterval time.Duration
+
+	// --limit flag
+	// UTILITY: number of listed runs
+	dashboardLimit int
+)
+
+// Command: dashboard
+//
+// Example usage:
+//   - uniflow dashboard
+//   - uniflow dashboard deploy.yml --interval 10s
+var dashboardCmd = &cobra.Command{
+	Use:   "dashboard [workflow]",
+	Short: "Live view of the active and recent runs",
+	Long: `Dashboard shows a full-screen, continuously refreshing view of the active and
+recent runs (of every workflow, or of one), with the progress of their jobs.
+
+Polling backs off (up to 1m) while no run is active, and speeds up again on changes.
+
+Keys:
+	↑/↓ (k/j)	 - Select a run
+	enter (l)	 - Show the logs of the selected run (follows active runs)
+	c		 - Cancel the selected run
+	r		 - Rerun the selected run
+	f		 - Rerun the failed jobs of the selected run
+	q (esc)		 - Quit
+
+Example:
+	uniflow dashboard
+
+	# The runs of a workflow, polled every 10s
+	uniflow dashboard deploy.yml --interval 10s
+
+	# Same view
+	uniflow status --watch`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runDashboard(cmd, args, dashboardLimit, dashboardInterval)
+	},
+}
+
+// Commands configuration
+func init() {
+	dashboardCmd.Flags().StringVarP(&profileName, "profile", "p", "default", "Config profile to use")
+	dashboardCmd.Flags().StringVar(&platformFlag, "platform", "github", "Platform (github, jenkins, gitlab, circleci). Auto-detected by default")
+	dashboardCmd.Flags().DurationVar(&dashboardInterval, "interval", constants.DEFAULT_DASHBOARD_INTERVAL, "Polling interval while runs are active")
+	dashboardCmd.Flags().IntVar(&dashboardLimit, "limit", constants.DEFAULT_DASHBOARD_LIMIT, "Number of listed runs")
+
+	// Command: dashboard
+	rootCmd.AddCommand(dashboardCmd)
+}
+
+// dashboardAction is a key action waiting for a confirmation
+type dashboardAction struct {
+	key string
+	run *types.Run
+}
+
+// dashboardSnapshot is the outcome of a poll
+type dashboardSnapshot struct {
+	runs []*types.Run
+	jobs map[int64][]*types.WorkflowJob
+	err  error
+}
+
+// dashboardCommand tells the event loop what to do after a key
+type dashboardCommand int
+
+const (
+	dashboardNone dashboardCommand = iota
+	dashboardRefresh
+	dashboardLogs
+	dashboardQuit
+)
+
+// dashboard is the state of the dashboard
+type dashboard struct {
+	client     platforms.PlatformClient
+	repository string
+	workflow   string
+	limit      int
+
+	runs []*types.Run
+
+	// jobs of the listed runs, the jobs of completed runs are only fetched once
+	jobs map[int64][]*types.WorkflowJob
+
+	// selected is the ID of the selected run (kept across polls)
+	selected int64
+
+	confirm *dashboardAction
+	message string
+	err     error
+
+	refreshedAt time.Time
+	base        time.Duration
+	interval    time.Duration
+}
+
+// newDashboard creates the dashboard state
+func newDashboard(client platforms.PlatformClient, repository, workflow string, limit int, interval time.Duration) *dashboard {
+	if interval <= 0 {
+		interval = constants.DEFAULT_DASHBOARD_INTERVAL
+	}
+
+	return &dashboard{
+		client:     client,
+		repository: repository,
+		workflow:   workflow,
+		limit:      limit,
+		jobs:       map[int64][]*types.WorkflowJob{},
+		base:       interval,
+		interval:   interval,
+	}
+}
+
+// runDashboard runs the dashboard until q (or Ctrl+C)
+//
+// Parameters:
+//   - cmd: the command (profile and platform flags)
+//   - args: optional workflow
+//   - limit: number of listed runs
+//   - interval: polling interval while runs are active
+//
+// Errors possible causes:
+//   - not a terminal
+//   - cannot create the client
+func runDashboard(cmd *cobra.Command, args []string, limit int, interval time.Duration) error {
+	if !helpers.IsInteractive() {
+		return fmt.Errorf("<?> Error: The dashboard needs a terminal (use uniflow status instead)")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := loadPlatformClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	owner, repo := client.GetRepository(ctx)
+
+	workflow := ""
+	if len(args) > 0 {
+		workflow = args[0]
+	}
+
+	d := newDashboard(client, fmt.Sprintf("%s/%s", owner, repo), workflow, limit, interval)
+
+	restore, err := helpers.MakeRaw(os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	screen := helpers.NewScreen(os.Stdout)
+	screen.Enter()
+
+	defer func() {
+		screen.Leave()
+		_ = restore()
+	}()
+
+	keys := readKeys(os.Stdin)
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(sigChan)
+
+	updates := make(chan *dashboardSnapshot, 1)
+	fetching := false
+
+	poll := func() {
+		if fetching {
+			return
+		}
+
+		fetching = true
+		known := d.completedJobs()
+
+		go func() {
+			updates <- d.fetch(ctx, known)
+		}()
+	}
+
+	draw := func() {
+		width, height, err := helpers.TerminalSize(os.Stdout)
+		if err != nil || width <= 0 || height <= 0 {
+			width, height = 80, 24
+		}
+
+		screen.Draw(d.render(width, height, time.Now(), fetching), width, height)
+	}
+
+	poll()
+
+	timer := time.NewTimer(d.interval)
+	timer.Stop()
+	defer timer.Stop()
+
+	ticker := time.NewTicker(constants.DASHBOARD_REDRAW_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		draw()
+
+		select {
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+
+			switch d.handleKey(ctx, key) {
+			case dashboardQuit:
+				return nil
+			case dashboardRefresh:
+				d.interval = d.base
+				poll()
+			case dashboardLogs:
+				screen.Leave()
+				_ = restore()
+
+				d.showLogs(keys)
+
+				if restore, err = helpers.MakeRaw(os.Stdin); err != nil {
+					return err
+				}
+
+				screen.Enter()
+				poll()
+			}
+		case snapshot := <-updates:
+			fetching = false
+			d.apply(snapshot)
+			timer.Reset(d.interval)
+		case <-timer.C:
+			poll()
+		case <-ticker.C:
+		case <-sigChan:
+			return nil
+		}
+	}
+}
+
+// readKeys reads the keys of a raw terminal (the channel is closed when the input is)
+func readKeys(in *os.File) <-chan string {
+	keys := make(chan string, 16)
+
+	go func() {
+		defer close(keys)
+
+		buf := make([]byte, 64)
+		for {
+			n, err := in.Read(buf)
+			if err != nil {
+				return
+			}
+
+			for _, key := range helpers.ParseKeys(buf[:n]) {
+				keys <- key
+			}
+		}
+	}()
+
+	return keys
+}
+
+// showLogs prints the logs of the selected run (following it while active), until enter is pressed
+func (d *dashboard) showLogs(keys <-chan string) {
+	run := d.selectedRun()
+	if run == nil {
+		return
+	}
+
+	follow := !types.IsCompleted(run.Status)
+	fmt.Printf("❯ %s run #%d: %s (%s)\n\n", map[bool]string{true: "Streaming", false: "Viewing"}[follow], run.RunNumber, d.runName(run), run.Branch)
+
+	streamReq := &types.LogsStreamRequest{RunID: run.RunID, Follow: follow, WorkflowName: run.WorkflowName}
+	if err := streamPlatformLogs(d.client, streamReq, helpers.LogRenderOptions{}); err != nil {
+		fmt.Println(err)
+	}
+
+	// the keys typed while streaming aren't meant for the dashboard
+	for len(keys) > 0 {
+		<-keys
+	}
+
+	fmt.Print("\n</> Info: Press enter to return to the dashboard")
+	<-keys
+}
+
+// fetch polls the runs, and the jobs of the runs without known jobs (or still active)
+func (d *dashboard) fetch(ctx context.Context, known map[int64]bool) *dashboardSnapshot {
+	runs, err := d.client.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{WorkflowName: d.workflow, Limit: d.limit})
+	if err != nil {
+		return &dashboardSnapshot{err: err}
+	}
+
+	snapshot := &dashboardSnapshot{runs: runs, jobs: map[int64][]*types.WorkflowJob{}}
+	for _, run := range runs {
+		if known[run.RunID] && types.IsCompleted(run.Status) {
+			continue
+		}
+
+		jobs, err := d.client.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{RunID: run.RunID})
+		if err != nil {
+			// the progress of the run is unknown until the next poll
+			continue
+		}
+
+		snapshot.jobs[run.RunID] = jobs
+	}
+
+	return snapshot
+}
+
+// completedJobs is the set of runs whose jobs won't change anymore
+func (d *dashboard) completedJobs() map[int64]bool {
+	known := map[int64]bool{}
+	for _, run := range d.runs {
+		if _, ok := d.jobs[run.RunID]; ok && types.IsCompleted(run.Status) {
+			known[run.RunID] = true
+		}
+	}
+
+	return known
+}
+
+// apply updates the state with a poll, and backs off the polling while the runs are idle
+func (d *dashboard) apply(snapshot *dashboardSnapshot) {
+	d.err = snapshot.err
+	if snapshot.err != nil {
+		d.interval = nextDashboardInterval(d.interval, d.base, false, false)
+		return
+	}
+
+	changed := runsChanged(d.runs, snapshot.runs)
+	active := false
+
+	jobs := make(map[int64][]*types.WorkflowJob, len(snapshot.runs))
+	for _, run := range snapshot.runs {
+		active = active || !types.IsCompleted(run.Status)
+
+		if runJobs, ok := snapshot.jobs[run.RunID]; ok {
+			jobs[run.RunID] = runJobs
+		} else if runJobs, ok := d.jobs[run.RunID]; ok {
+			jobs[run.RunID] = runJobs
+		}
+	}
+
+	d.runs = snapshot.runs
+	d.jobs = jobs
+	d.refreshedAt = time.Now()
+	d.interval = nextDashboardInterval(d.interval, d.base, active, changed)
+
+	if d.selectedRun() == nil && len(d.runs) > 0 {
+		d.selected = d.runs[0].RunID
+	}
+}
+
+// nextDashboardInterval is the polling interval after a poll:
+// the base interval while runs are active or changing, doubled (up to DASHBOARD_MAX_INTERVAL) otherwise
+func nextDashboardInterval(current, base time.Duration, active, changed bool) time.Duration {
+	if active || changed {
+		return base
+	}
+
+	return min(max(current*2, base), max(constants.DASHBOARD_MAX_INTERVAL, base))
+}
+
+// runsChanged checks if runs were added, removed or changed status between two polls
+func runsChanged(previous, current []*types.Run) bool {
+	if len(previous) != len(current) {
+		return true
+	}
+
+	for idx := range current {
+		if previous[idx].RunID != current[idx].RunID ||
+			previous[idx].Status != current[idx].Status ||
+			previous[idx].Conclusion != current[idx].Conclusion {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectedRun is the selected run (nil without runs)
+func (d *dashboard) selectedRun() *types.Run {
+	for _, run := range d.runs {
+		if run.RunID == d.selected {
+			return run
+		}
+	}
+
+	return nil
+}
+
+// move selects the run offset rows away from the selected one
+func (d *dashboard) move(offset int) {
+	if len(d.runs) == 0 {
+		return
+	}
+
+	idx := 0
+	for i, run := range d.runs {
+		if run.RunID == d.selected {
+			idx = i
+		}
+	}
+
+	idx = min(max(idx+offset, 0), len(d.runs)-1)
+	d.selected = d.runs[idx].RunID
+}
+
+// handleKey applies a key (actions on runs are confirmed with y)
+func (d *dashboard) handleKey(ctx context.Context, key string) dashboardCommand {
+	if d.confirm != nil {
+		action := d.confirm
+		d.confirm = nil
+
+		if key != "y" && key != "Y" {
+			d.message = "</> Info: Nothing done"
+			return dashboardNone
+		}
+
+		return d.perform(ctx, action)
+	}
+
+	d.message = ""
+
+	switch key {
+	case "q", helpers.KEY_ESC, helpers.KEY_CTRL_C:
+		return dashboardQuit
+	case helpers.KEY_UP, "k":
+		d.move(-1)
+	case helpers.KEY_DOWN, "j":
+		d.move(1)
+	case helpers.KEY_ENTER, "l":
+		if d.selectedRun() != nil {
+			return dashboardLogs
+		}
+	case "c", "r", "f":
+		d.ask(key)
+	}
+
+	return dashboardNone
+}
+
+// ask asks the confirmation of an action on the selected run
+func (d *dashboard) ask(key string) {
+	run := d.selectedRun()
+	if run == nil {
+		return
+	}
+
+	completed := types.IsCompleted(run.Status)
+
+	switch {
+	case key == "c" && completed:
+		d.message = fmt.Sprintf("</> Info: Run #%d is already completed", run.RunNumber)
+		return
+	case key != "c" && !completed:
+		d.message = fmt.Sprintf("</> Info: Run #%d is still running, cancel it first", run.RunNumber)
+		return
+	case key == "f" && run.Conclusion == "success":
+		d.message = fmt.Sprintf("</> Info: Run #%d has no failed job", run.RunNumber)
+		return
+	}
+
+	verb := map[string]string{"c": "Cancel", "r": "Rerun", "f": "Rerun the failed jobs of"}[key]
+
+	d.confirm = &dashboardAction{key: key, run: run}
+	d.message = fmt.Sprintf("<!> %s run #%d: %s (%s)? [y/N]", verb, run.RunNumber, d.runName(run), run.Branch)
+}
+
+// perform cancels or reruns a run
+func (d *dashboard) perform(ctx context.Context, action *dashboardAction) dashboardCommand {
+	run := action.run
+
+	if action.key == "c" {
+		if err := d.client.Cancel(ctx, &types.Run{RunID: run.RunID, Branch: run.Branch, WorkflowName: run.WorkflowName}); err != nil {
+			d.message = fmt.Sprintf("<?> Error: Failed to cancel run #%d: %s", run.RunNumber, inlineError(err))
+			return dashboardNone
+		}
+
+		d.message = fmt.Sprintf("✓ Cancellation of run #%d requested", run.RunNumber)
+		return dashboardRefresh
+	}
+
+	resp, err := d.client.Rerun(ctx, &types.RerunRequest{RunID: run.RunID, WorkflowName: run.WorkflowName, FailedOnly: action.key == "f"})
+	if err != nil {
+		d.message = fmt.Sprintf("<?> Error: Failed to rerun run #%d: %s", run.RunNumber, inlineError(err))
+		return dashboardNone
+	}
+
+	d.message = fmt.Sprintf("✓ Rerun of run #%d requested", run.RunNumber)
+	if resp.RunID != 0 && resp.RunID != run.RunID {
+		d.message = fmt.Sprintf("✓ Rerun of run #%d requested (new run #%d)", run.RunNumber, resp.RunNumber)
+		d.selected = resp.RunID
+	}
+
+	// the jobs of the rerun run change again
+	delete(d.jobs, run.RunID)
+
+	return dashboardRefresh
+}
+
+// runName is the workflow of a run (or its branch, if the platform doesn't report it)
+func (d *dashboard) runName(run *types.Run) string {
+	if run.WorkflowName != "" {
+		return run.WorkflowName
+	}
+
+	if d.workflow != "" {
+		return d.workflow
+	}
+
+	return run.Branch
+}
+
+// render draws the dashboard frame: runs, jobs of the selected run, message and keys
+func (d *dashboard) render(width, height int, now time.Time, fetching bool) []string {
+	lines := []string{fmt.Sprintf("❯ Uniflow dashboard: %s", d.repository)}
+	if d.workflow != "" {
+		lines[0] += fmt.Sprintf(" (%s)", d.workflow)
+	}
+
+	switch {
+	case d.err != nil:
+		lines = append(lines, fmt.Sprintf("  <?> Error: %s (retrying in %s)", inlineError(d.err), d.interval))
+	case d.refreshedAt.IsZero():
+		lines = append(lines, "  Loading runs...")
+	case fetching:
+		lines = append(lines, "  Refreshing...")
+	default:
+		lines = append(lines, fmt.Sprintf("  Refreshed %s ago, polling every %s", helpers.FormatElapsed(d.refreshedAt, now, now), d.interval))
+	}
+
+	lines = append(lines, "")
+
+	if len(d.runs) == 0 && !d.refreshedAt.IsZero() {
+		lines = append(lines, "  </> Info: No workflow runs found")
+	}
+
+	// the workflow column takes the room left by the others
+	workflowWidth := min(max(width-74, 10), 30)
+
+	if len(d.runs) > 0 {
+		lines = append(lines, fmt.Sprintf("    %s  %s  %s  %s  %s  %s",
+			helpers.FitColumn("RUN", 7),
+			helpers.FitColumn("WORKFLOW", workflowWidth),
+			helpers.FitColumn("BRANCH", 14),
+			helpers.FitColumn("STATUS", 15),
+			helpers.FitColumn("ELAPSED", 8),
+			"JOBS"))
+	}
+
+	for _, run := range d.runs {
+		cursor := "  "
+		if run.RunID == d.selected {
+			cursor = "❯ "
+		}
+
+		lines = append(lines, fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s",
+			cursor,
+			helpers.FitColumn(fmt.Sprintf("#%d", run.RunNumber), 7),
+			helpers.FitColumn(d.runName(run), workflowWidth),
+			helpers.FitColumn(run.Branch, 14),
+			helpers.FitColumn(helpers.StatusSymbol(run.Status, run.Conclusion)+" "+formatRunState(run.Status, run.Conclusion), 15),
+			helpers.FitColumn(helpers.FormatElapsed(run.CreatedAt, runEnd(run), now), 8),
+			formatJobsProgress(d.jobs[run.RunID])))
+	}
+
+	if run := d.selectedRun(); run != nil {
+		lines = append(lines, "", fmt.Sprintf("  Jobs of run #%d: %s (%s)", run.RunNumber, d.runName(run), run.Branch))
+
+		jobs, ok := d.jobs[run.RunID]
+		if !ok {
+			lines = append(lines, "    Loading jobs...")
+		}
+
+		for _, job := range jobs {
+			line := fmt.Sprintf("    %s %s  %s  %s",
+				helpers.StatusSymbol(job.Status, job.Conclusion),
+				helpers.FitColumn(job.Name, 30),
+				helpers.FitColumn(formatRunState(job.Status, job.Conclusion), 15),
+				helpers.FitColumn(helpers.FormatElapsed(job.StartedAt, job.CompletedAt, now), 8))
+
+			if done, total := stepsProgress(job); total > 0 {
+				line += fmt.Sprintf("  %d/%d steps", done, total)
+			}
+
+			lines = append(lines, line)
+		}
+	}
+
+	footer := []string{d.message, "  ↑/↓ select  enter logs  c cancel  r rerun  f rerun failed  q quit"}
+
+	// the footer stays at the bottom, the runs and jobs are cut if they don't fit
+	lines = lines[:min(len(lines), max(height-len(footer), 0))]
+	for len(lines) < height-len(footer) {
+		lines = append(lines, "")
+	}
+
+	return append(lines, footer...)
+}
+
+// formatRunState is the status of a run or job, or its conclusion once completed
+func formatRunState(status, conclusion string) string {
+	if types.IsCompleted(status) {
+		return strings.TrimSpace(helpers.FormatConclusion(conclusion))
+	}
+
+	return strings.TrimSpace(helpers.FormatStatus(status))
+}
+
+// runEnd is when a run completed (zero while active)
+func runEnd(run *types.Run) time.Time {
+	if types.IsCompleted(run.Status) {
+		return run.UpdatedAt
+	}
+
+	return time.Time{}
+}
+
+// formatJobsProgress draws the completed jobs of a run (eg: "███░░ 3/5")
+func formatJobsProgress(jobs []*types.WorkflowJob) string {
+	if len(jobs) == 0 {
+		return "-"
+	}
+
+	done := 0
+	for _, job := range jobs {
+		if types.IsCompleted(job.Status) {
+			done++
+		}
+	}
+
+	return fmt.Sprintf("%s %d/%d", helpers.ProgressBar(int64(done), int64(len(jobs)), 10), done, len(jobs))
+}
+
+// stepsProgress counts the completed steps of a job
+func stepsProgress(job *types.WorkflowJob) (int, int) {
+	done := 0
+	for _, step := range job.Steps {
+		if types.IsCompleted(step.Status) {
+			done++
+		}
+	}
+
+	return done, len(job.Steps)
+}
+
+// firstLine is the first line of an error (errors are wrapped on several lines)
+func inlineError(err error) string {
+	message, _, _ := strings.Cut(err.Error(), "\n")
+	return message
+}
@@ -0,0 +1,240 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dashboardClient lists runs and jobs, and records the cancelled and rerun runs
+type dashboardClient struct {
+	platforms.PlatformClient
+
+	runs []*types.Run
+	jobs map[int64][]*types.WorkflowJob
+
+	jobsCalls []int64
+	cancelled []int64
+	reruns    []*types.RerunRequest
+}
+
+func (c *dashboardClient) ListWorkflowRuns(ctx context.Context, req *types.ListWorkflowRunsRequest) ([]*types.Run, error) {
+	return c.runs, nil
+}
+
+func (c *dashboardClient) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
+	c.jobsCalls = append(c.jobsCalls, req.RunID)
+	return c.jobs[req.RunID], nil
+}
+
+func (c *dashboardClient) Cancel(ctx context.Context, req *types.Run) error {
+	c.cancelled = append(c.cancelled, req.RunID)
+	return nil
+}
+
+func (c *dashboardClient) Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error) {
+	c.reruns = append(c.reruns, req)
+	return &types.RerunResponse{RunID: req.RunID}, nil
+}
+
+// newDashboardClient has an active deploy run and a failed ci run
+func newDashboardClient() *dashboardClient {
+	now := time.Now()
+
+	return &dashboardClient{
+		runs: []*types.Run{
+			{RunID: 2, RunNumber: 48, WorkflowName: "Deploy", Branch: "main", Status: "in_progress", CreatedAt: now.Add(-2 * time.Minute)},
+			{RunID: 1, RunNumber: 47, WorkflowName: "CI", Branch: "feature", Status: "completed", Conclusion: "failure", CreatedAt: now.Add(-time.Hour), UpdatedAt: now.Add(-50 * time.Minute)},
+		},
+		jobs: map[int64][]*types.WorkflowJob{
+			2: {
+				{Name: "build", Status: "completed", Conclusion: "success"},
+				{Name: "deploy", Status: "in_progress", StartedAt: now.Add(-30 * time.Second), Steps: []*types.WorkflowStep{
+					{Name: "checkout", Status: "completed"},
+					{Name: "release", Status: "in_progress"},
+				}},
+			},
+			1: {{Name: "test", Status: "completed", Conclusion: "failure"}},
+		},
+	}
+}
+
+// Test dashboard flags and status --watch
+func TestDashboardFlags(t *testing.T) {
+	for flagName, defaultValue := range map[string]string{"interval": "5s", "limit": "15"} {
+		flag := dashboardCmd.Flags().Lookup(flagName)
+		require.NotNil(t, flag, "flag %s does not exist", flagName)
+		assert.Equal(t, defaultValue, flag.DefValue)
+	}
+
+	watch := statusCmd.Flags().Lookup("watch")
+	require.NotNil(t, watch)
+	assert.Equal(t, "w", watch.Shorthand)
+}
+
+// Testing the polling backs off while the runs are idle
+func TestNextDashboardInterval(t *testing.T) {
+	base := 5 * time.Second
+
+	assert.Equal(t, base, nextDashboardInterval(40*time.Second, base, true, false))
+	assert.Equal(t, base, nextDashboardInterval(40*time.Second, base, false, true))
+	assert.Equal(t, 10*time.Second, nextDashboardInterval(base, base, false, false))
+	assert.Equal(t, constants.DASHBOARD_MAX_INTERVAL, nextDashboardInterval(40*time.Second, base, false, false))
+
+	// an interval above the maximum isn't shortened
+	assert.Equal(t, 2*time.Minute, nextDashboardInterval(2*time.Minute, 2*time.Minute, false, false))
+}
+
+// Testing the jobs of completed runs are only fetched once
+func TestDashboardFetchApply(t *testing.T) {
+	client := newDashboardClient()
+	d := newDashboard(client, "ignorant05/Uniflow", "", 15, 5*time.Second)
+
+	d.apply(d.fetch(context.Background(), d.completedJobs()))
+
+	assert.Equal(t, []int64{2, 1}, client.jobsCalls)
+	assert.Equal(t, int64(2), d.selected, "the first run is selected")
+	assert.Equal(t, 5*time.Second, d.interval)
+
+	client.jobsCalls = nil
+	d.apply(d.fetch(context.Background(), d.completedJobs()))
+
+	assert.Equal(t, []int64{2}, client.jobsCalls)
+	assert.Len(t, d.jobs[1], 1, "the cached jobs are kept")
+
+	// nothing active nor changing: backing off
+	client.runs = client.runs[1:]
+	d.apply(d.fetch(context.Background(), d.completedJobs()))
+	assert.Equal(t, 5*time.Second, d.interval)
+	assert.Equal(t, int64(1), d.selected, "the selection moves to a listed run")
+
+	d.apply(d.fetch(context.Background(), d.completedJobs()))
+	assert.Equal(t, 10*time.Second, d.interval)
+}
+
+// Testing the keys select runs, and actions are confirmed
+func TestDashboardHandleKey(t *testing.T) {
+	client := newDashboardClient()
+	d := newDashboard(client, "ignorant05/Uniflow", "", 15, 5*time.Second)
+	d.apply(d.fetch(context.Background(), d.completedJobs()))
+	ctx := context.Background()
+
+	// a running run can't be rerun
+	assert.Equal(t, dashboardNone, d.handleKey(ctx, "r"))
+	assert.Nil(t, d.confirm)
+	assert.Contains(t, d.message, "still running")
+
+	// cancelling is confirmed
+	assert.Equal(t, dashboardNone, d.handleKey(ctx, "c"))
+	assert.Contains(t, d.message, "Cancel run #48: Deploy (main)? [y/N]")
+	assert.Equal(t, dashboardNone, d.handleKey(ctx, "n"))
+	assert.Empty(t, client.cancelled)
+
+	d.handleKey(ctx, "c")
+	assert.Equal(t, dashboardRefresh, d.handleKey(ctx, "y"))
+	assert.Equal(t, []int64{2}, client.cancelled)
+
+	// the completed run can be rerun, but not cancelled
+	d.handleKey(ctx, helpers.KEY_DOWN)
+	assert.Equal(t, int64(1), d.selected)
+	d.handleKey(ctx, helpers.KEY_DOWN)
+	assert.Equal(t, int64(1), d.selected, "the selection stops at the last run")
+
+	d.handleKey(ctx, "c")
+	assert.Contains(t, d.message, "already completed")
+
+	d.handleKey(ctx, "f")
+	assert.Equal(t, dashboardRefresh, d.handleKey(ctx, "y"))
+	require.Len(t, client.reruns, 1)
+	assert.True(t, client.reruns[0].FailedOnly)
+	assert.NotContains(t, d.jobs, int64(1), "the jobs of the rerun run are fetched again")
+
+	assert.Equal(t, dashboardLogs, d.handleKey(ctx, helpers.KEY_ENTER))
+	assert.Equal(t, dashboardQuit, d.handleKey(ctx, "q"))
+}
+
+// Testing the dashboard frame
+func TestDashboardRender(t *testing.T) {
+	d := newDashboard(newDashboardClient(), "ignorant05/Uniflow", "", 15, 5*time.Second)
+	d.apply(d.fetch(context.Background(), d.completedJobs()))
+
+	lines := d.render(120, 20, time.Now(), false)
+	require.Len(t, lines, 20)
+
+	frame := strings.Join(lines, "\n")
+	for _, want := range []string{
+		"❯ Uniflow dashboard: ignorant05/Uniflow",
+		"polling every 5s",
+		"● In Progress",
+		"█████░░░░░ 1/2",
+		"✗ Failure",
+		"10m 00s",
+		"Jobs of run #48: Deploy (main)",
+		"✓ build",
+		"● deploy",
+		"1/2 steps",
+	} {
+		assert.Contains(t, frame, want)
+	}
+
+	assert.True(t, strings.HasPrefix(lines[4], "❯   #48      Deploy "), "the first run is selected: %q", lines[4])
+	assert.Contains(t, lines[5], "#47      CI ")
+	assert.Contains(t, lines[19], "q quit", "the keys stay at the bottom")
+
+	// narrow terminals shrink the workflow column
+	lines = d.render(80, 20, time.Now(), false)
+	assert.True(t, strings.HasPrefix(lines[4], "❯   #48      Deploy      main "), "%q", lines[4])
+
+	// small terminals cut the runs, not the keys
+	lines = d.render(120, 6, time.Now(), false)
+	require.Len(t, lines, 6)
+	assert.Contains(t, lines[5], "q quit")
+}
+
+// Testing the keys of a raw terminal
+func TestParseKeys(t *testing.T) {
+	keys := helpers.ParseKeys([]byte("\x1b[A\x1b[Bjq\r\x03\x1bé"))
+	assert.Equal(t, []string{helpers.KEY_UP, helpers.KEY_DOWN, "j", "q", helpers.KEY_ENTER, helpers.KEY_CTRL_C, helpers.KEY_ESC, "é"}, keys)
+}
+
+// Testing the screen only rewrites the changed lines
+func TestScreenDraw(t *testing.T) {
+	var out bytes.Buffer
+	screen := helpers.NewScreen(&out)
+
+	assert.Equal(t, 3, screen.Draw([]string{"title", "run #1", "run #2"}, 80, 24))
+	assert.Contains(t, out.String(), "\033[2J")
+
+	out.Reset()
+	assert.Equal(t, 1, screen.Draw([]string{"title", "run #1 done", "run #2"}, 80, 24))
+	assert.Equal(t, "\033[2;1H\033[2Krun #1 done", out.String())
+
+	// removed lines are cleared
+	out.Reset()
+	assert.Equal(t, 1, screen.Draw([]string{"title", "run #1 done"}, 80, 24))
+	assert.Equal(t, "\033[3;1H\033[2K", out.String())
+
+	// resizing redraws everything, lines are cut to the width
+	out.Reset()
+	assert.Equal(t, 2, screen.Draw([]string{"title", "run #1 done"}, 6, 24))
+	assert.Contains(t, out.String(), "run #…")
+}
+
+// Testing the elapsed times
+func TestFormatElapsed(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, "-", helpers.FormatElapsed(time.Time{}, now, now))
+	assert.Equal(t, "45s", helpers.FormatElapsed(now.Add(-45*time.Second), time.Time{}, now))
+	assert.Equal(t, "2m 03s", helpers.FormatElapsed(now.Add(-123*time.Second), now, now))
+	assert.Equal(t, "1h 02m", helpers.FormatElapsed(now.Add(-62*time.Minute), now, now))
+}
@@ -0,0 +1,23 @@
+package helpers
+
+import (
+	"fmt"
+	"time"
+)
+
+// FormatWaitTime helper formats the time a run has been waiting for (eg: "2h 05m", "12m", "40s").
+//
+// Parameters:
+//   - since: when the run started waiting
+func FormatWaitTime(since time.Time) string {
+	wait := time.Since(since)
+
+	switch {
+	case wait < time.Minute:
+		return fmt.Sprintf("%ds", max(int(wait.Seconds()), 0))
+	case wait < time.Hour:
+		return fmt.Sprintf("%dm", int(wait.Minutes()))
+	default:
+		return fmt.Sprintf("%dh %02dm", int(wait.Hours()), int(wait.Minutes())%60)
+	}
+}
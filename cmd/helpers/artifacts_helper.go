@@ -0,0 +1,180 @@
+package helpers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// PROGRESS_REDRAW_INTERVAL is the minimum delay between two redraws of the progress bars
+const PROGRESS_REDRAW_INTERVAL = 100 * time.Millisecond
+
+// PROGRESS_BAR_WIDTH is the number of cells of a progress bar
+const PROGRESS_BAR_WIDTH = 30
+
+// artifactProgress is the download state of an artifact
+type artifactProgress struct {
+	artifact   *types.Artifact
+	downloaded int64
+	done       bool
+	err        error
+}
+
+// DownloadProgress shows the progress of artifact downloads.
+// On a terminal, a bar per artifact is redrawn in place, otherwise a line is printed per finished download.
+// NOTE: it isn't safe for concurrent use (platforms.DownloadArtifacts serializes its callbacks)
+type DownloadProgress struct {
+	Out         io.Writer
+	Interactive bool
+
+	downloads []*artifactProgress
+	drawn     int
+	lastDraw  time.Time
+}
+
+// NewDownloadProgress helper creates the progress display of artifact downloads.
+//
+// Parameters:
+//   - out: display output (eg: os.Stdout)
+//   - artifacts: downloaded artifacts
+//   - interactive: redraws progress bars (out must be a terminal)
+func NewDownloadProgress(out io.Writer, artifacts []*types.Artifact, interactive bool) *DownloadProgress {
+	downloads := make([]*artifactProgress, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		downloads = append(downloads, &artifactProgress{artifact: artifact})
+	}
+
+	return &DownloadProgress{Out: out, Interactive: interactive, downloads: downloads}
+}
+
+// Update helper records the downloaded bytes of an artifact.
+//
+// Parameters:
+//   - artifact: downloaded artifact
+//   - downloaded: bytes downloaded so far
+func (p *DownloadProgress) Update(artifact *types.Artifact, downloaded int64) {
+	if download := p.find(artifact); download != nil {
+		download.downloaded = downloaded
+	}
+
+	if p.Interactive && time.Since(p.lastDraw) >= PROGRESS_REDRAW_INTERVAL {
+		p.draw()
+	}
+}
+
+// Done helper records the end of an artifact download.
+//
+// Parameters:
+//   - artifact: downloaded artifact
+//   - size: downloaded bytes
+//   - err: download error (nil on success)
+func (p *DownloadProgress) Done(artifact *types.Artifact, size int64, err error) {
+	download := p.find(artifact)
+	if download == nil {
+		return
+	}
+
+	download.done = true
+	download.err = err
+	if err == nil {
+		download.downloaded = size
+	}
+
+	if p.Interactive {
+		p.draw()
+		return
+	}
+
+	if err != nil {
+		fmt.Fprintf(p.Out, "✗ %s: %v\n", artifact.Name, err)
+		return
+	}
+
+	fmt.Fprintf(p.Out, "✓ %s (%s)\n", artifact.Name, FormatSize(size))
+}
+
+// find is the download state of an artifact
+func (p *DownloadProgress) find(artifact *types.Artifact) *artifactProgress {
+	for _, download := range p.downloads {
+		if download.artifact == artifact {
+			return download
+		}
+	}
+
+	return nil
+}
+
+// draw redraws the progress bars over the previous ones
+func (p *DownloadProgress) draw() {
+	var b strings.Builder
+
+	if p.drawn > 0 {
+		fmt.Fprintf(&b, "\033[%dA", p.drawn)
+	}
+
+	width := 0
+	for _, download := range p.downloads {
+		width = max(width, len(download.artifact.Name))
+	}
+
+	for _, download := range p.downloads {
+		fmt.Fprintf(&b, "\033[2K%-*s  %s\n", width, download.artifact.Name, formatDownload(download))
+	}
+
+	fmt.Fprint(p.Out, b.String())
+
+	p.drawn = len(p.downloads)
+	p.lastDraw = time.Now()
+}
+
+// formatDownload formats the progress bar of a download
+func formatDownload(download *artifactProgress) string {
+	if download.err != nil {
+		return fmt.Sprintf("✗ %v", download.err)
+	}
+
+	// finished downloads have a full bar
+	total := download.artifact.Size
+	if download.done {
+		total = 0
+	}
+
+	bar := ProgressBar(download.downloaded, total, PROGRESS_BAR_WIDTH)
+
+	if download.done {
+		return fmt.Sprintf("%s ✓ %s", bar, FormatSize(download.downloaded))
+	}
+
+	return fmt.Sprintf("%s %s / %s", bar, FormatSize(download.downloaded), FormatSize(download.artifact.Size))
+}
+
+// FormatExpiry helper formats when an artifact expires (eg: "in 3d", "in 5h", "expired").
+//
+// Parameters:
+//   - expiresAt: expiry date (zero if unknown)
+//   - expired: the artifact already expired
+func FormatExpiry(expiresAt time.Time, expired bool) string {
+	if expired {
+		return "expired"
+	}
+
+	if expiresAt.IsZero() {
+		return "-"
+	}
+
+	left := time.Until(expiresAt)
+
+	switch {
+	case left <= 0:
+		return "expired"
+	case left < time.Hour:
+		return fmt.Sprintf("in %dm", int(left.Minutes()))
+	case left < 24*time.Hour:
+		return fmt.Sprintf("in %dh", int(left.Hours()))
+	default:
+		return fmt.Sprintf("in %dd", int(left.Hours()/24))
+	}
+}
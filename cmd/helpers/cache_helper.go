@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseSize parses a size in bytes, with an optional unit (eg: "512", "100K", "64MB", "1G").
+//
+// Parameters:
+//   - size: size with an optional unit (K, M, G, with or without B)
+//
+// Example:
+// bytes, err := helpers.ParseSize("100MB")
+func ParseSize(size string) (int64, error) {
+	value := strings.ToUpper(strings.TrimSpace(size))
+	value = strings.TrimSuffix(value, "B")
+
+	multiplier := int64(1)
+	for unit, factor := range map[string]int64{"K": 1 << 10, "M": 1 << 20, "G": 1 << 30} {
+		if strings.HasSuffix(value, unit) {
+			value, multiplier = strings.TrimSuffix(value, unit), factor
+			break
+		}
+	}
+
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("<?> Error: Invalid size %q (eg: 512, 100K, 64MB, 1G)", size)
+	}
+
+	return int64(number * float64(multiplier)), nil
+}
+
+// FormatSize formats a size in bytes (eg: "1.5 MB").
+//
+// Parameters:
+//   - size: size in bytes
+func FormatSize(size int64) string {
+	switch {
+	case size >= 1<<30:
+		return fmt.Sprintf("%.1f GB", float64(size)/(1<<30))
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
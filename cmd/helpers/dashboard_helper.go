@@ -0,0 +1,138 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Keys read from a terminal in raw mode
+const (
+	KEY_UP     = "up"
+	KEY_DOWN   = "down"
+	KEY_ENTER  = "enter"
+	KEY_ESC    = "esc"
+	KEY_CTRL_C = "ctrl+c"
+)
+
+// ParseKeys helper splits the bytes read from a raw terminal into keys.
+// Arrows, enter, escape and Ctrl+C are named (eg: KEY_UP), other keys are their character.
+//
+// Parameters:
+//   - buf: bytes read at once
+func ParseKeys(buf []byte) []string {
+	var keys []string
+
+	for len(buf) > 0 {
+		switch {
+		case buf[0] == 0x1b && len(buf) >= 3 && (buf[1] == '[' || buf[1] == 'O'):
+			switch buf[2] {
+			case 'A':
+				keys = append(keys, KEY_UP)
+			case 'B':
+				keys = append(keys, KEY_DOWN)
+			}
+			buf = buf[3:]
+		case buf[0] == 0x1b:
+			keys = append(keys, KEY_ESC)
+			buf = buf[1:]
+		case buf[0] == '\r' || buf[0] == '\n':
+			keys = append(keys, KEY_ENTER)
+			buf = buf[1:]
+		case buf[0] == 0x03:
+			keys = append(keys, KEY_CTRL_C)
+			buf = buf[1:]
+		default:
+			r, size := utf8.DecodeRune(buf)
+			keys = append(keys, string(r))
+			buf = buf[size:]
+		}
+	}
+
+	return keys
+}
+
+// StatusSymbol helper is the one cell symbol of a run or job status.
+//
+// Parameters:
+//   - status: status string
+//   - conclusion: conclusion string (of completed runs)
+func StatusSymbol(status, conclusion string) string {
+	if status != "completed" {
+		switch status {
+		case "queued", "waiting", "pending":
+			return "○"
+		case "in_progress":
+			return "●"
+		default:
+			return "•"
+		}
+	}
+
+	switch conclusion {
+	case "success":
+		return "✓"
+	case "failure", "timed_out":
+		return "✗"
+	case "cancelled":
+		return "⊘"
+	case "skipped", "neutral":
+		return "–"
+	default:
+		return "•"
+	}
+}
+
+// FormatElapsed helper formats the time between start and end (eg: "45s", "2m 13s", "1h 02m").
+//
+// Parameters:
+//   - start: start time ("-" if zero)
+//   - end: end time (now if zero)
+//   - now: current time
+func FormatElapsed(start, end, now time.Time) string {
+	if start.IsZero() {
+		return "-"
+	}
+
+	if end.IsZero() {
+		end = now
+	}
+
+	elapsed := max(end.Sub(start), 0)
+
+	switch {
+	case elapsed < time.Minute:
+		return fmt.Sprintf("%ds", int(elapsed.Seconds()))
+	case elapsed < time.Hour:
+		return fmt.Sprintf("%dm %02ds", int(elapsed.Minutes()), int(elapsed.Seconds())%60)
+	default:
+		return fmt.Sprintf("%dh %02dm", int(elapsed.Hours()), int(elapsed.Minutes())%60)
+	}
+}
+
+// FitColumn helper cuts or pads a value to width runes.
+//
+// Parameters:
+//   - value: column value
+//   - width: column width
+func FitColumn(value string, width int) string {
+	value = cutLine(value, width)
+
+	return value + strings.Repeat(" ", max(width-utf8.RuneCountInString(value), 0))
+}
+
+// ProgressBar helper draws a bar of width cells, filled by done/total.
+//
+// Parameters:
+//   - done: completed amount
+//   - total: total amount (the bar is full if unknown)
+//   - width: number of cells
+func ProgressBar(done, total int64, width int) string {
+	filled := width
+	if total > 0 {
+		filled = int(min(max(done, 0)*int64(width)/total, int64(width)))
+	}
+
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
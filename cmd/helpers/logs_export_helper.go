@@ -0,0 +1,405 @@
+package helpers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// LogWriter writes streamed log lines in an output format
+type LogWriter interface {
+	// Write writes (or keeps) a log line
+	Write(line *types.LogLine) error
+
+	// Close writes what's left (eg: the end of a document)
+	Close() error
+}
+
+// NewLogWriter creates the log writer of an output format.
+//
+// Parameters:
+//   - out: output (eg: os.Stdout)
+//   - runID: run the lines belong to
+//   - opts: display options (opts.Format selects the writer)
+//
+// Errors possible causes:
+//   - unknown format
+//
+// Example:
+// writer, err := helpers.NewLogWriter(os.Stdout, 123456, helpers.LogRenderOptions{Format: "jsonl"})
+func NewLogWriter(out io.Writer, runID int64, opts LogRenderOptions) (LogWriter, error) {
+	switch opts.Format {
+	case "", constants.LOGS_FORMAT_TEXT:
+		return NewLogRenderer(out, opts), nil
+	case constants.LOGS_FORMAT_JSONL:
+		return &JSONLinesWriter{encoder: json.NewEncoder(out), runID: runID, step: opts.Step}, nil
+	case constants.LOGS_FORMAT_HTML:
+		return &HTMLLogWriter{out: out, runID: runID, step: opts.Step}, nil
+	default:
+		return nil, fmt.Errorf("<?> Error: Unknown format '%s' (text, jsonl, html)", opts.Format)
+	}
+}
+
+// LogRecord is a log line of the jsonl format
+type LogRecord struct {
+	Run        int64          `json:"run"`
+	Job        string         `json:"job"`
+	Step       string         `json:"step"`
+	Timestamp  string         `json:"timestamp"`
+	Level      string         `json:"level"`
+	Content    string         `json:"content"`
+	Group      string         `json:"group,omitempty"`
+	Annotation *LogAnnotation `json:"annotation,omitempty"`
+}
+
+// LogAnnotation is the annotation of a LogRecord
+type LogAnnotation struct {
+	Level  string `json:"level"`
+	Title  string `json:"title,omitempty"`
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// JSONLinesWriter writes one JSON object (LogRecord) per log line
+type JSONLinesWriter struct {
+	encoder *json.Encoder
+	runID   int64
+	step    string
+}
+
+// Write writes a log line (group ends are skipped)
+func (w *JSONLinesWriter) Write(line *types.LogLine) error {
+	if line.Marker == types.LOG_GROUP_END || !matchesStep(line, w.step) {
+		return nil
+	}
+
+	record := LogRecord{
+		Run:     w.runID,
+		Job:     line.JobName,
+		Step:    line.Step,
+		Level:   lineLevel(line),
+		Content: line.Content,
+		Group:   line.Group,
+	}
+
+	if !line.Timestamp.IsZero() {
+		record.Timestamp = line.Timestamp.UTC().Format(time.RFC3339Nano)
+	}
+
+	if annotation := line.Annotation; annotation != nil {
+		record.Annotation = &LogAnnotation{
+			Level:  annotation.Level,
+			Title:  annotation.Title,
+			File:   annotation.File,
+			Line:   annotation.Line,
+			Column: annotation.Column,
+		}
+	}
+
+	return w.encoder.Encode(record)
+}
+
+// Close does nothing, records are written as they come
+func (w *JSONLinesWriter) Close() error {
+	return nil
+}
+
+// lineLevel is the level of a line ("info" if none)
+func lineLevel(line *types.LogLine) string {
+	if line.Level == "" {
+		return "info"
+	}
+
+	return line.Level
+}
+
+// HTMLLogWriter keeps the log lines and writes them as a self-contained HTML page on Close
+// NOTE: jobs, steps and groups are collapsible, steps and groups with errors are expanded
+type HTMLLogWriter struct {
+	out   io.Writer
+	runID int64
+	step  string
+
+	jobs []*htmlJob
+}
+
+type htmlJob struct {
+	Name  string
+	Steps []*htmlStep
+}
+
+type htmlStep struct {
+	Name    string
+	Lines   int
+	Errors  int
+	Entries []*htmlEntry
+}
+
+// htmlEntry is either a line or a group of lines
+type htmlEntry struct {
+	Line   *htmlLine
+	Group  string
+	Lines  []*htmlLine
+	Errors int
+	open   bool
+}
+
+type htmlLine struct {
+	Time    string
+	Level   string
+	Content string
+}
+
+// Write keeps a log line
+func (w *HTMLLogWriter) Write(line *types.LogLine) error {
+	if !matchesStep(line, w.step) {
+		return nil
+	}
+
+	if len(w.jobs) == 0 || w.jobs[len(w.jobs)-1].Name != line.JobName {
+		w.jobs = append(w.jobs, &htmlJob{Name: line.JobName})
+	}
+
+	job := w.jobs[len(w.jobs)-1]
+	if len(job.Steps) == 0 || job.Steps[len(job.Steps)-1].Name != line.Step {
+		job.Steps = append(job.Steps, &htmlStep{Name: line.Step})
+	}
+
+	step := job.Steps[len(job.Steps)-1]
+
+	var group *htmlEntry
+	if len(step.Entries) > 0 && step.Entries[len(step.Entries)-1].open {
+		group = step.Entries[len(step.Entries)-1]
+	}
+
+	switch line.Marker {
+	case types.LOG_GROUP_START:
+		if group != nil {
+			group.open = false
+		}
+		step.Entries = append(step.Entries, &htmlEntry{Group: line.Content, open: true})
+		return nil
+	case types.LOG_GROUP_END:
+		if group != nil {
+			group.open = false
+		}
+		return nil
+	}
+
+	entry := &htmlLine{Level: lineLevel(line), Content: (&LogRenderer{opts: LogRenderOptions{NoColor: true}}).content(line)}
+	if !line.Timestamp.IsZero() {
+		entry.Time = line.Timestamp.Local().Format("15:04:05")
+	}
+
+	step.Lines++
+	if entry.Level == "error" {
+		step.Errors++
+	}
+
+	if group != nil && line.Group != "" {
+		group.Lines = append(group.Lines, entry)
+		if entry.Level == "error" {
+			group.Errors++
+		}
+	} else {
+		step.Entries = append(step.Entries, &htmlEntry{Line: entry})
+	}
+
+	return nil
+}
+
+// Close writes the HTML page
+func (w *HTMLLogWriter) Close() error {
+	return htmlLogsTemplate.Execute(w.out, map[string]any{
+		"Title": fmt.Sprintf("Run #%d logs", w.runID),
+		"Jobs":  w.jobs,
+	})
+}
+
+var htmlLogsTemplate = template.Must(template.New("logs").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; background: #0d1117; color: #c9d1d9; margin: 2em; }
+h1 { font-size: 1.4em; }
+summary { cursor: pointer; padding: 4px 0; }
+.job > summary { font-weight: bold; font-size: 1.1em; color: #58a6ff; }
+.step { margin-left: 1em; }
+.step > summary { font-weight: bold; }
+.count { color: #8b949e; font-weight: normal; font-size: 0.9em; }
+.lines { font-family: ui-monospace, SFMono-Regular, Menlo, Consolas, monospace; font-size: 0.85em; margin-left: 1.5em; }
+.group { margin-left: 0; }
+.group .line { padding-left: 1.5em; }
+.line { white-space: pre-wrap; word-break: break-all; }
+.time { color: #6e7681; margin-right: 1em; }
+.error { color: #f85149; }
+.warning { color: #d29922; }
+.success { color: #3fb950; }
+.debug { color: #6e7681; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- define "line"}}
+<div class="line {{.Level}}">{{if .Time}}<span class="time">{{.Time}}</span>{{end}}{{.Content}}</div>
+{{- end}}
+{{- range .Jobs}}
+<details class="job" open>
+<summary>{{.Name}}</summary>
+{{- range .Steps}}
+<details class="step"{{if .Errors}} open{{end}}>
+<summary>{{if .Name}}{{.Name}}{{else}}Logs{{end}} <span class="count">({{.Lines}} lines{{if .Errors}}, <span class="error">{{.Errors}} error(s)</span>{{end}})</span></summary>
+<div class="lines">
+{{- range .Entries}}
+{{- if .Line}}{{template "line" .Line}}{{else}}
+<details class="group"{{if .Errors}} open{{end}}>
+<summary>{{.Group}}</summary>
+{{- range .Lines}}{{template "line" .}}{{end}}
+</details>
+{{- end}}
+{{- end}}
+</div>
+</details>
+{{- end}}
+</details>
+{{- end}}
+</body>
+</html>
+`))
+
+// JUnitTestSuites is the root of a JUnit XML report
+type JUnitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Name     string            `xml:"name,attr"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Skipped  int               `xml:"skipped,attr"`
+	Suites   []*JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestSuite is a job of a JUnit XML report
+type JUnitTestSuite struct {
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Cases    []*JUnitTestCase `xml:"testcase"`
+}
+
+// JUnitTestCase is a step (or a job without steps) of a JUnit XML report
+type JUnitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *JUnitMessage `xml:"failure,omitempty"`
+	Skipped   *JUnitMessage `xml:"skipped,omitempty"`
+}
+
+// JUnitMessage is the failure (or skip) reason of a test case
+type JUnitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+}
+
+// NewJUnitReport turns the job and step conclusions of a run into a JUnit report:
+// a test suite per job, a test case per step (per job if the platform doesn't report steps).
+// NOTE: failed steps are failures, skipped, cancelled and unfinished steps are skipped
+//
+// Parameters:
+//   - name: report name (eg: workflow name)
+//   - jobs: run jobs
+//
+// Example:
+// report := helpers.NewJUnitReport("CI", jobs)
+func NewJUnitReport(name string, jobs []*types.WorkflowJob) *JUnitTestSuites {
+	report := &JUnitTestSuites{Name: name}
+
+	for _, job := range jobs {
+		suite := &JUnitTestSuite{Name: job.Name}
+
+		if len(job.Steps) == 0 {
+			suite.Cases = append(suite.Cases, newJUnitTestCase(job.Name, job.Name, job.Status, job.Conclusion))
+		}
+
+		for _, step := range job.Steps {
+			suite.Cases = append(suite.Cases, newJUnitTestCase(job.Name, step.Name, step.Status, step.Conclusion))
+		}
+
+		for _, testCase := range suite.Cases {
+			suite.Tests++
+			if testCase.Failure != nil {
+				suite.Failures++
+			}
+			if testCase.Skipped != nil {
+				suite.Skipped++
+			}
+		}
+
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		report.Suites = append(report.Suites, suite)
+	}
+
+	return report
+}
+
+// newJUnitTestCase creates the test case of a step (or job) conclusion
+func newJUnitTestCase(className, name, status, conclusion string) *JUnitTestCase {
+	testCase := &JUnitTestCase{ClassName: className, Name: name}
+
+	switch {
+	case IsFailedConclusion(conclusion):
+		testCase.Failure = &JUnitMessage{Message: conclusion, Type: conclusion}
+	case conclusion == "" && status != "":
+		testCase.Skipped = &JUnitMessage{Message: "not completed (" + status + ")"}
+	case conclusion == "":
+		testCase.Skipped = &JUnitMessage{Message: "not completed"}
+	case !isPassedConclusion(conclusion):
+		testCase.Skipped = &JUnitMessage{Message: conclusion}
+	}
+
+	return testCase
+}
+
+// isPassedConclusion checks if a conclusion is a success
+func isPassedConclusion(conclusion string) bool {
+	switch strings.ToLower(conclusion) {
+	case "success", "succeeded", "passed":
+		return true
+	default:
+		return false
+	}
+}
+
+// WriteJUnitReport writes a JUnit report as XML.
+//
+// Parameters:
+//   - out: output (eg: a file)
+//   - report: JUnit report
+//
+// Errors possible causes:
+//   - cannot write the output
+func WriteJUnitReport(out io.Writer, report *JUnitTestSuites) error {
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(out)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(out, "\n")
+	return err
+}
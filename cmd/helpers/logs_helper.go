@@ -2,9 +2,16 @@ package helpers
 
 import (
 	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/fatih/color"
 	"github.com/google/go-github/v57/github"
 	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
 )
 
 // ExtractGithubClient extracts github client from PlatformClient struct
@@ -17,3 +24,495 @@ func ExtractGithubClient(client platforms.PlatformClient) (*github.Client, error
 
 	return nil, fmt.Errorf("<?> Error: Not a GitHub client")
 }
+
+// LogRenderOptions are the display options of streamed logs
+type LogRenderOptions struct {
+	NoColor bool
+
+	// CollapseGroups folds groups into a single line (errors and annotations are still shown)
+	CollapseGroups bool
+
+	// Step only shows the steps whose name contains it (case insensitive)
+	Step string
+
+	// Format is the output format (text, jsonl or html), text if empty
+	Format string
+}
+
+// LogRenderer prints structured log lines with job/step headers, groups and annotations
+type LogRenderer struct {
+	Out  io.Writer
+	opts LogRenderOptions
+
+	job    string
+	step   string
+	hidden int
+
+	// Matched counts the lines shown (or folded)
+	Matched int
+}
+
+// NewLogRenderer creates a log renderer.
+//
+// Parameters:
+//   - out: output (eg: os.Stdout)
+//   - opts: display options
+//
+// Example:
+// renderer := helpers.NewLogRenderer(os.Stdout, helpers.LogRenderOptions{CollapseGroups: true})
+func NewLogRenderer(out io.Writer, opts LogRenderOptions) *LogRenderer {
+	return &LogRenderer{Out: out, opts: opts}
+}
+
+// Render prints a log line (headers are printed when the job or the step changes).
+//
+// Parameters:
+//   - line: log line
+func (r *LogRenderer) Render(line *types.LogLine) {
+	if !matchesStep(line, r.opts.Step) {
+		return
+	}
+	r.Matched++
+
+	if line.JobName != r.job {
+		r.job, r.step = line.JobName, ""
+		fmt.Fprintf(r.Out, "\n%s\n", r.paint(color.New(color.Bold, color.FgCyan), "Job: "+line.JobName))
+		fmt.Fprintln(r.Out, strings.Repeat("─", 80))
+	}
+
+	if line.Step != "" && line.Step != r.step {
+		r.step = line.Step
+		fmt.Fprintln(r.Out, r.paint(color.New(color.Bold), "▸ Step: "+line.Step))
+	}
+
+	switch line.Marker {
+	case types.LOG_GROUP_START:
+		r.hidden = 0
+		if !r.opts.CollapseGroups {
+			fmt.Fprintln(r.Out, r.timestamp(line)+r.paint(color.New(color.Bold), "▾ "+line.Content))
+		}
+		return
+	case types.LOG_GROUP_END:
+		if r.opts.CollapseGroups && line.Group != "" {
+			fmt.Fprintln(r.Out, r.timestamp(line)+r.paint(color.New(color.FgHiBlack), fmt.Sprintf("▸ %s (%d lines)", line.Group, r.hidden)))
+		}
+		return
+	}
+
+	// folded lines are counted, errors are never folded
+	if line.Group != "" && r.opts.CollapseGroups && line.Level != "error" && line.Annotation == nil {
+		r.hidden++
+		return
+	}
+
+	indent := ""
+	if line.Group != "" {
+		indent = "  "
+	}
+
+	fmt.Fprintln(r.Out, r.timestamp(line)+indent+r.content(line))
+}
+
+// Write renders a log line (LogWriter)
+func (r *LogRenderer) Write(line *types.LogLine) error {
+	r.Render(line)
+	return nil
+}
+
+// Close does nothing, lines are printed as they come (LogWriter)
+func (r *LogRenderer) Close() error {
+	return nil
+}
+
+// matchesStep checks if a line belongs to a step whose name contains step (any step if empty)
+func matchesStep(line *types.LogLine, step string) bool {
+	return step == "" || strings.Contains(strings.ToLower(line.Step), strings.ToLower(step))
+}
+
+// timestamp formats the time of a line ("15:04:05 ")
+func (r *LogRenderer) timestamp(line *types.LogLine) string {
+	if line.Timestamp.IsZero() {
+		return ""
+	}
+
+	return r.paint(color.New(color.FgHiBlack), line.Timestamp.Local().Format("15:04:05")) + " "
+}
+
+// content formats the content of a line, colorized by level
+func (r *LogRenderer) content(line *types.LogLine) string {
+	content := line.Content
+
+	if annotation := line.Annotation; annotation != nil {
+		location := annotation.File
+		if location != "" && annotation.Line > 0 {
+			location += fmt.Sprintf(":%d", annotation.Line)
+			if annotation.Column > 0 {
+				location += fmt.Sprintf(":%d", annotation.Column)
+			}
+		}
+
+		if annotation.Title != "" {
+			content = annotation.Title + ": " + content
+		}
+
+		if location != "" {
+			content = location + ": " + content
+		}
+
+		content = annotation.Level + ": " + content
+	}
+
+	switch line.Level {
+	case "error":
+		return r.paint(color.New(color.FgRed), content)
+	case "warning":
+		return r.paint(color.New(color.FgYellow), content)
+	case "success":
+		return r.paint(color.New(color.FgGreen), content)
+	case "debug":
+		return r.paint(color.New(color.FgHiBlack), content)
+	default:
+		return content
+	}
+}
+
+// paint colorizes a text (unless colors are disabled)
+func (r *LogRenderer) paint(c *color.Color, text string) string {
+	if r.opts.NoColor {
+		return text
+	}
+
+	return c.Sprint(text)
+}
+
+// prefixColors are the colors of the multiplexed streams prefixes
+var prefixColors = []color.Attribute{
+	color.FgCyan, color.FgYellow, color.FgGreen, color.FgMagenta, color.FgBlue,
+	color.FgHiCyan, color.FgHiYellow, color.FgHiGreen, color.FgHiMagenta, color.FgHiBlue,
+}
+
+// MultiplexRenderer prints the interleaved log lines of several runs, each prefixed by it's run and job
+// (eg: "[build/linux] 10:30:40 go test ./..."), in the style of docker compose logs
+type MultiplexRenderer struct {
+	Out  io.Writer
+	opts LogRenderOptions
+	base *LogRenderer
+
+	// width is the width of the longest prefix so far (prefixes are aligned)
+	width   int
+	streams map[string]*multiplexStream
+}
+
+// multiplexStream is the display state of a run job
+type multiplexStream struct {
+	step   string
+	hidden int
+}
+
+// NewMultiplexRenderer creates a multiplexed log renderer.
+//
+// Parameters:
+//   - out: output (eg: os.Stdout)
+//   - opts: display options
+//
+// Example:
+// renderer := helpers.NewMultiplexRenderer(os.Stdout, helpers.LogRenderOptions{})
+func NewMultiplexRenderer(out io.Writer, opts LogRenderOptions) *MultiplexRenderer {
+	return &MultiplexRenderer{Out: out, opts: opts, base: NewLogRenderer(out, opts), streams: make(map[string]*multiplexStream)}
+}
+
+// Render prints a log line of a run, prefixed by "[name/job]" (step changes are printed too).
+//
+// Parameters:
+//   - name: run name (eg: workflow name)
+//   - line: log line
+func (r *MultiplexRenderer) Render(name string, line *types.LogLine) {
+	if !matchesStep(line, r.opts.Step) {
+		return
+	}
+
+	label := name
+	if line.JobName != "" {
+		label = strings.TrimPrefix(name+"/"+line.JobName, "/")
+	}
+
+	stream, ok := r.streams[label]
+	if !ok {
+		stream = &multiplexStream{}
+		r.streams[label] = stream
+	}
+
+	if line.Step != "" && line.Step != stream.step {
+		stream.step = line.Step
+		r.print(label, r.base.paint(color.New(color.Bold), "▸ Step: "+line.Step))
+	}
+
+	switch line.Marker {
+	case types.LOG_GROUP_START:
+		stream.hidden = 0
+		if !r.opts.CollapseGroups {
+			r.print(label, r.base.timestamp(line)+r.base.paint(color.New(color.Bold), "▾ "+line.Content))
+		}
+		return
+	case types.LOG_GROUP_END:
+		if r.opts.CollapseGroups && line.Group != "" {
+			r.print(label, r.base.timestamp(line)+r.base.paint(color.New(color.FgHiBlack), fmt.Sprintf("▸ %s (%d lines)", line.Group, stream.hidden)))
+		}
+		return
+	}
+
+	if line.Group != "" && r.opts.CollapseGroups && line.Level != "error" && line.Annotation == nil {
+		stream.hidden++
+		return
+	}
+
+	indent := ""
+	if line.Group != "" {
+		indent = "  "
+	}
+
+	r.print(label, r.base.timestamp(line)+indent+r.base.content(line))
+}
+
+// Done prints the completion status of a run stream.
+//
+// Parameters:
+//   - name: run name
+//   - status: status of the run (nil if unknown)
+//   - err: stream error (if any)
+func (r *MultiplexRenderer) Done(name string, status *types.Status, err error) {
+	switch {
+	case err != nil:
+		r.print(name, r.base.paint(color.New(color.FgRed), fmt.Sprintf("✗ Stream failed: %v", err)))
+	case status == nil:
+		r.print(name, "✓ Stream ended")
+	case status.Status != "completed":
+		r.print(name, fmt.Sprintf("<!> Stream ended, run #%d is %s", status.RunNumber, FormatStatus(status.Status)))
+	case IsFailedConclusion(status.Conclusion):
+		r.print(name, r.base.paint(color.New(color.FgRed), fmt.Sprintf("✗ Run #%d completed: %s", status.RunNumber, FormatConclusion(status.Conclusion))))
+	default:
+		r.print(name, r.base.paint(color.New(color.FgGreen), fmt.Sprintf("✓ Run #%d completed: %s", status.RunNumber, FormatConclusion(status.Conclusion))))
+	}
+}
+
+// print prints a text prefixed by a label
+func (r *MultiplexRenderer) print(label, text string) {
+	r.width = max(r.width, len(label)+2)
+	fmt.Fprintf(r.Out, "%s %s\n", r.base.paint(PrefixColor(label), fmt.Sprintf("%-*s", r.width, "["+label+"]")), text)
+}
+
+// PrefixColor helper gives the color of a prefix (the same label always has the same color).
+//
+// Parameters:
+//   - label: prefix label (eg: "build/linux")
+func PrefixColor(label string) *color.Color {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(label))
+
+	return color.New(prefixColors[hash.Sum32()%uint32(len(prefixColors))])
+}
+
+// IsFailedConclusion helper checks if a job (or step) conclusion is a failure.
+//
+// Parameters:
+//   - conclusion: job or step conclusion
+func IsFailedConclusion(conclusion string) bool {
+	switch strings.ToLower(conclusion) {
+	case "failure", "failed", "timed_out", "startup_failure":
+		return true
+	default:
+		return false
+	}
+}
+
+// FailedJob is a failed job with it's failed steps
+type FailedJob struct {
+	Job *types.WorkflowJob
+
+	// Steps are the failed steps (empty if the platform doesn't report steps)
+	Steps []*types.WorkflowStep
+}
+
+// FailureSummary collects the last lines of the failed steps and the error annotations of a run
+type FailureSummary struct {
+	Jobs []*FailedJob
+
+	// Annotations are the error annotations of the failed jobs
+	Annotations []*types.LogLine
+
+	tail  int
+	lines map[string][]*types.LogLine
+	order []string
+}
+
+// NewFailureSummary helper selects the failed jobs and steps of a run.
+//
+// Parameters:
+//   - jobs: run jobs
+//   - tail: lines kept per failed step (per failed job without steps)
+//
+// Example:
+// summary := helpers.NewFailureSummary(jobs, 50)
+func NewFailureSummary(jobs []*types.WorkflowJob, tail int) *FailureSummary {
+	summary := &FailureSummary{tail: tail, lines: make(map[string][]*types.LogLine)}
+
+	for _, job := range jobs {
+		if !IsFailedConclusion(job.Conclusion) {
+			continue
+		}
+
+		failed := &FailedJob{Job: job}
+		for _, step := range job.Steps {
+			if IsFailedConclusion(step.Conclusion) {
+				failed.Steps = append(failed.Steps, step)
+			}
+		}
+
+		summary.Jobs = append(summary.Jobs, failed)
+	}
+
+	return summary
+}
+
+// JobIDs lists the IDs of the failed jobs
+func (s *FailureSummary) JobIDs() []int64 {
+	ids := make([]int64, 0, len(s.Jobs))
+	for _, failed := range s.Jobs {
+		ids = append(ids, failed.Job.ID)
+	}
+
+	return ids
+}
+
+// Add keeps a log line if it belongs to a failed step (or to a failed job without steps).
+//
+// Parameters:
+//   - line: log line
+func (s *FailureSummary) Add(line *types.LogLine) {
+	for _, failed := range s.Jobs {
+		if failed.Job.Name != line.JobName {
+			continue
+		}
+
+		if line.Annotation != nil && line.Annotation.Level == "error" {
+			s.Annotations = append(s.Annotations, line)
+		}
+
+		if len(failed.Steps) > 0 && !slices.ContainsFunc(failed.Steps, func(step *types.WorkflowStep) bool { return step.Name == line.Step }) {
+			return
+		}
+
+		key := line.JobName + "\x00" + line.Step
+		if _, ok := s.lines[key]; !ok {
+			s.order = append(s.order, key)
+		}
+
+		s.lines[key] = append(s.lines[key], line)
+		if s.tail > 0 && len(s.lines[key]) > s.tail {
+			s.lines[key] = s.lines[key][1:]
+		}
+
+		return
+	}
+}
+
+// Lines lists the kept lines, step by step
+func (s *FailureSummary) Lines() []*types.LogLine {
+	var lines []*types.LogLine
+	for _, key := range s.order {
+		lines = append(lines, s.lines[key]...)
+	}
+
+	return lines
+}
+
+// Print prints the failed jobs and steps, their last lines and the error annotations.
+//
+// Parameters:
+//   - out: output (eg: os.Stdout)
+//   - opts: display options
+func (s *FailureSummary) Print(out io.Writer, opts LogRenderOptions) {
+	paint := func(c *color.Color, text string) string {
+		if opts.NoColor {
+			return text
+		}
+
+		return c.Sprint(text)
+	}
+	red := color.New(color.FgRed)
+
+	fmt.Fprintln(out, paint(red, fmt.Sprintf("✗ %d failed job(s)", len(s.Jobs))))
+	for _, failed := range s.Jobs {
+		fmt.Fprintf(out, "   %s %s (%s)\n", paint(red, "✗"), failed.Job.Name, failed.Job.Conclusion)
+		for _, step := range failed.Steps {
+			fmt.Fprintf(out, "      %s %s (%s)\n", paint(red, "✗"), step.Name, step.Conclusion)
+		}
+	}
+
+	renderer := NewLogRenderer(out, opts)
+	for _, line := range s.Lines() {
+		renderer.Render(line)
+	}
+
+	if len(s.Annotations) == 0 {
+		return
+	}
+
+	fmt.Fprintln(out, "\n❯ Annotations:")
+	for _, line := range s.Annotations {
+		fmt.Fprintf(out, "   %s: %s\n", line.JobName, renderer.content(line))
+	}
+}
+
+// PrintLogMatches prints the matches of a logs search, grep like ("--" separates non adjacent matches).
+//
+// Parameters:
+//   - out: output (eg: os.Stdout)
+//   - matches: search matches (in run order)
+//   - pattern: searched pattern (highlighted)
+//   - opts: display options
+//
+// Example:
+// helpers.PrintLogMatches(os.Stdout, matches, regexp.MustCompile("panic"), helpers.LogRenderOptions{})
+func PrintLogMatches(out io.Writer, matches []*platforms.LogMatch, pattern *regexp.Regexp, opts LogRenderOptions) {
+	renderer := NewLogRenderer(out, opts)
+
+	var last *types.LogLine
+	printed := make(map[*types.LogLine]bool)
+	for idx, match := range matches {
+		// overlapping context is only printed once (per run)
+		if idx > 0 && matches[idx-1].Run != match.Run {
+			printed = make(map[*types.LogLine]bool)
+		}
+
+		lines := append(append(append([]*types.LogLine(nil), match.Before...), match.Line), match.After...)
+		for pos, line := range lines {
+			if printed[line] {
+				continue
+			}
+
+			if last != nil && (pos == 0 || lines[pos-1] != last) {
+				fmt.Fprintln(out, renderer.paint(color.New(color.FgHiBlack), "--"))
+			}
+
+			prefix := fmt.Sprintf("#%d %s", match.Run.RunNumber, line.JobName)
+			if line.Step != "" {
+				prefix += " › " + line.Step
+			}
+
+			content := renderer.content(line)
+			separator := "-"
+			if line == match.Line || pattern.MatchString(line.Content) {
+				separator = ":"
+				if !opts.NoColor {
+					content = pattern.ReplaceAllStringFunc(line.Content, func(found string) string {
+						return color.New(color.Bold, color.FgRed).Sprint(found)
+					})
+				}
+			}
+
+			fmt.Fprintf(out, "%s %s%s %s\n", renderer.paint(color.New(color.FgCyan), prefix), renderer.timestamp(line), separator, content)
+			printed[line], last = true, line
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"go.yaml.in/yaml/v3"
+)
+
+// IsStructuredOutput helper reports whether a --output format replaces the text output.
+//
+// Parameters:
+//   - format: --output value
+func IsStructuredOutput(format string) bool {
+	return format != "" && format != constants.OUTPUT_TEXT
+}
+
+// ValidateOutput helper checks the --output and --columns flags.
+//
+// Parameters:
+//   - format: --output value
+//   - columns: --columns value
+//
+// Errors possible causes:
+//   - unknown format
+//   - columns without the table format
+func ValidateOutput(format string, columns []string) error {
+	switch format {
+	case "", constants.OUTPUT_TEXT, constants.OUTPUT_JSON, constants.OUTPUT_YAML, constants.OUTPUT_TABLE:
+	default:
+		return fmt.Errorf("<?> Error: Unknown output '%s' (text, json, yaml, table)", format)
+	}
+
+	if len(columns) > 0 && format != constants.OUTPUT_TABLE {
+		return fmt.Errorf("<?> Error: --columns requires --output table")
+	}
+
+	return nil
+}
+
+// WriteOutput helper writes a value in a machine-readable format.
+// json and yaml share the schema of the value's json tags, table writes a row per item.
+//
+// Parameters:
+//   - out: output (eg: os.Stdout)
+//   - format: constants.OUTPUT_JSON, constants.OUTPUT_YAML or constants.OUTPUT_TABLE
+//   - value: a struct, or a slice of structs
+//   - columns: table columns (json names), the scalar fields if empty
+//
+// Errors possible causes:
+//   - unknown format or column
+//   - value can't be encoded
+//
+// Example:
+// err := helpers.WriteOutput(os.Stdout, "json", helpers.NewOutputRuns(runs), nil)
+func WriteOutput(out io.Writer, format string, value interface{}, columns []string) error {
+	// empty lists are still lists
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice && v.IsNil() {
+		value = reflect.MakeSlice(v.Type(), 0, 0).Interface()
+	}
+
+	switch format {
+	case constants.OUTPUT_JSON:
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+
+		return encoder.Encode(value)
+	case constants.OUTPUT_YAML:
+		node, err := outputNode(value)
+		if err != nil {
+			return err
+		}
+
+		encoder := yaml.NewEncoder(out)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(node); err != nil {
+			return fmt.Errorf("<?> Error: Failed to encode the output.\n<?> Error: %w", err)
+		}
+
+		return encoder.Close()
+	case constants.OUTPUT_TABLE:
+		return WriteTable(out, value, columns)
+	default:
+		return fmt.Errorf("<?> Error: Unknown output '%s' (json, yaml, table)", format)
+	}
+}
+
+// WriteTable helper writes a value as a table, a row per item, with upper case column names.
+//
+// Parameters:
+//   - out: output (eg: os.Stdout)
+//   - value: a struct, or a slice of structs
+//   - columns: json names of the columns, the scalar fields if empty
+//
+// Errors possible causes:
+//   - unknown column
+//   - value can't be encoded
+func WriteTable(out io.Writer, value interface{}, columns []string) error {
+	fields, scalars := outputFields(value)
+
+	if len(columns) == 0 {
+		columns = scalars
+	}
+
+	for _, column := range columns {
+		if !slices.Contains(fields, column) {
+			return fmt.Errorf("<?> Error: Unknown column '%s'\n</> Info: Available columns: %s", column, strings.Join(fields, ", "))
+		}
+	}
+
+	node, err := outputNode(value)
+	if err != nil {
+		return err
+	}
+
+	rows := []*yaml.Node{node}
+	if node.Kind == yaml.SequenceNode {
+		rows = node.Content
+	}
+
+	var table bytes.Buffer
+	writer := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+
+	header := make([]string, len(columns))
+	for idx, column := range columns {
+		header[idx] = strings.ToUpper(column)
+	}
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		for idx, column := range columns {
+			cells[idx] = tableCell(row, column)
+		}
+		fmt.Fprintln(writer, strings.Join(cells, "\t"))
+	}
+
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	// empty last cells leave trailing spaces
+	for _, line := range strings.SplitAfter(table.String(), "\n") {
+		if line != "" {
+			fmt.Fprintln(out, strings.TrimRight(line, " \n"))
+		}
+	}
+
+	return nil
+}
+
+// outputNode converts a value to the yaml node of its json encoding (keeping the json names and order)
+func outputNode(value interface{}) (*yaml.Node, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to encode the output.\n<?> Error: %w", err)
+	}
+
+	// json is yaml
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to encode the output.\n<?> Error: %w", err)
+	}
+
+	node := doc.Content[0]
+	resetStyle(node)
+
+	return node, nil
+}
+
+// resetStyle drops the json flow style and quotes (the encoder quotes what must be)
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// outputFields lists the json names of the fields of a struct (or of a slice items), and the ones holding a single value
+func outputFields(value interface{}) ([]string, []string) {
+	t := reflect.TypeOf(value)
+	for t != nil && (t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	var fields, scalars []string
+	for idx := range t.NumField() {
+		field := t.Field(idx)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fields = append(fields, name)
+
+		switch field.Type.Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array, reflect.Interface, reflect.Pointer:
+			continue
+		case reflect.Struct:
+			if field.Type != reflect.TypeOf(time.Time{}) {
+				continue
+			}
+		}
+
+		scalars = append(scalars, name)
+	}
+
+	return fields, scalars
+}
+
+// tableCell is the value of a column in a row (nested values are compact json)
+func tableCell(row *yaml.Node, column string) string {
+	if row.Kind != yaml.MappingNode {
+		return ""
+	}
+
+	for idx := 0; idx+1 < len(row.Content); idx += 2 {
+		if row.Content[idx].Value != column {
+			continue
+		}
+
+		value := row.Content[idx+1]
+		if value.Kind == yaml.ScalarNode {
+			if value.Tag == "!!null" {
+				return ""
+			}
+
+			return strings.NewReplacer("\n", " ", "\t", " ").Replace(value.Value)
+		}
+
+		var decoded interface{}
+		if err := value.Decode(&decoded); err != nil {
+			return ""
+		}
+
+		data, _ := json.Marshal(decoded)
+		return string(data)
+	}
+
+	return ""
+}
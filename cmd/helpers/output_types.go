@@ -0,0 +1,156 @@
+package helpers
+
+import (
+	"time"
+
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// OutputRun is the --output schema of a run
+type OutputRun struct {
+	RunID        int64     `json:"run_id"`
+	RunNumber    int       `json:"run_number"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	Branch       string    `json:"branch"`
+	Actor        string    `json:"actor"`
+	Event        string    `json:"event"`
+	CommitSHA    string    `json:"commit_sha"`
+	TriggeredBy  string    `json:"triggered_by"`
+	CreatedAt    time.Time `json:"created_at,omitzero"`
+	UpdatedAt    time.Time `json:"updated_at,omitzero"`
+	URL          string    `json:"url"`
+	WorkflowName string    `json:"workflow"`
+}
+
+// OutputStatus is the --output schema of a run status
+type OutputStatus struct {
+	RunID       int64     `json:"run_id"`
+	RunNumber   int       `json:"run_number"`
+	Status      string    `json:"status"`
+	Conclusion  string    `json:"conclusion"`
+	StartedAt   time.Time `json:"started_at,omitzero"`
+	CompletedAt time.Time `json:"completed_at,omitzero"`
+
+	// Duration is in seconds
+	Duration float64 `json:"duration"`
+
+	URL      string                 `json:"url"`
+	Branch   string                 `json:"branch"`
+	QueuedAt time.Time              `json:"queued_at,omitzero"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// OutputWorkflow is the --output schema of a workflow
+type OutputWorkflow struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Path         string `json:"path"`
+	State        string `json:"state"`
+	URL          string `json:"url"`
+	WithDispatch bool   `json:"with_dispatch,omitempty"`
+}
+
+// OutputTrigger is the --output schema of a triggered run
+type OutputTrigger struct {
+	RunID         int64     `json:"run_id"`
+	RunNumber     int       `json:"run_number"`
+	URL           string    `json:"url"`
+	Status        string    `json:"status"`
+	QueuedAt      time.Time `json:"queued_at,omitzero"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+}
+
+// NewOutputRuns helper converts runs to their --output schema.
+//
+// Parameters:
+//   - runs: runs of the platform
+//
+// Example:
+// err := helpers.WriteOutput(os.Stdout, "json", helpers.NewOutputRuns(runs), nil)
+func NewOutputRuns(runs []*types.Run) []*OutputRun {
+	out := make([]*OutputRun, len(runs))
+	for idx, run := range runs {
+		out[idx] = &OutputRun{
+			RunID:        run.RunID,
+			RunNumber:    run.RunNumber,
+			Status:       run.Status,
+			Conclusion:   run.Conclusion,
+			Branch:       run.Branch,
+			Actor:        run.Actor,
+			Event:        run.Event,
+			CommitSHA:    run.CommitSHA,
+			TriggeredBy:  run.TriggeredBy,
+			CreatedAt:    run.CreatedAt,
+			UpdatedAt:    run.UpdatedAt,
+			URL:          run.URL,
+			WorkflowName: run.WorkflowName,
+		}
+	}
+
+	return out
+}
+
+// NewOutputStatus helper converts a run status to its --output schema.
+//
+// Parameters:
+//   - status: status of the run
+//
+// Example:
+// err := helpers.WriteOutput(os.Stdout, "json", helpers.NewOutputStatus(status), nil)
+func NewOutputStatus(status *types.Status) *OutputStatus {
+	return &OutputStatus{
+		RunID:       status.RunID,
+		RunNumber:   status.RunNumber,
+		Status:      status.Status,
+		Conclusion:  status.Conclusion,
+		StartedAt:   status.StartedAt,
+		CompletedAt: status.CompletedAt,
+		Duration:    status.Duration.Seconds(),
+		URL:         status.URL,
+		Branch:      status.Branch,
+		QueuedAt:    status.QueuedAt,
+		Metadata:    status.Metadata,
+	}
+}
+
+// NewOutputWorkflows helper converts workflows to their --output schema.
+//
+// Parameters:
+//   - workflows: workflows of the platform
+//
+// Example:
+// err := helpers.WriteOutput(os.Stdout, "json", helpers.NewOutputWorkflows(workflows), nil)
+func NewOutputWorkflows(workflows []*types.Workflow) []*OutputWorkflow {
+	out := make([]*OutputWorkflow, len(workflows))
+	for idx, wf := range workflows {
+		out[idx] = &OutputWorkflow{
+			ID:           wf.ID,
+			Name:         wf.Name,
+			Path:         wf.Path,
+			State:        wf.State,
+			URL:          wf.URL,
+			WithDispatch: wf.WithDispatch,
+		}
+	}
+
+	return out
+}
+
+// NewOutputTrigger helper converts a trigger response to its --output schema.
+//
+// Parameters:
+//   - resp: trigger response
+//
+// Example:
+// err := helpers.WriteOutput(os.Stdout, "json", helpers.NewOutputTrigger(resp), nil)
+func NewOutputTrigger(resp *types.TriggerResponse) *OutputTrigger {
+	return &OutputTrigger{
+		RunID:         resp.RunID,
+		RunNumber:     resp.RunNumber,
+		URL:           resp.URL,
+		Status:        resp.Status,
+		QueuedAt:      resp.QueuedAt,
+		CorrelationID: resp.CorrelationID,
+	}
+}
@@ -0,0 +1,188 @@
+package helpers
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+// PROMPT_MAX_OPTIONS is the maximum number of options listed at once (the rest is reached by filtering)
+const PROMPT_MAX_OPTIONS = 20
+
+// Option is a selectable value with its display label
+type Option struct {
+	Value string
+	Label string
+}
+
+// Prompter asks questions on a line based terminal
+type Prompter struct {
+	In  *bufio.Reader
+	Out io.Writer
+}
+
+// NewPrompter helper creates a prompter.
+//
+// Parameters:
+//   - in: answers input (eg: os.Stdin)
+//   - out: questions output (eg: os.Stdout)
+func NewPrompter(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{In: bufio.NewReader(in), Out: out}
+}
+
+// IsInteractive helper checks if both stdin and stdout are terminals.
+//
+// Parameters:
+//   - None
+func IsInteractive() bool {
+	isTerminal := func(f *os.File) bool {
+		return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+	}
+
+	return isTerminal(os.Stdin) && isTerminal(os.Stdout)
+}
+
+// readLine reads a trimmed answer
+func (p *Prompter) readLine() (string, error) {
+	line, err := p.In.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", fmt.Errorf("<?> Error: No answer.\n<?> Error: %w", err)
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+// Select helper asks to pick an option, by number or by typing a filter.
+//
+// Parameters:
+//   - label: question
+//   - options: selectable options
+//   - def: default value (optional, picked on an empty answer)
+func (p *Prompter) Select(label string, options []Option, def string) (string, error) {
+	if len(options) == 0 {
+		return "", fmt.Errorf("<?> Error: Nothing to select for: %s", label)
+	}
+
+	filter := ""
+	for {
+		var filtered []Option
+		for _, option := range options {
+			if filter == "" || strings.Contains(strings.ToLower(option.Value+" "+option.Label), strings.ToLower(filter)) {
+				filtered = append(filtered, option)
+			}
+		}
+
+		if len(filtered) == 0 {
+			fmt.Fprintf(p.Out, "<!> Warn:  Nothing matches %q\n", filter)
+			filter = ""
+			continue
+		}
+
+		fmt.Fprintf(p.Out, "❯ %s\n", label)
+		for idx, option := range filtered {
+			if idx == PROMPT_MAX_OPTIONS {
+				fmt.Fprintf(p.Out, "   ... %d more (type to filter)\n", len(filtered)-idx)
+				break
+			}
+
+			marker := " "
+			if option.Value == def {
+				marker = "*"
+			}
+
+			if option.Label != "" && option.Label != option.Value {
+				fmt.Fprintf(p.Out, " %s %2d) %s  %s\n", marker, idx+1, option.Value, option.Label)
+			} else {
+				fmt.Fprintf(p.Out, " %s %2d) %s\n", marker, idx+1, option.Value)
+			}
+		}
+
+		if def != "" {
+			fmt.Fprintf(p.Out, "   Number, or text to filter [%s]: ", def)
+		} else {
+			fmt.Fprint(p.Out, "   Number, or text to filter: ")
+		}
+
+		answer, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case answer == "" && def != "":
+			return def, nil
+		case answer == "" && len(filtered) == 1:
+			return filtered[0].Value, nil
+		case answer == "":
+			continue
+		}
+
+		if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(filtered) {
+			return filtered[n-1].Value, nil
+		}
+
+		for _, option := range options {
+			if option.Value == answer {
+				return option.Value, nil
+			}
+		}
+
+		filter = answer
+	}
+}
+
+// Ask helper asks for a free value, until it's valid.
+//
+// Parameters:
+//   - label: question
+//   - def: default value (optional, used on an empty answer)
+//   - validate: value validation (optional)
+func (p *Prompter) Ask(label, def string, validate func(string) error) (string, error) {
+	for {
+		if def != "" {
+			fmt.Fprintf(p.Out, "❯ %s [%s]: ", label, def)
+		} else {
+			fmt.Fprintf(p.Out, "❯ %s: ", label)
+		}
+
+		answer, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+
+		if answer == "" {
+			answer = def
+		}
+
+		if validate != nil {
+			if err := validate(answer); err != nil {
+				fmt.Fprintf(p.Out, "<?> Error: %v\n", err)
+				continue
+			}
+		}
+
+		return answer, nil
+	}
+}
+
+// Confirm helper asks a yes/no question (no by default).
+//
+// Parameters:
+//   - label: question
+func (p *Prompter) Confirm(label string) (bool, error) {
+	fmt.Fprintf(p.Out, "❯ %s [y/N]: ", label)
+
+	answer, err := p.readLine()
+	if err != nil {
+		return false, err
+	}
+
+	answer = strings.ToLower(answer)
+
+	return answer == "y" || answer == "yes", nil
+}
@@ -0,0 +1,100 @@
+package helpers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Screen draws full-screen frames, only rewriting the lines that changed since the previous frame.
+type Screen struct {
+	Out io.Writer
+
+	// previous is the last drawn frame (nil forces a full redraw)
+	previous      []string
+	width, height int
+}
+
+// NewScreen helper creates a full-screen display.
+//
+// Parameters:
+//   - out: terminal output (eg: os.Stdout)
+func NewScreen(out io.Writer) *Screen {
+	return &Screen{Out: out}
+}
+
+// Enter helper switches to the alternate screen and hides the cursor.
+//
+// Parameters:
+//   - None
+func (s *Screen) Enter() {
+	fmt.Fprint(s.Out, "\033[?1049h\033[?25l\033[2J")
+	s.previous = nil
+}
+
+// Leave helper shows the cursor and restores the normal screen.
+//
+// Parameters:
+//   - None
+func (s *Screen) Leave() {
+	fmt.Fprint(s.Out, "\033[?25h\033[?1049l")
+}
+
+// Draw helper draws a frame, lines are cut to the screen size.
+//
+// Parameters:
+//   - lines: frame lines
+//   - width: screen columns
+//   - height: screen rows
+//
+// Returns the number of rewritten lines.
+func (s *Screen) Draw(lines []string, width, height int) int {
+	var b strings.Builder
+
+	// everything moves on resize
+	if s.previous == nil || width != s.width || height != s.height {
+		b.WriteString("\033[2J")
+		s.previous = nil
+	}
+
+	frame := make([]string, min(len(lines), height))
+	for idx := range frame {
+		frame[idx] = cutLine(lines[idx], width)
+	}
+
+	rewritten := 0
+	for idx := 0; idx < max(len(frame), len(s.previous)); idx++ {
+		line := ""
+		if idx < len(frame) {
+			line = frame[idx]
+		}
+
+		if s.previous != nil && idx < len(s.previous) && s.previous[idx] == line {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\033[%d;1H\033[2K%s", idx+1, line)
+		rewritten++
+	}
+
+	fmt.Fprint(s.Out, b.String())
+
+	s.previous = frame
+	s.width, s.height = width, height
+
+	return rewritten
+}
+
+// cutLine cuts a line to width runes
+func cutLine(line string, width int) string {
+	runes := []rune(line)
+	if len(runes) <= width {
+		return line
+	}
+
+	if width <= 1 {
+		return string(runes[:max(width, 0)])
+	}
+
+	return string(runes[:width-1]) + "…"
+}
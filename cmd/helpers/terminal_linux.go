@@ -0,0 +1,9 @@
+package helpers
+
+import "golang.org/x/sys/unix"
+
+// termios requests of linux
+const (
+	ioctlReadTermios  = unix.TCGETS
+	ioctlWriteTermios = unix.TCSETS
+)
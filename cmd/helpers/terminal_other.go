@@ -0,0 +1,27 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd
+
+package helpers
+
+import (
+	"errors"
+	"os"
+)
+
+// errNoRawMode is returned where the terminal raw mode isn't supported
+var errNoRawMode = errors.New("<?> Error: The terminal raw mode isn't supported on this platform")
+
+// MakeRaw helper puts a terminal in raw mode (not supported on this platform).
+//
+// Parameters:
+//   - f: terminal (eg: os.Stdin)
+func MakeRaw(f *os.File) (func() error, error) {
+	return nil, errNoRawMode
+}
+
+// TerminalSize helper is the number of columns and rows of a terminal (not supported on this platform).
+//
+// Parameters:
+//   - f: terminal (eg: os.Stdout)
+func TerminalSize(f *os.File) (int, int, error) {
+	return 0, 0, errNoRawMode
+}
@@ -0,0 +1,54 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+
+package helpers
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// MakeRaw helper puts a terminal in raw mode: keys are read one by one, without echo nor signals.
+//
+// Parameters:
+//   - f: terminal (eg: os.Stdin)
+//
+// Returns the function restoring the previous mode.
+func MakeRaw(f *os.File) (func() error, error) {
+	fd := int(f.Fd())
+
+	previous, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to read the terminal mode.\n<?> Error: %w", err)
+	}
+
+	raw := *previous
+	raw.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	raw.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	raw.Cflag &^= unix.CSIZE | unix.PARENB
+	raw.Cflag |= unix.CS8
+	raw.Cc[unix.VMIN] = 1
+	raw.Cc[unix.VTIME] = 0
+
+	if err := unix.IoctlSetTermios(fd, ioctlWriteTermios, &raw); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to set the terminal raw mode.\n<?> Error: %w", err)
+	}
+
+	return func() error {
+		return unix.IoctlSetTermios(fd, ioctlWriteTermios, previous)
+	}, nil
+}
+
+// TerminalSize helper is the number of columns and rows of a terminal.
+//
+// Parameters:
+//   - f: terminal (eg: os.Stdout)
+func TerminalSize(f *os.File) (int, int, error) {
+	size, err := unix.IoctlGetWinsize(int(f.Fd()), unix.TIOCGWINSZ)
+	if err != nil {
+		return 0, 0, fmt.Errorf("<?> Error: Failed to get the terminal size.\n<?> Error: %w", err)
+	}
+
+	return int(size.Col), int(size.Row), nil
+}
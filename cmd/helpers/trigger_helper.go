@@ -0,0 +1,33 @@
+package helpers
+
+import (
+	"github.com/ignorant05/Uniflow/cmd/constants"
+)
+
+// ConclusionExitCode helper maps the conclusion of a completed run onto trigger --wait exit code.
+//
+// Parameters:
+//   - conclusion: run conclusion
+func ConclusionExitCode(conclusion string) int {
+	switch conclusion {
+	case "success", "skipped", "neutral":
+		return constants.EXIT_SUCCESS
+	case "cancelled":
+		return constants.EXIT_CANCELLED
+	default:
+		return constants.EXIT_FAILURE
+	}
+}
+
+// CancelExitCode helper maps the conclusion of a cancelled run onto cancel --wait exit code.
+// NOTE: the run being cancelled is the expected outcome
+//
+// Parameters:
+//   - conclusion: run conclusion
+func CancelExitCode(conclusion string) int {
+	if conclusion == "cancelled" {
+		return constants.EXIT_SUCCESS
+	}
+
+	return ConclusionExitCode(conclusion)
+}
@@ -8,11 +8,13 @@ import (
 	"strings"
 	"syscall"
 
+	"github.com/ignorant05/Uniflow/cmd/constants"
 	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/internal/cache"
 	"github.com/ignorant05/Uniflow/internal/config"
 	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	"github.com/ignorant05/Uniflow/internal/redact"
 	"github.com/ignorant05/Uniflow/platforms"
-	ghlogs "github.com/ignorant05/Uniflow/platforms/configurations/github/logs"
 	"github.com/ignorant05/Uniflow/types"
 	"github.com/spf13/cobra"
 )
@@ -23,6 +25,14 @@ var (
 	// UTILITY: specify workflow run id
 	runID int64
 
+	// --run-id flag (given several times)
+	// UTILITY: stream several runs at once
+	runIDs []int64
+
+	// --all-running flag
+	// UTILITY: stream all the running runs at once
+	allRunning bool
+
 	// --job flag
 	// UTILITY: real-time log streaming
 	jobName string
@@ -47,13 +57,49 @@ var (
 	// UTILITY: specify platform
 	platformFlag string
 
-	// --output (-o) flag
-	// UTILITY: output file name
-	output string
+	// --dest flag
+	// UTILITY: file name of the downloaded archive
+	logsDest string
 
 	// --verbose flag
 	// UTILITY: logsVerbose output
 	logsVerbose bool
+
+	// --collapse-groups flag
+	// UTILITY: fold log groups into a single line
+	collapseGroups bool
+
+	// --step flag
+	// UTILITY: only show a step
+	stepName string
+
+	// --failed flag
+	// UTILITY: only show the failed jobs and steps
+	failedOnly bool
+
+	// --extract flag
+	// UTILITY: unpack the downloaded archive
+	extractLogs bool
+
+	// --no-cache flag
+	// UTILITY: always fetch the logs from the platform
+	noCache bool
+
+	// --no-redact flag
+	// UTILITY: show the logs as is (secrets aren't masked)
+	noRedact bool
+
+	// --format flag
+	// UTILITY: output format of the logs (text, jsonl, html)
+	logsFormat string
+
+	// --report flag
+	// UTILITY: write a report of the run (junit)
+	reportFormat string
+
+	// --report-file flag
+	// UTILITY: file of the report
+	reportFile string
 )
 
 // Command: logs (or l)
@@ -70,11 +116,15 @@ You can use this to debug issues or monitor workflow progress.
 
 Features:
 	• Real-time log streaming with --follow
+	• Several runs streamed at once (--run-id repeated, --all-running)
 	• Colored output for different log levels
 	• Timestamps for each log line
 	• Tail support to limit output
+	• Step headers, foldable groups and annotations (file:line)
 	• Graceful handling of Ctrl+C
 	• Auto-detection of run completion
+	• Logs of completed runs are cached (~/.uniflow/cache/logs), so they work offline
+	• Secrets are masked (profile tokens, GitHub/AWS keys, JWTs, private keys and redact rules)
 
 Example:
 	# Latest run logs
@@ -90,7 +140,27 @@ Example:
 	uniflow logs deploy.yml --no-color
 
 	# Specific job
-	uniflow logs deploy.yml --job build`,
+	uniflow logs deploy.yml --job build
+
+	# Several runs at once, lines prefixed by [workflow/job]
+	uniflow logs --follow --run-id 123456 --run-id 123457
+	uniflow logs --follow --all-running
+
+	# A single step, with groups folded
+	uniflow logs deploy.yml --step "Run tests" --collapse-groups
+
+	# What failed (last 50 lines of each failed step)
+	uniflow logs deploy.yml --failed
+
+	# Download the logs, unpacked one file per step
+	uniflow logs deploy.yml --download --extract
+
+	# Export the logs (one JSON object per line, or a HTML page)
+	uniflow logs deploy.yml --format jsonl > deploy.jsonl
+	uniflow logs deploy.yml --format html > deploy.html
+
+	# JUnit report of the job and step conclusions
+	uniflow logs deploy.yml --report junit --report-file junit.xml`,
 	Args: cobra.MaximumNArgs(1),
 	Run:  runLogsCmd,
 }
@@ -98,16 +168,29 @@ Example:
 // Commands and subcommnds declaration
 func init() {
 	// Flags declaration
-	logsCmd.Flags().Int64Var(&runID, "run-id", 0, "Specific run ID")
+	logsCmd.Flags().Int64SliceVar(&runIDs, "run-id", nil, "Specific run ID (repeat it to stream several runs at once)")
+	logsCmd.Flags().BoolVar(&allRunning, "all-running", false, "Stream the logs of all the running runs at once (of the workflow if given)")
 	logsCmd.Flags().StringVarP(&jobName, "job", "j", "", "Specific job name")
-	logsCmd.Flags().StringVarP(&output, "output", "o", "", "download file name for logs")
-	logsCmd.Flags().BoolVarP(&followLogs, "follow", "f", false, "Follow logs (not implemented)")
+	logsCmd.Flags().StringVar(&logsDest, "dest", "", "File name of the downloaded logs archive")
+	logsCmd.Flags().BoolVarP(&followLogs, "follow", "f", false, "Follow logs in real time")
 	logsCmd.Flags().BoolVarP(&logsVerbose, "verbose", "v", false, "verbose output")
 	logsCmd.Flags().IntVarP(&tailLines, "tail", "t", 0, "Show last N lines (0 = all)")
-	logsCmd.Flags().BoolVarP(&downloadOnly, "download-only", "d", false, "Just show download URL")
+	logsCmd.Flags().BoolVarP(&downloadOnly, "download", "d", false, "Download the logs archive (~/.uniflow/logs, see --dest)")
+	logsCmd.Flags().BoolVar(&downloadOnly, "download-only", false, "Download the logs archive")
+	logsCmd.Flags().BoolVar(&extractLogs, "extract", false, "Unpack the downloaded archive into a directory, with an index (see logs open)")
 	logsCmd.Flags().BoolVar(&noColor, "no-color", false, "Disable colored output")
+	logsCmd.Flags().BoolVar(&collapseGroups, "collapse-groups", false, "Fold log groups into a single line (errors are still shown)")
+	logsCmd.Flags().StringVar(&stepName, "step", "", "Only show the steps whose name contains this")
+	logsCmd.Flags().BoolVar(&failedOnly, "failed", false, "Only show the failed jobs and steps (last lines and error annotations)")
+	logsCmd.Flags().BoolVar(&noCache, "no-cache", false, "Don't use the logs cache (logs of completed runs are cached)")
+	logsCmd.Flags().BoolVar(&noRedact, "no-redact", false, "Don't mask secrets (tokens, keys) in the logs (also skips the cache)")
+	logsCmd.Flags().StringVar(&logsFormat, "format", constants.LOGS_FORMAT_TEXT, "Output format: text, jsonl (one JSON object per line) or html (self-contained page)")
+	logsCmd.Flags().StringVar(&reportFormat, "report", "", "Write a report of the job and step conclusions: junit")
+	logsCmd.Flags().StringVar(&reportFile, "report-file", constants.DEFAULT_REPORT_FILE, "File of the --report")
 	logsCmd.Flags().StringVarP(&platformFlag, "platform", "p", "github", "Platform (github, jenkins, gitlab, circleci). The default is github")
 
+	_ = logsCmd.Flags().MarkDeprecated("download-only", "use --download instead")
+
 	// root command
 	rootCmd.AddCommand(logsCmd)
 }
@@ -119,26 +202,46 @@ func runLogsCmd(cmd *cobra.Command, args []string) {
 		fmt.Println("<!> Info: logsVerbose mode enabled")
 	}
 
-	// only works for github for now
-	if platformFlag != "github" {
-		fmt.Printf("<?> Error: Platform '%s' not yet supported. Currently only 'github' is available.\n", platformFlag)
-		fmt.Println("<!> Warning: Jenkins, GitLab, CircleCI aren't supported yet.\n</> Info: Feel free to contribute if you want them in please.")
+	if !platforms.IsPlatformSupported(platformFlag) {
+		fmt.Printf("<?> Error: Platform '%s' not supported.\n", platformFlag)
+		fmt.Printf("</> Info: Supported platforms: %s\n", strings.Join(platforms.ListSupportedPlatforms(), ", "))
+		return
+	}
+
+	if err := validateLogsFormat(); err != nil {
+		errorhandling.HandleError(err)
 		return
 	}
 
+	multiplexed := len(runIDs) > 1 || allRunning
+	if multiplexed {
+		if err := validateMultiplexedLogs(); err != nil {
+			errorhandling.HandleError(err)
+			return
+		}
+	} else if len(runIDs) == 1 {
+		runID = runIDs[0]
+	}
+
 	ctx := context.Background()
-	cfg, err := config.Load()
+	client, err := newLogsClient(ctx, cmd)
 	if err != nil {
 		errorhandling.HandleError(err)
+		return
 	}
 
-	factory := platforms.NewFactory(cfg)
+	if multiplexed {
+		renderOpts := helpers.LogRenderOptions{
+			NoColor:        noColor,
+			CollapseGroups: collapseGroups,
+			Step:           stepName,
+			Format:         logsFormat,
+		}
 
-	// create new client with profileName
-	client, err := factory.CreateClientAutoDetectPlatform(ctx, profileName)
-	if err != nil {
-		errMsg := fmt.Errorf("<?> Error: Field to create client.\n<?> Error: %w", err)
-		errorhandling.HandleError(errMsg)
+		if err := streamMultiplexedLogs(ctx, client, args, renderOpts); err != nil {
+			errorhandling.HandleError(err)
+		}
+		return
 	}
 
 	owner, repo := client.GetRepository(ctx)
@@ -156,9 +259,46 @@ func runLogsCmd(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	if downloadOnly || extractLogs {
+		workflowRunLogsReq := types.LogsRequest{
+			RunID:        targetRunID,
+			WorkflowName: workflowFile,
+			DownloadPath: logsDest,
+			Extract:      extractLogs,
+			Tail:         tailLines,
+		}
+
+		resp, err := client.ListWorkflowRunLogs(ctx, &workflowRunLogsReq)
+		if err != nil {
+			errorhandling.HandleError(err)
+			return
+		}
+
+		printLogsDownload(resp)
+		return
+	}
+
+	renderOpts := helpers.LogRenderOptions{
+		NoColor:        noColor,
+		CollapseGroups: collapseGroups,
+		Step:           stepName,
+		Format:         logsFormat,
+	}
+
+	if failedOnly {
+		if err := showFailedLogs(ctx, client, targetRunID, tailLines, renderOpts); err != nil {
+			errorhandling.HandleError(err)
+		}
+		writeLogsReport(ctx, client, targetRunID, workflowName)
+		return
+	}
+
 	// For --follow, verify workflow is still running
 	if followLogs {
-		statusReq := types.StatusRequest{}
+		statusReq := types.StatusRequest{
+			Name:  workflowFile,
+			RunID: targetRunID,
+		}
 
 		status, err := client.GetStatus(ctx, &statusReq)
 		if err != nil {
@@ -175,50 +315,360 @@ func runLogsCmd(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	// Print header
-	if workflowName != "" {
-		fmt.Printf("❯ %s workflow: %s (Run #%d)\n\n",
-			map[bool]string{true: "Streaming", false: "Viewing"}[followLogs],
-			workflowName, targetRunID)
-	} else {
-		fmt.Printf("❯ %s run ID: %d\n\n",
-			map[bool]string{true: "Streaming", false: "Viewing"}[followLogs],
-			targetRunID)
+	// Print header (exports only hold the logs)
+	if logsFormat == constants.LOGS_FORMAT_TEXT {
+		if workflowName != "" {
+			fmt.Printf("❯ %s workflow: %s (Run #%d)\n\n",
+				map[bool]string{true: "Streaming", false: "Viewing"}[followLogs],
+				workflowName, targetRunID)
+		} else {
+			fmt.Printf("❯ %s run ID: %d\n\n",
+				map[bool]string{true: "Streaming", false: "Viewing"}[followLogs],
+				targetRunID)
+		}
+	}
+
+	streamReq := types.LogsStreamRequest{
+		RunID:   targetRunID,
+		Follow:  followLogs,
+		NoColor: noColor,
+		Tail:    tailLines,
+
+		WorkflowName: workflowFile,
+	}
+
+	if err := streamPlatformLogs(client, &streamReq, renderOpts); err != nil {
+		errorhandling.HandleError(err)
+		return
+	}
+
+	writeLogsReport(ctx, client, targetRunID, workflowName)
+}
+
+// printLogsDownload shows where the downloaded (and extracted) logs are
+//
+// Parameters:
+//   - resp: response of the download
+func printLogsDownload(resp *types.LogsResponse) {
+	switch {
+	case resp.Archive != "":
+		fmt.Printf("✓ Downloaded %d KB of logs to %s\n", resp.Size/1024, resp.Archive)
+		fmt.Printf("✓ Extracted %d step(s) to %s\n\n", len(resp.Files)-1, resp.Path)
+	case len(resp.Files) > 1:
+		fmt.Printf("✓ Downloaded %d KB of logs (%d jobs) to %s\n\n", resp.Size/1024, len(resp.Files), resp.Path)
+	default:
+		fmt.Printf("✓ Downloaded %d KB of logs to %s\n\n", resp.Size/1024, resp.Path)
+	}
+}
+
+// validateLogsFormat checks the --format and --report values
+//
+// Errors possible causes:
+//   - unknown format or report
+//   - --output json, yaml or table (use --format jsonl)
+func validateLogsFormat() error {
+	// the logs are a stream, not a document
+	if helpers.IsStructuredOutput(outputFormat) {
+		return fmt.Errorf("<?> Error: logs doesn't support --output %s\n</> Info: Use --format jsonl for machine-readable logs", outputFormat)
+	}
+
+	switch logsFormat {
+	case constants.LOGS_FORMAT_TEXT, constants.LOGS_FORMAT_JSONL, constants.LOGS_FORMAT_HTML:
+	default:
+		return fmt.Errorf("<?> Error: Unknown format '%s' (text, jsonl, html)", logsFormat)
+	}
+
+	if reportFormat != "" && reportFormat != constants.LOGS_REPORT_JUNIT {
+		return fmt.Errorf("<?> Error: Unknown report '%s' (junit)", reportFormat)
+	}
+
+	return nil
+}
+
+// writeLogsReport writes the --report of a run (if any)
+// NOTE: the messages go to stderr, stdout may hold an export
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - targetRunID: run to report
+//   - workflowName: report name (the run ID if empty)
+func writeLogsReport(ctx context.Context, client platforms.PlatformClient, targetRunID int64, workflowName string) {
+	if reportFormat == "" {
+		return
 	}
 
-	// Extract GitHub client for streamer
-	githubClient, err := helpers.ExtractGithubClient(client)
+	jobs, err := client.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{RunID: targetRunID})
 	if err != nil {
-		errorhandling.HandleError(fmt.Errorf("streaming only supported for GitHub: %w", err))
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to list the run jobs.\n<?> Error: %w", err))
 		return
 	}
 
-	// Create streamer
-	streamer := ghlogs.NewStreamer(
-		githubClient,
-		owner,
-		repo,
-		targetRunID,
-		ghlogs.StreamerOptions{
-			Follow:    followLogs,
-			TailLines: tailLines,
-			Colorize:  !noColor,
-		})
+	if workflowName == "" {
+		workflowName = fmt.Sprintf("Run #%d", targetRunID)
+	}
 
-	// Handle graceful shutdown
-	setupGracefulShutdown(streamer)
+	file, err := os.Create(reportFile)
+	if err != nil {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to create the report.\n<?> Error: %w", err))
+		return
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "<!> warning: failed to close file: %v\n", err)
+		}
+	}()
 
-	// Stream or display logs
-	if err := streamer.Stream(); err != nil {
-		errorhandling.HandleError(err)
+	report := helpers.NewJUnitReport(workflowName, jobs)
+	if err := helpers.WriteJUnitReport(file, report); err != nil {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to write the report.\n<?> Error: %w", err))
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "✓ JUnit report written to %s (%d test(s), %d failure(s), %d skipped)\n", reportFile, report.Tests, report.Failures, report.Skipped)
+}
+
+// newLogsClient creates the client of the logs commands, with the logs cache
+// NOTE: an explicit --platform wins over auto-detection
+//
+// Parameters:
+//   - ctx: the context variable
+//   - cmd: logs command (or subcommand)
+//
+// Errors possible causes:
+//   - invalid configuration
+//   - cannot create the client
+func newLogsClient(ctx context.Context, cmd *cobra.Command) (platforms.PlatformClient, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	client, platform, err := newPlatformClient(ctx, cmd, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cache only holds redacted logs
+	if noRedact {
+		return client, nil
+	}
+
+	redactor, err := logsRedactor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client = platforms.NewRedactingClient(client, redactor)
+	if noCache {
+		return client, nil
+	}
+
+	store, err := cache.NewLogsCache()
+	if err != nil {
+		fmt.Printf("<!> Warn:  Logs cache disabled: %v\n", err)
+		return client, nil
+	}
+
+	// cached logs are redacted again, in case rules were added since
+	return platforms.NewRedactingClient(platforms.NewCachedClient(client, store, platform), redactor), nil
+}
+
+// newPlatformClient creates the client of the --profile, on the --platform if given (auto-detected otherwise)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - cmd: command declaring the --platform flag
+//   - cfg: configuration
+//
+// Returns the client and the platform name.
+//
+// Errors possible causes:
+//   - platform can't be detected
+//   - cannot create the client
+func newPlatformClient(ctx context.Context, cmd *cobra.Command, cfg *config.Config) (platforms.PlatformClient, string, error) {
+	factory := platforms.NewFactory(cfg)
+
+	platform := platformFlag
+	if !cmd.Flags().Changed("platform") {
+		var err error
+		if platform, err = factory.DetectPlatform(profileName); err != nil {
+			return nil, "", fmt.Errorf("<?> Error: Field to create client.\n<?> Error: %w", err)
+		}
+	}
+
+	client, err := factory.CreateClientForProfile(ctx, platform, profileName)
+	if err != nil {
+		return nil, "", fmt.Errorf("<?> Error: Field to create client.\n<?> Error: %w", err)
+	}
+
+	return client, platform, nil
+}
+
+// loadPlatformClient loads the configuration and creates the client of the selected profile and platform
+func loadPlatformClient(ctx context.Context, cmd *cobra.Command) (platforms.PlatformClient, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	client, _, err := newPlatformClient(ctx, cmd, cfg)
+	return client, err
+}
+
+// logsRedactor creates the redactor of the displayed and saved logs (nil with --no-redact)
+// NOTE: without a configuration, only the built-in detectors are used
+//
+// Parameters:
+//   - cfg: configuration (optional)
+//
+// Errors possible causes:
+//   - invalid redaction rule
+func logsRedactor(cfg *config.Config) (*redact.Redactor, error) {
+	if noRedact {
+		return nil, nil
+	}
+
+	if cfg == nil {
+		return redact.New(nil, nil), nil
+	}
+
+	return redact.FromConfig(cfg)
+}
+
+// streamPlatformLogs streams logs through PlatformClient.StreamLogs and prints every line (until Ctrl+C)
+//
+// Parameters:
+//   - client: platform client
+//   - streamReq: the stream request
+//   - renderOpts: display options
+//
+// Errors possible causes:
+//   - invalid runID
+//   - cannot retrieve logs (either deosn't exist or internal problem)
+func streamPlatformLogs(client platforms.PlatformClient, streamReq *types.LogsStreamRequest, renderOpts helpers.LogRenderOptions) error {
+	ctx, cancel := interruptibleContext(context.Background())
+	defer cancel()
+
+	writer, err := helpers.NewLogWriter(os.Stdout, streamReq.RunID, renderOpts)
+	if err != nil {
+		return err
+	}
+
+	renderer, isText := writer.(*helpers.LogRenderer)
+	if streamReq.Follow && isText {
+		fmt.Println("  Following logs (press Ctrl+C to stop)...")
+	}
+
+	var callback types.LogCallback = writer.Write
+
+	// what was streamed is written, even when interrupted
+	err = client.StreamLogs(ctx, streamReq, &callback)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if isText && renderOpts.Step != "" && renderer.Matched == 0 {
+		fmt.Printf("<!> Warn:  No step matching %q (steps are only reported by some platforms)\n", renderOpts.Step)
+	}
+
+	return nil
+}
+
+// interruptibleContext creates a context cancelled on Ctrl+C (or SIGTERM)
+//
+// Parameters:
+//   - parent: parent context
+func interruptibleContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		defer signal.Stop(sigChan)
+
+		select {
+		case <-sigChan:
+			fmt.Println("\n\n<!> Warning: Received interrupt signal...")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// showFailedLogs prints the failed jobs and steps of a run, with their last lines and error annotations
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - targetRunID: run to summarize
+//   - tail: lines shown per failed step (0 = default)
+//   - renderOpts: display options
+//
+// Errors possible causes:
+//   - cannot retrieve the jobs or the logs
+func showFailedLogs(ctx context.Context, client platforms.PlatformClient, targetRunID int64, tail int, renderOpts helpers.LogRenderOptions) error {
+	jobs, err := client.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{RunID: targetRunID})
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to list the run jobs.\n<?> Error: %w", err)
+	}
+
+	if tail <= 0 {
+		tail = constants.DEFAULT_FAILED_TAIL
+	}
+
+	summary := helpers.NewFailureSummary(jobs, tail)
+	if len(summary.Jobs) == 0 {
+		fmt.Printf("✓ No failed jobs in run %d\n", targetRunID)
+		return nil
+	}
+
+	streamReq := types.LogsStreamRequest{
+		RunID:   targetRunID,
+		NoColor: renderOpts.NoColor,
+		JobIDs:  summary.JobIDs(),
+	}
+
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		summary.Add(line)
+		return nil
 	}
 
+	if err := client.StreamLogs(ctx, &streamReq, &callback); err != nil {
+		return err
+	}
+
+	if renderOpts.Format == "" || renderOpts.Format == constants.LOGS_FORMAT_TEXT {
+		summary.Print(os.Stdout, renderOpts)
+		return nil
+	}
+
+	// exports only hold the kept lines
+	writer, err := helpers.NewLogWriter(os.Stdout, targetRunID, renderOpts)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range summary.Lines() {
+		if err := writer.Write(line); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
 }
 
 // resolveRunID retrieves workflow runID (and name)
 //
 // Parameters:
-//   - client: github client
+//   - client: platform client
 //   - owner: owner name
 //   - repo: repository name
 //   - args: arguments from command
@@ -255,28 +705,10 @@ func resolveRunID(ctx context.Context, client platforms.PlatformClient, owner, r
 			return 0, "", fmt.Errorf("<?> Error: Invalid workflow")
 		}
 
-		workflowRunLogsReq := types.LogsRequest{
-			RunID: workflowID,
-			Tail:  tailLines,
-		}
-
-		logsURL, err := client.ListWorkflowRunLogs(ctx, &workflowRunLogsReq)
-		if err != nil {
-			return 0, "", err
-		}
-
-		if downloadOnly {
-			err := ghlogs.DownloadLogs(logsURL.URL, output)
-			if err != nil {
-				return 0, "", err
-			}
-		}
-
 		listWorkflowRunsReq := types.ListWorkflowRunsRequest{
 			RunID:        workflowID,
 			WorkflowName: workflowFile,
-			Branch:       branch,
-			Limit:        tailLines,
+			Limit:        1,
 		}
 
 		runs, err := client.ListWorkflowRuns(ctx, &listWorkflowRunsReq)
@@ -289,15 +721,3 @@ func resolveRunID(ctx context.Context, client platforms.PlatformClient, owner, r
 
 	return 0, "", fmt.Errorf("<?> Error: Please specify either a workflow name or use --run-id")
 }
-
-func setupGracefulShutdown(s *ghlogs.Streamer) {
-	// making new channel with buffer
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
-
-	go func() {
-		<-sigChan
-		fmt.Println("\n\n<!> Warning: Received interrupt signal...")
-		s.Stop()
-	}()
-}
@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// validateMultiplexedLogs checks the flags of several runs streamed at once
+//
+// Errors possible causes:
+//   - --run-id and --all-running are both given
+//   - a flag needs a single run (--failed, --download, --extract, --report, --format html)
+func validateMultiplexedLogs() error {
+	switch {
+	case allRunning && len(runIDs) > 0:
+		return fmt.Errorf("<?> Error: --run-id and --all-running are exclusive")
+	case failedOnly || downloadOnly || extractLogs:
+		return fmt.Errorf("<?> Error: --failed, --download and --extract need a single run")
+	case reportFormat != "":
+		return fmt.Errorf("<?> Error: --report needs a single run")
+	case logsFormat == constants.LOGS_FORMAT_HTML:
+		return fmt.Errorf("<?> Error: --format html needs a single run")
+	}
+
+	return nil
+}
+
+// streamMultiplexedLogs streams several runs at once (until they complete or Ctrl+C),
+// lines are prefixed by their run and job and each run reports it's completion status
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - args: arguments from command (workflow of --all-running)
+//   - renderOpts: display options
+//
+// Errors possible causes:
+//   - cannot retrieve the runs
+//   - no running runs (--all-running)
+//   - every stream failed
+func streamMultiplexedLogs(ctx context.Context, client platforms.PlatformClient, args []string, renderOpts helpers.LogRenderOptions) error {
+	streams, err := resolveRunStreams(ctx, client, args)
+	if err != nil {
+		return err
+	}
+
+	isText := renderOpts.Format == "" || renderOpts.Format == constants.LOGS_FORMAT_TEXT
+	if isText {
+		names := make([]string, 0, len(streams))
+		for _, stream := range streams {
+			names = append(names, stream.Name)
+		}
+
+		fmt.Printf("❯ %s %d run(s): %s\n\n",
+			map[bool]string{true: "Streaming", false: "Viewing"}[followLogs],
+			len(streams), strings.Join(names, ", "))
+
+		if followLogs {
+			fmt.Println("  Following logs (press Ctrl+C to stop)...")
+		}
+	}
+
+	ctx, cancel := interruptibleContext(ctx)
+	defer cancel()
+
+	// exports get a writer per run (the lines carry their run)
+	renderer := helpers.NewMultiplexRenderer(os.Stdout, renderOpts)
+	writers := make(map[*platforms.RunStream]helpers.LogWriter)
+	if !isText {
+		for _, stream := range streams {
+			writer, err := helpers.NewLogWriter(os.Stdout, stream.Run.RunID, renderOpts)
+			if err != nil {
+				return err
+			}
+			writers[stream] = writer
+		}
+	}
+
+	var callback platforms.MultiplexCallback = func(stream *platforms.RunStream, line *types.LogLine) error {
+		if isText {
+			renderer.Render(stream.Name, line)
+			return nil
+		}
+
+		return writers[stream].Write(line)
+	}
+
+	done := func(result *platforms.StreamResult) {
+		if isText {
+			renderer.Done(result.Stream.Name, result.Status, result.Err)
+		} else if result.Err != nil {
+			fmt.Fprintf(os.Stderr, "<!> Warn:  %s: %v\n", result.Stream.Name, result.Err)
+		}
+	}
+
+	streamReq := types.LogsStreamRequest{
+		Follow:  followLogs,
+		NoColor: noColor,
+		Tail:    tailLines,
+	}
+
+	results := platforms.MultiplexLogs(ctx, client, streams, streamReq, callback, done)
+
+	for _, writer := range writers {
+		if err := writer.Close(); err != nil {
+			return err
+		}
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+
+	if failed == len(results) && ctx.Err() == nil {
+		return fmt.Errorf("<?> Error: Failed to stream the logs of the %d run(s)", len(results))
+	}
+
+	return nil
+}
+
+// resolveRunStreams retrieves the runs streamed at once (--run-id given several times, or --all-running)
+// NOTE: runs are named after their workflow, runs of the same workflow get their run number
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - args: arguments from command (workflow of --all-running)
+//
+// Errors possible causes:
+//   - cannot retrieve a run (or the workflows)
+//   - no running runs (--all-running)
+func resolveRunStreams(ctx context.Context, client platforms.PlatformClient, args []string) ([]*platforms.RunStream, error) {
+	var streams []*platforms.RunStream
+
+	if allRunning {
+		workflows, err := client.ListWorkflows(ctx, &types.ListWorkflowsRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, wf := range workflows {
+			if len(args) > 0 && !strings.HasSuffix(wf.Path, args[0]) {
+				continue
+			}
+
+			workflowName := wf.Path
+			if workflowName == "" {
+				workflowName = wf.Name
+			}
+
+			runs, err := client.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{WorkflowName: workflowName})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "<!> Warn:  Cannot list the runs of %s: %v\n", wf.Name, err)
+				continue
+			}
+
+			for _, run := range runs {
+				if run.Status != "completed" {
+					streams = append(streams, &platforms.RunStream{Run: run, Name: wf.Name})
+				}
+			}
+		}
+
+		if len(streams) == 0 {
+			return nil, fmt.Errorf("<?> Error: No running workflow runs")
+		}
+	}
+
+	for _, id := range runIDs {
+		status, err := client.GetStatus(ctx, &types.StatusRequest{RunID: id})
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Cannot retrieve run %d.\n<?> Error: %w", id, err)
+		}
+
+		stream := &platforms.RunStream{
+			Run: &types.Run{RunID: id, RunNumber: status.RunNumber, Status: status.Status, Conclusion: status.Conclusion},
+		}
+
+		// the workflow name is only known by the jobs
+		if jobs, err := client.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{RunID: id}); err == nil && len(jobs) > 0 {
+			stream.Name = jobs[0].WorkflowName
+		}
+
+		streams = append(streams, stream)
+	}
+
+	uniqueStreamNames(streams)
+
+	return streams, nil
+}
+
+// uniqueStreamNames appends the run number to the names shared by several runs (or empty)
+//
+// Parameters:
+//   - streams: runs streamed at once
+func uniqueStreamNames(streams []*platforms.RunStream) {
+	counts := make(map[string]int)
+	for _, stream := range streams {
+		counts[stream.Name]++
+	}
+
+	for _, stream := range streams {
+		if stream.Name != "" && counts[stream.Name] == 1 {
+			continue
+		}
+
+		number := int64(stream.Run.RunNumber)
+		if number == 0 {
+			number = stream.Run.RunID
+		}
+
+		stream.Name = fmt.Sprintf("%s#%d", stream.Name, number)
+	}
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/internal/config"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	"github.com/ignorant05/Uniflow/internal/redact"
+	ghlogs "github.com/ignorant05/Uniflow/platforms/configurations/github/logs"
+	"github.com/spf13/cobra"
+)
+
+// Command: logs (or l)
+// subcommand: open
+//
+// Example usage:
+//   - uniflow logs open ~/.uniflow/logs/deploy.zip build "Run tests"
+var logsOpenCmd = &cobra.Command{
+	Use:   "open <archive> [job] [step]",
+	Short: "Browse a downloaded logs archive",
+	Long: `Open lists the jobs and steps of a logs archive (downloaded with --download, or extracted with --extract),
+and prints a job or a step like the streamed logs.
+
+Without a job, the steps are listed (and picked from a menu in a terminal).
+
+Example:
+	# List the jobs and steps
+	uniflow logs open ~/.uniflow/logs/deploy.zip
+
+	# Print a whole job
+	uniflow logs open ~/.uniflow/logs/deploy build
+
+	# Print a step (the step name may be partial)
+	uniflow logs open ~/.uniflow/logs/deploy.zip build "tests" --collapse-groups`,
+	Args: cobra.RangeArgs(1, 3),
+	Run:  runLogsOpenCmd,
+}
+
+// Commands and subcommnds declaration
+func init() {
+	// Flags declaration
+	logsOpenCmd.Flags().BoolVar(&noColor, "no-color", false, "Disable colored output")
+	logsOpenCmd.Flags().BoolVar(&noRedact, "no-redact", false, "Don't mask secrets (tokens, keys) in the logs")
+	logsOpenCmd.Flags().BoolVar(&collapseGroups, "collapse-groups", false, "Fold log groups into a single line (errors are still shown)")
+
+	// logs command
+	logsCmd.AddCommand(logsOpenCmd)
+}
+
+// runLogsOpenCmd
+func runLogsOpenCmd(cmd *cobra.Command, args []string) {
+	archive, err := ghlogs.OpenArchive(args[0])
+	if err != nil {
+		errorhandling.HandleError(err)
+		return
+	}
+	defer func() {
+		if err := archive.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close logs archive: %v", err)
+		}
+	}()
+
+	var job, step string
+	if len(args) > 1 {
+		job = args[1]
+	}
+	if len(args) > 2 {
+		step = args[2]
+	}
+
+	if job == "" {
+		printArchiveIndex(os.Stdout, args[0], archive.Index)
+
+		if !helpers.IsInteractive() {
+			return
+		}
+
+		if job, step, err = promptArchiveStep(helpers.NewPrompter(os.Stdin, os.Stdout), archive.Index); err != nil {
+			errorhandling.HandleError(err)
+			return
+		}
+	}
+
+	// archives may have been saved with --no-redact (without a configuration, only the built-in detectors are used)
+	cfg, _ := config.Load()
+	redactor, err := logsRedactor(cfg)
+	if err != nil {
+		errorhandling.HandleError(err)
+		return
+	}
+
+	if err := printArchiveLogs(os.Stdout, archive, job, step, redactor, helpers.LogRenderOptions{NoColor: noColor, CollapseGroups: collapseGroups}); err != nil {
+		errorhandling.HandleError(err)
+	}
+}
+
+// printArchiveIndex lists the jobs and steps of an archive
+func printArchiveIndex(out io.Writer, name string, index *ghlogs.ArchiveIndex) {
+	fmt.Fprintf(out, "❯ %s: %d job(s)\n", name, len(index.Jobs))
+
+	for _, job := range index.Jobs {
+		fmt.Fprintf(out, "\n  %s\n", job.Name)
+
+		for _, step := range job.Steps {
+			if step.Name == "" {
+				fmt.Fprintf(out, "     (whole job, %d lines)\n", step.Lines)
+				continue
+			}
+
+			fmt.Fprintf(out, "     %2d. %s (%d lines)\n", step.Number, step.Name, step.Lines)
+		}
+	}
+}
+
+// promptArchiveStep picks a step of an archive (the option values are "<job index>/<step index>")
+func promptArchiveStep(prompter *helpers.Prompter, index *ghlogs.ArchiveIndex) (string, string, error) {
+	var options []helpers.Option
+	for jobIdx, job := range index.Jobs {
+		for stepIdx, step := range job.Steps {
+			label := job.Name
+			if step.Name != "" {
+				label += " › " + step.Name
+			}
+
+			options = append(options, helpers.Option{Value: strconv.Itoa(jobIdx) + "/" + strconv.Itoa(stepIdx), Label: label})
+		}
+	}
+
+	fmt.Fprintln(prompter.Out)
+	value, err := prompter.Select("Step", options, "")
+	if err != nil {
+		return "", "", err
+	}
+
+	var jobIdx, stepIdx int
+	if _, err := fmt.Sscanf(value, "%d/%d", &jobIdx, &stepIdx); err != nil {
+		return "", "", fmt.Errorf("<?> Error: Invalid step selection: %s", value)
+	}
+
+	job := index.Jobs[jobIdx]
+	return job.Name, job.Steps[stepIdx].Name, nil
+}
+
+// printArchiveLogs prints a step of an archive (the whole job without a step), through the logs renderer
+//
+// Parameters:
+//   - out: output
+//   - archive: opened archive
+//   - job: job name
+//   - step: step name (optional, partial)
+//   - redactor: secrets redactor (optional)
+//   - renderOpts: display options
+//
+// Errors possible causes:
+//   - no such job or step
+//   - unreadable step
+func printArchiveLogs(out io.Writer, archive *ghlogs.Archive, job, step string, redactor *redact.Redactor, renderOpts helpers.LogRenderOptions) error {
+	archiveJob, archiveStep, err := archive.Find(job, step)
+	if err != nil {
+		return err
+	}
+
+	steps := archiveJob.Steps
+	if step != "" {
+		steps = []*ghlogs.ArchiveStep{archiveStep}
+	}
+
+	renderer := helpers.NewLogRenderer(out, renderOpts)
+	for _, s := range steps {
+		lines, err := archive.Lines(archiveJob, s)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			if redactor != nil {
+				line = redactor.Line(line)
+			}
+
+			renderer.Render(line)
+		}
+	}
+
+	return nil
+}
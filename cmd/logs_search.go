@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	"github.com/ignorant05/Uniflow/platforms"
+	platformsConstants "github.com/ignorant05/Uniflow/platforms/constants"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/spf13/cobra"
+)
+
+// Logs search command flags representatives
+var (
+	// --workflow flag
+	// UTILITY: workflow whose runs are searched
+	searchWorkflow string
+
+	// --last flag
+	// UTILITY: number of recent runs searched
+	searchLast int
+
+	// --branch flag
+	// UTILITY: only search the runs of a branch
+	searchBranch string
+
+	// --before (-B) flag
+	// UTILITY: context lines before a match
+	searchBefore int
+
+	// --after (-A) flag
+	// UTILITY: context lines after a match
+	searchAfter int
+
+	// --workers flag
+	// UTILITY: number of runs searched concurrently
+	searchWorkers int
+)
+
+// Command: logs (or l)
+// subcommand: search
+//
+// Example usage:
+//   - uniflow logs search "connection reset" --workflow ci.yml --last 50 --branch main
+var logsSearchCmd = &cobra.Command{
+	Use:   "search <pattern>",
+	Short: "Search logs across many runs",
+	Long: `Search greps the logs of the recent runs of a workflow with a regular expression.
+The logs of the runs are downloaded concurrently.
+
+Each match is reported with it's run number, job, step and timestamp.
+Use (?i) in the pattern for a case insensitive search.
+
+Example:
+	# Flaky test over the last 50 runs on main
+	uniflow logs search "TestUpload.*timeout" --workflow ci.yml --last 50 --branch main
+
+	# With context lines (like grep)
+	uniflow logs search "panic:" --workflow ci.yml -B 2 -A 10`,
+	Args: cobra.ExactArgs(1),
+	Run:  runLogsSearchCmd,
+}
+
+// Commands and subcommnds declaration
+func init() {
+	// Flags declaration
+	logsSearchCmd.Flags().StringVarP(&searchWorkflow, "workflow", "w", "", "Workflow whose runs are searched (eg: ci.yml)")
+	logsSearchCmd.Flags().IntVarP(&searchLast, "last", "n", 20, "Number of recent runs searched")
+	logsSearchCmd.Flags().StringVarP(&searchBranch, "branch", "b", "", "Only search the runs of this branch")
+	logsSearchCmd.Flags().IntVarP(&searchBefore, "before", "B", 0, "Lines of context before a match")
+	logsSearchCmd.Flags().IntVarP(&searchAfter, "after", "A", 0, "Lines of context after a match")
+	logsSearchCmd.Flags().IntVar(&searchWorkers, "workers", platformsConstants.SEARCH_WORKERS, "Number of runs searched concurrently")
+	logsSearchCmd.Flags().BoolVar(&noColor, "no-color", false, "Disable colored output")
+	logsSearchCmd.Flags().BoolVar(&noCache, "no-cache", false, "Don't use the logs cache (logs of completed runs are cached)")
+	logsSearchCmd.Flags().BoolVar(&noRedact, "no-redact", false, "Don't mask secrets (tokens, keys) in the logs (also skips the cache)")
+	logsSearchCmd.Flags().StringVarP(&platformFlag, "platform", "p", "github", "Platform (github, jenkins, gitlab, circleci). The default is github")
+
+	_ = logsSearchCmd.MarkFlagRequired("workflow")
+
+	// logs command
+	logsCmd.AddCommand(logsSearchCmd)
+}
+
+// runLogsSearchCmd
+func runLogsSearchCmd(cmd *cobra.Command, args []string) {
+	pattern, err := regexp.Compile(args[0])
+	if err != nil {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Invalid pattern.\n<?> Error: %w", err))
+		return
+	}
+
+	if !platforms.IsPlatformSupported(platformFlag) {
+		fmt.Printf("<?> Error: Platform '%s' not supported.\n", platformFlag)
+		fmt.Printf("</> Info: Supported platforms: %s\n", strings.Join(platforms.ListSupportedPlatforms(), ", "))
+		return
+	}
+
+	ctx := context.Background()
+	client, err := newLogsClient(ctx, cmd)
+	if err != nil {
+		errorhandling.HandleError(err)
+		return
+	}
+
+	if err := searchLogs(ctx, client, pattern); err != nil {
+		errorhandling.HandleError(err)
+	}
+}
+
+// searchLogs searches the logs of the recent runs of --workflow and prints the matches
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - pattern: searched pattern
+//
+// Errors possible causes:
+//   - cannot retrieve the workflow runs
+func searchLogs(ctx context.Context, client platforms.PlatformClient, pattern *regexp.Regexp) error {
+	runs, err := client.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{
+		WorkflowName: searchWorkflow,
+		Branch:       searchBranch,
+		Limit:        searchLast,
+	})
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to list the workflow runs.\n<?> Error: %w", err)
+	}
+
+	if len(runs) == 0 {
+		fmt.Printf("<!> Info: No runs of %s to search.\n", searchWorkflow)
+		return nil
+	}
+
+	fmt.Printf("❯ Searching %d run(s) of %s for %q\n\n", len(runs), searchWorkflow, pattern.String())
+
+	matches, failures := platforms.SearchLogs(ctx, client, runs, pattern, platforms.SearchOptions{
+		Before:  searchBefore,
+		After:   searchAfter,
+		Workers: searchWorkers,
+	})
+
+	for _, failure := range failures {
+		fmt.Printf("<!> Warn:  Run #%d not searched: %v\n", failure.Run.RunNumber, failure.Err)
+	}
+
+	helpers.PrintLogMatches(os.Stdout, matches, pattern, helpers.LogRenderOptions{NoColor: noColor})
+
+	searched := make(map[int64]bool)
+	for _, match := range matches {
+		searched[match.Run.RunID] = true
+	}
+	fmt.Printf("\n✓ %d match(es) in %d of %d run(s)\n", len(matches), len(searched), len(runs))
+
+	return nil
+}
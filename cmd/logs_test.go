@@ -1,8 +1,15 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
 )
 
 // Test logs cmd flags
@@ -15,7 +22,12 @@ func TestLogsCmdFlag(t *testing.T) {
 		{
 			name:     "run-id flag exists",
 			flagName: "run-id",
-			wantType: "int64",
+			wantType: "int64Slice",
+		},
+		{
+			name:     "all-running flag exists",
+			flagName: "all-running",
+			wantType: "bool",
 		},
 		{
 			name:     "job flag exists",
@@ -156,7 +168,7 @@ func TestLogsFlags(t *testing.T) {
 		{
 			name:         "run-id default",
 			flagName:     "run-id",
-			defaultValue: "0",
+			defaultValue: "[]",
 		},
 		{
 			name:         "job default",
@@ -183,6 +195,21 @@ func TestLogsFlags(t *testing.T) {
 			flagName:     "platform",
 			defaultValue: "github",
 		},
+		{
+			name:         "collapse-groups default",
+			flagName:     "collapse-groups",
+			defaultValue: "false",
+		},
+		{
+			name:         "step default",
+			flagName:     "step",
+			defaultValue: "",
+		},
+		{
+			name:         "failed default",
+			flagName:     "failed",
+			defaultValue: "false",
+		},
 	}
 
 	for _, tt := range tests {
@@ -254,3 +281,386 @@ func TestLogsCmdValidation(t *testing.T) {
 		})
 	}
 }
+
+// renderLogs renders the lines of a job with groups, steps and annotations
+func renderLogs(opts helpers.LogRenderOptions) string {
+	lines := []*types.LogLine{
+		{JobName: "build", Step: "Set up job", Content: "Current runner version: '2.320.0'"},
+		{JobName: "build", Step: "Run tests", Content: "Run go test ./...", Marker: types.LOG_GROUP_START, Group: "Run go test ./..."},
+		{JobName: "build", Step: "Run tests", Content: "go test ./...", Group: "Run go test ./...", Level: "info"},
+		{JobName: "build", Step: "Run tests", Content: "FAIL github.com/ignorant05/Uniflow/cmd", Group: "Run go test ./...", Level: "error"},
+		{JobName: "build", Step: "Run tests", Marker: types.LOG_GROUP_END, Group: "Run go test ./..."},
+		{JobName: "build", Step: "Run tests", Content: "undefined: foo", Level: "error", Annotation: &types.LogAnnotation{Level: "error", File: "main.go", Line: 3, Column: 5}},
+	}
+
+	out := &bytes.Buffer{}
+	writer, err := helpers.NewLogWriter(out, 42, opts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, line := range lines {
+		_ = writer.Write(line)
+	}
+	_ = writer.Close()
+
+	return out.String()
+}
+
+// Test rendering of steps, groups and annotations
+func TestLogRenderer(t *testing.T) {
+	out := renderLogs(helpers.LogRenderOptions{NoColor: true})
+
+	for _, expected := range []string{
+		"Job: build",
+		"▸ Step: Set up job",
+		"▸ Step: Run tests",
+		"▾ Run go test ./...",
+		"  go test ./...",
+		"error: main.go:3:5: undefined: foo",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("output doesn't contain %q:\n%s", expected, out)
+		}
+	}
+}
+
+// Test collapsed groups (errors are still shown)
+func TestLogRendererCollapseGroups(t *testing.T) {
+	out := renderLogs(helpers.LogRenderOptions{NoColor: true, CollapseGroups: true})
+
+	if strings.Contains(out, "  go test ./...\n") {
+		t.Errorf("folded line is shown:\n%s", out)
+	}
+
+	for _, expected := range []string{"▸ Run go test ./... (1 lines)", "  FAIL github.com/ignorant05/Uniflow/cmd"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("output doesn't contain %q:\n%s", expected, out)
+		}
+	}
+}
+
+// Test step filter
+func TestLogRendererStep(t *testing.T) {
+	out := renderLogs(helpers.LogRenderOptions{NoColor: true, Step: "tests"})
+
+	if strings.Contains(out, "Set up job") || strings.Contains(out, "runner version") {
+		t.Errorf("other steps are shown:\n%s", out)
+	}
+
+	if !strings.Contains(out, "▸ Step: Run tests") {
+		t.Errorf("step isn't shown:\n%s", out)
+	}
+}
+
+// Test the failure summary (failed steps only, last lines, error annotations)
+func TestFailureSummary(t *testing.T) {
+	jobs := []*types.WorkflowJob{
+		{ID: 1, Name: "lint", Conclusion: "success"},
+		{ID: 2, Name: "test", Conclusion: "failure", Steps: []*types.WorkflowStep{
+			{Name: "Set up job", Conclusion: "success"},
+			{Name: "Run tests", Conclusion: "failure"},
+		}},
+		{ID: 3, Name: "deploy", Conclusion: "timed_out"},
+	}
+
+	summary := helpers.NewFailureSummary(jobs, 2)
+
+	ids := summary.JobIDs()
+	if len(ids) != 2 || ids[0] != 2 || ids[1] != 3 {
+		t.Fatalf("failed jobs = %v, want [2 3]", ids)
+	}
+
+	for _, line := range []*types.LogLine{
+		{JobName: "lint", Content: "lint output"},
+		{JobName: "test", Step: "Set up job", Content: "setting up"},
+		{JobName: "test", Step: "Run tests", Content: "=== RUN TestA"},
+		{JobName: "test", Step: "Run tests", Content: "--- FAIL: TestA"},
+		{JobName: "test", Step: "Run tests", Content: "undefined: foo", Level: "error", Annotation: &types.LogAnnotation{Level: "error", File: "main.go", Line: 3}},
+		{JobName: "deploy", Content: "deploy timed out"},
+	} {
+		summary.Add(line)
+	}
+
+	var contents []string
+	for _, line := range summary.Lines() {
+		contents = append(contents, line.Content)
+	}
+
+	if got := strings.Join(contents, ","); got != "--- FAIL: TestA,undefined: foo,deploy timed out" {
+		t.Errorf("lines = %s", got)
+	}
+
+	out := &bytes.Buffer{}
+	summary.Print(out, helpers.LogRenderOptions{NoColor: true})
+
+	for _, expected := range []string{
+		"✗ 2 failed job(s)",
+		"✗ Run tests (failure)",
+		"✗ deploy (timed_out)",
+		"test: error: main.go:3: undefined: foo",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("output doesn't contain %q:\n%s", expected, out.String())
+		}
+	}
+
+	if strings.Contains(out.String(), "lint output") || strings.Contains(out.String(), "setting up") {
+		t.Errorf("lines of successful steps are shown:\n%s", out.String())
+	}
+}
+
+// Test logs search cmd flags
+func TestLogsSearchCmdFlag(t *testing.T) {
+	tests := []struct {
+		flagName  string
+		shorthand string
+		wantType  string
+	}{
+		{flagName: "workflow", shorthand: "w", wantType: "string"},
+		{flagName: "last", shorthand: "n", wantType: "int"},
+		{flagName: "branch", shorthand: "b", wantType: "string"},
+		{flagName: "before", shorthand: "B", wantType: "int"},
+		{flagName: "after", shorthand: "A", wantType: "int"},
+		{flagName: "workers", wantType: "int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.flagName, func(t *testing.T) {
+			flag := logsSearchCmd.Flags().Lookup(tt.flagName)
+			if flag == nil {
+				t.Fatalf("flag %s does not exist", tt.flagName)
+			}
+
+			if flag.Value.Type() != tt.wantType || flag.Shorthand != tt.shorthand {
+				t.Errorf("flag %s: got %s -%s, want %s -%s", tt.flagName, flag.Value.Type(), flag.Shorthand, tt.wantType, tt.shorthand)
+			}
+		})
+	}
+}
+
+// Test printing the matches of a logs search
+func TestPrintLogMatches(t *testing.T) {
+	run := &types.Run{RunID: 1, RunNumber: 7}
+	logLines := []*types.LogLine{
+		{JobName: "test", Step: "Run tests", Content: "ok a"},
+		{JobName: "test", Step: "Run tests", Content: "FAIL b"},
+		{JobName: "test", Step: "Run tests", Content: "FAIL c"},
+		{JobName: "test", Step: "Run tests", Content: "ok d"},
+		{JobName: "test", Step: "Run tests", Content: "ok e"},
+		{JobName: "test", Step: "Run tests", Content: "FAIL f"},
+	}
+
+	matches := []*platforms.LogMatch{
+		{Run: run, Line: logLines[1], Before: logLines[0:1], After: logLines[2:3]},
+		{Run: run, Line: logLines[2], Before: logLines[1:2], After: logLines[3:4]},
+		{Run: run, Line: logLines[5], Before: logLines[4:5]},
+	}
+
+	var out bytes.Buffer
+	helpers.PrintLogMatches(&out, matches, regexp.MustCompile("FAIL"), helpers.LogRenderOptions{NoColor: true})
+
+	want := strings.Join([]string{
+		"#7 test › Run tests - ok a",
+		"#7 test › Run tests : FAIL b",
+		"#7 test › Run tests : FAIL c",
+		"#7 test › Run tests - ok d",
+		"--",
+		"#7 test › Run tests - ok e",
+		"#7 test › Run tests : FAIL f",
+	}, "\n") + "\n"
+
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+// Test logs download flags
+func TestLogsDownloadFlags(t *testing.T) {
+	for _, name := range []string{"download", "extract"} {
+		flag := logsCmd.Flags().Lookup(name)
+		if flag == nil || flag.Value.Type() != "bool" {
+			t.Errorf("flag %s does not exist", name)
+		}
+	}
+
+	if flag := logsCmd.Flags().Lookup("download-only"); flag == nil || flag.Deprecated == "" {
+		t.Errorf("flag download-only should be deprecated")
+	}
+}
+
+// Test logs export flags
+func TestLogsExportFlags(t *testing.T) {
+	for _, name := range []string{"format", "report", "report-file"} {
+		flag := logsCmd.Flags().Lookup(name)
+		if flag == nil || flag.Value.Type() != "string" {
+			t.Errorf("flag %s does not exist", name)
+		}
+	}
+
+	if _, err := helpers.NewLogWriter(&bytes.Buffer{}, 42, helpers.LogRenderOptions{Format: "xml"}); err == nil {
+		t.Errorf("unknown format should fail")
+	}
+}
+
+// Test the jsonl export (one object per line, group ends are skipped)
+func TestLogWriterJSONLines(t *testing.T) {
+	out := renderLogs(helpers.LogRenderOptions{Format: "jsonl"})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("got %d lines, want 5:\n%s", len(lines), out)
+	}
+
+	var record helpers.LogRecord
+	if err := json.Unmarshal([]byte(lines[4]), &record); err != nil {
+		t.Fatalf("invalid JSON %q: %v", lines[4], err)
+	}
+
+	if record.Run != 42 || record.Job != "build" || record.Step != "Run tests" || record.Level != "error" || record.Content != "undefined: foo" {
+		t.Errorf("unexpected record: %+v", record)
+	}
+
+	if record.Annotation == nil || record.Annotation.File != "main.go" || record.Annotation.Line != 3 {
+		t.Errorf("unexpected annotation: %+v", record.Annotation)
+	}
+
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil || record.Level != "info" {
+		t.Errorf("lines without level should be info: %+v", record)
+	}
+}
+
+// Test the html export (collapsible steps and groups, escaped content)
+func TestLogWriterHTML(t *testing.T) {
+	out := renderLogs(helpers.LogRenderOptions{Format: "html"})
+
+	for _, expected := range []string{
+		"<!DOCTYPE html>",
+		"<title>Run #42 logs</title>",
+		`<details class="step">`,
+		`<details class="step" open>`,
+		`<summary>Run go test ./...</summary>`,
+		`<div class="line error">FAIL github.com/ignorant05/Uniflow/cmd</div>`,
+		`error: main.go:3:5: undefined: foo`,
+		"</html>",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("output doesn't contain %q:\n%s", expected, out)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	writer, _ := helpers.NewLogWriter(buf, 42, helpers.LogRenderOptions{Format: "html"})
+	_ = writer.Write(&types.LogLine{JobName: "build", Content: "<script>alert(1)</script>"})
+	_ = writer.Close()
+
+	if strings.Contains(buf.String(), "<script>") {
+		t.Errorf("content isn't escaped:\n%s", buf.String())
+	}
+}
+
+// Test the JUnit report of the job and step conclusions
+func TestJUnitReport(t *testing.T) {
+	jobs := []*types.WorkflowJob{
+		{Name: "lint", Conclusion: "success"},
+		{Name: "test", Conclusion: "failure", Steps: []*types.WorkflowStep{
+			{Name: "Set up job", Conclusion: "success"},
+			{Name: "Run tests", Conclusion: "failure"},
+			{Name: "Upload coverage", Conclusion: "skipped"},
+		}},
+	}
+
+	report := helpers.NewJUnitReport("CI", jobs)
+	if report.Tests != 4 || report.Failures != 1 || report.Skipped != 1 || len(report.Suites) != 2 {
+		t.Errorf("unexpected report: tests=%d failures=%d skipped=%d suites=%d", report.Tests, report.Failures, report.Skipped, len(report.Suites))
+	}
+
+	out := &bytes.Buffer{}
+	if err := helpers.WriteJUnitReport(out, report); err != nil {
+		t.Fatalf("WriteJUnitReport() error = %v", err)
+	}
+
+	for _, expected := range []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<testsuites name="CI" tests="4" failures="1" skipped="1">`,
+		`<testsuite name="lint" tests="1" failures="0" skipped="0">`,
+		`<testcase classname="lint" name="lint"></testcase>`,
+		`<testcase classname="test" name="Run tests">`,
+		`<failure message="failure" type="failure"></failure>`,
+		`<skipped message="skipped"></skipped>`,
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("report doesn't contain %q:\n%s", expected, out.String())
+		}
+	}
+}
+
+// Test multiplexed rendering (aligned "[run/job]" prefixes, step changes, completion status)
+func TestMultiplexRenderer(t *testing.T) {
+	out := &bytes.Buffer{}
+	renderer := helpers.NewMultiplexRenderer(out, helpers.LogRenderOptions{NoColor: true})
+
+	renderer.Render("build", &types.LogLine{JobName: "linux", Step: "Run tests", Content: "go test ./..."})
+	renderer.Render("deploy", &types.LogLine{JobName: "production", Content: "deploying", Level: "info"})
+	renderer.Render("build", &types.LogLine{JobName: "linux", Step: "Run tests", Content: "ok"})
+	renderer.Done("deploy", &types.Status{RunNumber: 12, Status: "completed", Conclusion: "failure"}, nil)
+
+	want := strings.Join([]string{
+		"[build/linux] ▸ Step: Run tests",
+		"[build/linux] go test ./...",
+		"[deploy/production] deploying",
+		"[build/linux]       ok",
+		"[deploy]            ✗ Run #12 completed: Failure",
+	}, "\n") + "\n"
+
+	if out.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	if helpers.PrefixColor("build/linux").Sprint("x") != helpers.PrefixColor("build/linux").Sprint("x") {
+		t.Errorf("prefix color isn't stable")
+	}
+}
+
+// Test the names of runs streamed at once are unique
+func TestUniqueStreamNames(t *testing.T) {
+	streams := []*platforms.RunStream{
+		{Run: &types.Run{RunID: 1, RunNumber: 11}, Name: "deploy"},
+		{Run: &types.Run{RunID: 2, RunNumber: 12}, Name: "deploy"},
+		{Run: &types.Run{RunID: 3, RunNumber: 3}, Name: "build"},
+		{Run: &types.Run{RunID: 4}},
+	}
+
+	uniqueStreamNames(streams)
+
+	for idx, want := range []string{"deploy#11", "deploy#12", "build", "#4"} {
+		if streams[idx].Name != want {
+			t.Errorf("stream %d name = %q, want %q", idx, streams[idx].Name, want)
+		}
+	}
+}
+
+// Test the flags needing a single run are rejected with several runs
+func TestValidateMultiplexedLogs(t *testing.T) {
+	defer func() { runIDs, allRunning, failedOnly, logsFormat = nil, false, false, "text" }()
+
+	runIDs, allRunning = []int64{1, 2}, false
+	if err := validateMultiplexedLogs(); err != nil {
+		t.Errorf("validateMultiplexedLogs() error = %v", err)
+	}
+
+	failedOnly = true
+	if err := validateMultiplexedLogs(); err == nil {
+		t.Errorf("--failed should need a single run")
+	}
+
+	failedOnly, logsFormat = false, "html"
+	if err := validateMultiplexedLogs(); err == nil {
+		t.Errorf("--format html should need a single run")
+	}
+
+	runIDs, allRunning, logsFormat = []int64{1}, true, "text"
+	if err := validateMultiplexedLogs(); err == nil {
+		t.Errorf("--run-id and --all-running should be exclusive")
+	}
+}
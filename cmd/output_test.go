@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/internal/config"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusClient lists a workflow and its runs, and records the runs request
+type statusClient struct {
+	platforms.PlatformClient
+
+	runs    []*types.Run
+	runsReq *types.ListWorkflowRunsRequest
+}
+
+func (c *statusClient) ListWorkflows(ctx context.Context, req *types.ListWorkflowsRequest) ([]*types.Workflow, error) {
+	return []*types.Workflow{{ID: 7, Name: "Deploy", Path: ".github/workflows/deploy.yml", State: "active"}}, nil
+}
+
+func (c *statusClient) ListWorkflowRuns(ctx context.Context, req *types.ListWorkflowRunsRequest) ([]*types.Run, error) {
+	c.runsReq = req
+	return c.runs, nil
+}
+
+// outputRuns are two runs of the deploy workflow
+func outputRuns() []*types.Run {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	return []*types.Run{
+		{RunID: 2, RunNumber: 48, WorkflowName: "Deploy", Branch: "main", Status: "in_progress", CreatedAt: created},
+		{RunID: 1, RunNumber: 47, WorkflowName: "Deploy", Branch: "main", Status: "completed", Conclusion: "success", CreatedAt: created.Add(-time.Hour)},
+	}
+}
+
+// Test the global output flags
+func TestOutputFlags(t *testing.T) {
+	output := rootCmd.PersistentFlags().Lookup("output")
+	require.NotNil(t, output)
+	assert.Equal(t, "o", output.Shorthand)
+	assert.Equal(t, "text", output.DefValue)
+
+	require.NotNil(t, rootCmd.PersistentFlags().Lookup("columns"))
+
+	// logs names the downloaded file with --dest, and keeps the global --output
+	require.NotNil(t, logsCmd.Flags().Lookup("dest"))
+	assert.Nil(t, logsCmd.LocalNonPersistentFlags().Lookup("output"))
+
+	// which it rejects
+	outputFormat = constants.OUTPUT_JSON
+	defer func() { outputFormat = constants.OUTPUT_TEXT }()
+
+	err := validateLogsFormat()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--format jsonl")
+}
+
+// Testing the output and columns validation
+func TestValidateOutput(t *testing.T) {
+	for _, format := range []string{"", "text", "json", "yaml", "table"} {
+		assert.NoError(t, helpers.ValidateOutput(format, nil), format)
+	}
+
+	assert.Error(t, helpers.ValidateOutput("xml", nil))
+	assert.Error(t, helpers.ValidateOutput(constants.OUTPUT_JSON, []string{"status"}))
+	assert.NoError(t, helpers.ValidateOutput(constants.OUTPUT_TABLE, []string{"status"}))
+}
+
+// Testing the json output uses the json tags
+func TestWriteOutputJSON(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, helpers.WriteOutput(&out, constants.OUTPUT_JSON, helpers.NewOutputRuns(outputRuns()), nil))
+
+	var runs []map[string]interface{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &runs))
+	require.Len(t, runs, 2)
+
+	assert.Equal(t, float64(2), runs[0]["run_id"])
+	assert.Equal(t, "Deploy", runs[0]["workflow"])
+	assert.Equal(t, "2026-10-01T12:00:00Z", runs[0]["created_at"])
+	assert.NotContains(t, runs[0], "updated_at", "zero times are omitted")
+
+	// no runs is an empty list
+	out.Reset()
+	require.NoError(t, helpers.WriteOutput(&out, constants.OUTPUT_JSON, helpers.NewOutputRuns(nil), nil))
+	assert.Equal(t, "[]\n", out.String())
+
+	// durations are in seconds
+	out.Reset()
+	status := helpers.NewOutputStatus(&types.Status{RunID: 42, Duration: 90500 * time.Millisecond})
+	require.NoError(t, helpers.WriteOutput(&out, constants.OUTPUT_JSON, status, nil))
+	assert.Contains(t, out.String(), `"duration": 90.5`)
+}
+
+// Testing the yaml output has the json schema
+func TestWriteOutputYAML(t *testing.T) {
+	var out bytes.Buffer
+	resp := &types.TriggerResponse{RunID: 42, RunNumber: 7, Status: "queued", URL: "https://ci.company.com/42", CorrelationID: "true"}
+	require.NoError(t, helpers.WriteOutput(&out, constants.OUTPUT_YAML, helpers.NewOutputTrigger(resp), nil))
+
+	assert.Equal(t, `run_id: 42
+run_number: 7
+url: https://ci.company.com/42
+status: queued
+correlation_id: "true"
+`, out.String())
+}
+
+// Testing the table output and its columns
+func TestWriteOutputTable(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, helpers.WriteOutput(&out, constants.OUTPUT_TABLE, helpers.NewOutputRuns(outputRuns()), []string{"run_number", "status", "conclusion"}))
+
+	assert.Equal(t, `RUN_NUMBER  STATUS       CONCLUSION
+48          in_progress
+47          completed    success
+`, out.String())
+
+	// the default columns are the single value fields
+	out.Reset()
+	status := helpers.NewOutputStatus(&types.Status{RunID: 42, Status: "completed", Metadata: map[string]interface{}{"attempt": 2}})
+	require.NoError(t, helpers.WriteOutput(&out, constants.OUTPUT_TABLE, status, nil))
+
+	header := strings.Fields(strings.Split(out.String(), "\n")[0])
+	assert.Equal(t, []string{"RUN_ID", "RUN_NUMBER", "STATUS", "CONCLUSION", "STARTED_AT", "COMPLETED_AT", "DURATION", "URL", "BRANCH", "QUEUED_AT"}, header)
+
+	// nested values are requested explicitly
+	out.Reset()
+	require.NoError(t, helpers.WriteOutput(&out, constants.OUTPUT_TABLE, status, []string{"run_id", "metadata"}))
+	assert.Contains(t, out.String(), `42      {"attempt":2}`)
+
+	err := helpers.WriteOutput(&out, constants.OUTPUT_TABLE, status, []string{"runid"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Available columns: run_id, run_number")
+}
+
+// Testing the runs written by status --output
+func TestRecentRuns(t *testing.T) {
+	client := &statusClient{runs: outputRuns()}
+	showAllRuns, limitRuns = false, 1
+	defer func() { limitRuns = 5 }()
+
+	runs, err := recentRuns(context.Background(), client, "deploy.yml")
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, int64(2), runs[0].RunID)
+	assert.Equal(t, int64(7), client.runsReq.RunID, "the runs of the workflow are listed")
+
+	_, err = recentRuns(context.Background(), client, "missing.yml")
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_found", platformErr.Code)
+
+	// all the workflows
+	showAllRuns = true
+	defer func() { showAllRuns = false }()
+
+	runs, err = recentRuns(context.Background(), client, "")
+	require.NoError(t, err)
+	assert.Len(t, runs, 2)
+	assert.Equal(t, int64(0), client.runsReq.RunID)
+}
+
+// Testing the platforms written by config list --output
+func TestConfigPlatforms(t *testing.T) {
+	cfg := &config.Config{
+		DefaultPlatform: "github",
+		Profiles: map[string]*config.Profile{
+			"default": {Platforms: map[string]interface{}{
+				"github":  map[string]interface{}{"token": "ghp_1234567890", "default_repository": "ignorant05/Uniflow"},
+				"jenkins": map[string]interface{}{"base_url": "https://jenkins.company.com", "username": "ci", "job_name": "deploy"},
+			}},
+		},
+	}
+
+	entries, err := configPlatforms(cfg, "default")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "github", entries[0].Platform)
+	assert.True(t, entries[0].Default)
+	assert.Equal(t, "ignorant05/Uniflow", entries[0].Settings["default_repository"])
+	assert.NotContains(t, entries[0].Settings["token"], "1234567890", "the secrets are masked")
+
+	assert.Equal(t, "jenkins", entries[1].Platform)
+	assert.False(t, entries[1].Default)
+	assert.Equal(t, "deploy", entries[1].Settings["job_name"])
+	assert.Equal(t, "https://jenkins.company.com", entries[1].Settings["base_url"])
+
+	_, err = configPlatforms(cfg, "missing")
+	assert.Error(t, err)
+}
+
+// Testing the json errors
+func TestWriteJSONError(t *testing.T) {
+	var out bytes.Buffer
+	platformErr := &types.PlatformError{Code: "not_found", Message: "Workflow not found", StatusCode: 404, Platform: "github"}
+	errorhandling.WriteJSONError(&out, fmt.Errorf("<?> Error: Failed to trigger workflow.\n<?> Error: %w", platformErr))
+
+	assert.Equal(t, `{"error":{"message":"Failed to trigger workflow. [github] not_found: Workflow not found","code":"not_found","platform":"github","status_code":404}}`+"\n", out.String())
+
+	out.Reset()
+	errorhandling.WriteJSONError(&out, errors.New("<?> Error: Failed to load.\n<?> Error: <?> Error: Unknown column 'x'\n</> Info: Available columns: a, b"))
+	assert.Equal(t, `{"error":{"message":"Failed to load. Unknown column 'x' Available columns: a, b"}}`+"\n", out.String())
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/internal/cache"
+	"github.com/ignorant05/Uniflow/internal/config"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/spf13/cobra"
+)
+
+// rerun command flags
+var (
+	// --failed-only
+	// UTILITY: only rerun the failed jobs
+	rerunFailedOnly bool
+
+	// --debug
+	// UTILITY: rerun with debug logging
+	rerunDebug bool
+)
+
+var rerunCmd = &cobra.Command{
+	Use:   "rerun <run-id>",
+	Short: "Rerun a completed workflow run",
+	Long: `Rerun runs a completed workflow run again, with the same commit and inputs.
+
+github, circleci and gitlab --failed-only rerun in place (the run ID is kept),
+jenkins and gitlab start a new run with the same variables.
+
+Example:
+	uniflow rerun 123456789
+
+	# Only rerun the failed jobs (and the jobs depending on them)
+	uniflow rerun 123456789 --failed-only
+
+	# Rerun with debug logging
+	uniflow rerun 123456789 --failed-only --debug
+
+	# Wait for the run to complete (exit code reflects the conclusion)
+	uniflow rerun 123456789 --wait --timeout 20m
+
+	# Rerun a build of another jenkins job than the configured one
+	uniflow rerun 42 --platform jenkins --workflow nightly
+
+Exit codes (with --wait):
+	0  success
+	1  uniflow error
+	2  failure
+	3  cancelled
+	4  timeout`,
+	Args: cobra.ExactArgs(1),
+	Run:  runRerunCmd,
+}
+
+func init() {
+	rerunCmd.Flags().StringVarP(&profileName, "profile", "p", "default", "Config profile to use")
+	rerunCmd.Flags().StringVar(&platformFlag, "platform", "github", "Platform (github, jenkins, gitlab, circleci). Auto-detected by default")
+	rerunCmd.Flags().StringVarP(&runWorkflow, "workflow", "w", "", "Workflow of the run (the job for jenkins, defaults to the configured job_name)")
+	rerunCmd.Flags().BoolVar(&rerunFailedOnly, "failed-only", false, "Only rerun the failed jobs")
+	rerunCmd.Flags().BoolVar(&rerunDebug, "debug", false, "Rerun with debug logging")
+	rerunCmd.Flags().BoolVar(&waitForRun, "wait", false, "Wait for the run to complete")
+	rerunCmd.Flags().DurationVar(&waitTimeout, "timeout", constants.DEFAULT_WAIT_TIMEOUT, "Maximum waiting time with --wait (0 waits forever)")
+
+	rootCmd.AddCommand(rerunCmd)
+}
+
+// rerun command main function
+func runRerunCmd(cmd *cobra.Command, args []string) {
+	id, err := parseRunID(args[0])
+	if err != nil {
+		errorhandling.HandleError(err)
+	}
+
+	ctx := context.Background()
+	cfg, err := config.Load()
+	if err != nil {
+		errorhandling.HandleError(err)
+	}
+
+	client, platform, err := newPlatformClient(ctx, cmd, cfg)
+	if err != nil {
+		errorhandling.HandleError(err)
+	}
+
+	// the cached logs of the previous attempt are dropped
+	if store, err := cache.NewLogsCache(); err == nil {
+		client = platforms.NewCachedClient(client, store, platform)
+	}
+
+	resp, err := client.Rerun(ctx, &types.RerunRequest{RunID: id, WorkflowName: runWorkflow, FailedOnly: rerunFailedOnly, Debug: rerunDebug})
+	if err != nil {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to rerun run %d.\n<?> Error: %w", id, err))
+	}
+
+	if rerunFailedOnly {
+		fmt.Printf("✓ Rerun of the failed jobs of run #%d requested\n", id)
+	} else {
+		fmt.Printf("✓ Rerun of run #%d requested\n", id)
+	}
+
+	if resp.RunID != id {
+		fmt.Printf("   New run: #%d\n", resp.RunNumber)
+	}
+
+	if resp.URL != "" {
+		fmt.Printf("   View at: %s\n", resp.URL)
+	}
+
+	fmt.Printf("   Stream with: uniflow logs --run-id %d --follow\n", resp.RunID)
+
+	if waitForRun {
+		os.Exit(waitForRunCompletion(ctx, client, &types.StatusRequest{Name: runWorkflow, RunID: resp.RunID}, waitTimeout, helpers.ConclusionExitCode))
+	}
+}
@@ -3,6 +3,13 @@ package cmd
 import (
 	"os"
 
+	"github.com/ignorant05/Uniflow/cmd/constants"
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+
+	// registers the supported platforms
+	_ "github.com/ignorant05/Uniflow/platforms/adapters"
+
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +20,14 @@ var (
 
 	// verbose output (global)
 	verbose bool
+
+	// --output (-o) (global)
+	// UTILITY: machine-readable output (json, yaml, table)
+	outputFormat string
+
+	// --columns (global)
+	// UTILITY: columns of the table output
+	outputColumns []string
 )
 
 // Uniflow command initialization
@@ -22,19 +37,41 @@ var rootCmd = &cobra.Command{
 	Long: `uniflow is a CLI tool for managing and triggering automated workflows.
 It provides commands to initialize configurations, trigger workflows, check status, and view logs.`,
 	Version: version,
+
+	PersistentPreRunE: setupOutput,
 }
 
 func Execute() {
 	err := rootCmd.Execute()
 	if err != nil {
+		if errorhandling.JSONErrors {
+			errorhandling.WriteJSONError(os.Stderr, err)
+		}
 		os.Exit(1)
 	}
 }
 
+// setupOutput validates --output and --columns, json and yaml outputs get json errors on stderr
+func setupOutput(cmd *cobra.Command, args []string) error {
+	if outputFormat == constants.OUTPUT_JSON || outputFormat == constants.OUTPUT_YAML {
+		errorhandling.JSONErrors = true
+
+		// the errors are written by Execute
+		cmd.Root().SilenceErrors = true
+		cmd.Root().SilenceUsage = true
+	}
+
+	return helpers.ValidateOutput(outputFormat, outputColumns)
+}
+
 func init() {
 	// verbose flag
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output for debugging")
 
+	// output flags
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", constants.OUTPUT_TEXT, "Output format: text, json, yaml or table (status, workflows, config list, trigger)")
+	rootCmd.PersistentFlags().StringSliceVar(&outputColumns, "columns", nil, "Columns of the table output (json field names, eg: run_id,status)")
+
 	// version
 	rootCmd.SetVersionTemplate(`{{.Version}}`)
 }
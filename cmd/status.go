@@ -3,9 +3,11 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/ignorant05/Uniflow/cmd/constants"
 	"github.com/ignorant05/Uniflow/cmd/helpers"
 	"github.com/ignorant05/Uniflow/internal/config"
 	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
@@ -27,6 +29,10 @@ var (
 	// --verbose (-v)
 	// UTILITY: verbose output
 	statusVerbose bool
+
+	// --watch (-w)
+	// UTILITY: live dashboard of the runs
+	statusWatch bool
 )
 
 // status command declaration
@@ -53,7 +59,10 @@ Example:
 	uniflow status my-workflow --limit number-of-runs-desired (default: 5 most recent) 
 
 	# Activate verbose output
-	uniflow s --verbose`,
+	uniflow s --verbose
+
+	# Live view of the runs, refreshed until q (see uniflow dashboard)
+	uniflow status --watch`,
 	Args: cobra.MaximumNArgs(1),
 	Run:  runStatusCmd,
 }
@@ -62,12 +71,32 @@ func init() {
 	statusCmd.Flags().BoolVarP(&showAllRuns, "all", "a", false, "Show all workflow runs (default: 5 most recent)")
 	statusCmd.Flags().BoolVarP(&statusVerbose, "verbose", "v", false, "Verbose output")
 	statusCmd.Flags().IntVarP(&limitRuns, "limit", "l", 5, "Number of runs to show")
+	statusCmd.Flags().BoolVarP(&statusWatch, "watch", "w", false, "Live view of the runs (same as uniflow dashboard)")
 
 	rootCmd.AddCommand(statusCmd)
 }
 
 // runStatusCmd is the main status command function
 func runStatusCmd(cmd *cobra.Command, args []string) {
+	if statusWatch {
+		limit := constants.DEFAULT_DASHBOARD_LIMIT
+		if cmd.Flags().Changed("limit") {
+			limit = limitRuns
+		}
+
+		if err := runDashboard(cmd, args, limit, constants.DEFAULT_DASHBOARD_INTERVAL); err != nil {
+			errorhandling.HandleError(err)
+		}
+		return
+	}
+
+	if helpers.IsStructuredOutput(outputFormat) {
+		if err := writeStatus(cmd, args); err != nil {
+			errorhandling.HandleError(err)
+		}
+		return
+	}
+
 	// if verbose mode is active
 	if verbose {
 		fmt.Println("Running in verbose mode...")
@@ -342,3 +371,77 @@ func showAllWorkflowsStatus(ctx context.Context, client platforms.PlatformClient
 
 	return nil
 }
+
+// writeStatus writes the recent runs in the --output format
+// (of a workflow, or of all workflows: limited by --limit unless --all)
+//
+// Parameters:
+//   - cmd: the status command
+//   - args: optional workflow file
+//
+// Errors possible causes:
+//   - invalid configuration
+//   - unknown workflow
+//   - rate limit exceeded
+func writeStatus(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	client, err := loadPlatformClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	workflow := ""
+	if len(args) > 0 {
+		workflow = args[0]
+	}
+
+	runs, err := recentRuns(ctx, client, workflow)
+	if err != nil {
+		return err
+	}
+
+	return helpers.WriteOutput(cmd.OutOrStdout(), outputFormat, helpers.NewOutputRuns(runs), outputColumns)
+}
+
+// recentRuns lists the recent runs of a workflow (or of all workflows if empty), limited by --limit unless --all
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - workflow: workflow file (optional)
+//
+// Errors possible causes:
+//   - unknown workflow
+//   - rate limit exceeded
+func recentRuns(ctx context.Context, client platforms.PlatformClient, workflow string) ([]*types.Run, error) {
+	req := types.ListWorkflowRunsRequest{Branch: branch}
+
+	if workflow != "" {
+		workflows, err := client.ListWorkflows(ctx, &types.ListWorkflowsRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		idx := slices.IndexFunc(workflows, func(wf *types.Workflow) bool {
+			return strings.HasSuffix(wf.Path, workflow)
+		})
+		if idx < 0 {
+			return nil, &types.PlatformError{Code: "not_found", Message: fmt.Sprintf("No workflow found with filename: %s", workflow)}
+		}
+
+		req.RunID = workflows[idx].ID
+		req.WorkflowName = workflow
+	}
+
+	runs, err := client.ListWorkflowRuns(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !showAllRuns && limitRuns >= 0 && len(runs) > limitRuns {
+		runs = runs[:limitRuns]
+	}
+
+	return runs, nil
+}
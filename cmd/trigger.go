@@ -2,15 +2,16 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
+	"github.com/ignorant05/Uniflow/cmd/constants"
 	"github.com/ignorant05/Uniflow/cmd/helpers"
 	"github.com/ignorant05/Uniflow/internal/config"
 	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
 	"github.com/ignorant05/Uniflow/platforms"
-
-	ghlogs "github.com/ignorant05/Uniflow/platforms/configurations/github/logs"
 	"github.com/ignorant05/Uniflow/types"
 	"github.com/spf13/cobra"
 )
@@ -40,6 +41,18 @@ var (
 	// --verbose (-v)
 	// UTILITY: verbose output
 	triggerVerbose bool
+
+	// --wait
+	// UTILITY: block until the triggered run completes
+	waitForRun bool
+
+	// --timeout
+	// UTILITY: maximum waiting time (with --wait)
+	waitTimeout time.Duration
+
+	// --correlation-input
+	// UTILITY: workflow input receiving a unique ID (to identify the triggered run)
+	correlationInput string
 )
 
 var triggerCmd = &cobra.Command{
@@ -49,9 +62,15 @@ var triggerCmd = &cobra.Command{
 	Long: `Trigger starts the execution of a specified workflow.
 You can pass the workflow name as an argument.
 
+Without a workflow, terminals get an interactive mode: pick the workflow,
+the branch and each declared input, then confirm.
+
 Example:
 	uniflow trigger deploy.yml
 
+	# Interactive mode
+	uniflow trigger
+
 	# Trigger on a specific branch
 	uniflow trigger deploy.yml --branch develop
 
@@ -59,7 +78,20 @@ Example:
 	uniflow trigger deploy.yml --input environment=prod --input version=v1.0
 
 	# Use a specific profile
-	uniflow trigger deploy.yml --profile prod`,
+	uniflow trigger deploy.yml --profile prod
+
+	# Identify the triggered run by a unique input (the workflow shows it in its run-name)
+	uniflow trigger deploy.yml --correlation-input run_id
+
+	# Wait for the run to complete (exit code reflects the conclusion)
+	uniflow trigger deploy.yml --wait --timeout 20m
+
+Exit codes (with --wait):
+	0  success
+	1  uniflow error
+	2  failure
+	3  cancelled
+	4  timeout`,
 	Args: cobra.MaximumNArgs(1),
 	Run:  runTriggerCmd,
 }
@@ -70,33 +102,30 @@ func init() {
 	triggerCmd.Flags().StringToStringVarP(&inputs, "input", "i", nil, "Workflow inputs (key=value)")
 	triggerCmd.Flags().StringVarP(&profileName, "profile", "p", "default", "Config profile to use")
 	triggerCmd.Flags().BoolVarP(&streamLogs, "stream", "s", false, "Stream workflow logs in real time")
+	triggerCmd.Flags().BoolVar(&noRedact, "no-redact", false, "Don't mask secrets (tokens, keys) in the streamed logs")
 	triggerCmd.Flags().BoolVarP(&triggerVerbose, "verbose", "v", false, "Verbose output")
+	triggerCmd.Flags().BoolVar(&waitForRun, "wait", false, "Wait for the run to complete")
+	triggerCmd.Flags().StringVar(&correlationInput, "correlation-input", "", "Workflow input receiving a unique ID, to identify the triggered run (see run-name)")
+	triggerCmd.Flags().DurationVar(&waitTimeout, "timeout", constants.DEFAULT_WAIT_TIMEOUT, "Maximum waiting time with --wait (0 waits forever)")
 
 	rootCmd.AddCommand(triggerCmd)
 }
 
 // trigger command main function
 func runTriggerCmd(cmd *cobra.Command, args []string) {
-	if len(args) < 1 {
-		errMsg := fmt.Errorf("<?> Error: Not enough arguments")
-		errorhandling.HandleError(errMsg)
+	// the prompts and streamed logs would be mixed with the --output document
+	structured := helpers.IsStructuredOutput(outputFormat)
+	if structured && streamLogs {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: --stream can't be used with --output %s", outputFormat))
 	}
 
-	workflow := args[0]
-
-	// if verbose mode active
-	if triggerVerbose {
-		fmt.Printf("<!> Info: Verbose mode enabled\n")
-		fmt.Printf("   Workflow: %s\n", workflow)
-		fmt.Printf("   Branch: %s\n", branch)
-		fmt.Printf("   Profile: %s\n", profileName)
-		if len(inputs) > 0 {
-			fmt.Printf("   Inputs: %v\n", inputs)
-		}
+	// without a workflow, terminals get the interactive mode
+	interactive := len(args) < 1
+	if interactive && (structured || !helpers.IsInteractive()) {
+		errMsg := fmt.Errorf("<?> Error: Not enough arguments")
+		errorhandling.HandleError(errMsg)
 	}
 
-	fmt.Printf("❯ Triggering workflow: %s\n", workflow)
-
 	ctx := context.Background()
 	cfg, err := config.Load()
 	if err != nil {
@@ -112,10 +141,42 @@ func runTriggerCmd(cmd *cobra.Command, args []string) {
 		errorhandling.HandleError(errMsg)
 	}
 
+	var workflow string
+	if interactive {
+		selection, err := promptTrigger(ctx, client, helpers.NewPrompter(os.Stdin, os.Stdout), branch, inputs)
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+
+		if !selection.Confirmed {
+			fmt.Println("<!> Info: Aborted")
+			return
+		}
+
+		workflow, branch, inputs = selection.Workflow, selection.Branch, selection.Inputs
+	} else {
+		workflow = args[0]
+	}
+
+	// if verbose mode active
+	if triggerVerbose && !structured {
+		fmt.Printf("<!> Info: Verbose mode enabled\n")
+		fmt.Printf("   Workflow: %s\n", workflow)
+		fmt.Printf("   Branch: %s\n", branch)
+		fmt.Printf("   Profile: %s\n", profileName)
+		if len(inputs) > 0 {
+			fmt.Printf("   Inputs: %v\n", inputs)
+		}
+	}
+
+	if !structured {
+		fmt.Printf("❯ Triggering workflow: %s\n", workflow)
+	}
+
 	owner, repo := client.GetRepository(ctx)
 
 	// if verbose mode active
-	if triggerVerbose {
+	if triggerVerbose && !structured {
 		fmt.Printf("</> Info: %s/%s\n", owner, repo)
 	}
 
@@ -125,13 +186,35 @@ func runTriggerCmd(cmd *cobra.Command, args []string) {
 		workflowInputs[key] = val
 	}
 
+	// --workflow overrides the argument
+	targetWorkflow := workflow
+	if workflowFile != "" {
+		targetWorkflow = workflowFile
+	}
+
 	triggerReqBody := types.TriggerRequest{
-		WorkflowName: workflowFile,
+		WorkflowName: targetWorkflow,
 		Branch:       branch,
 		Inputs:       workflowInputs,
+		Wait:         waitForRun,
+		Timeout:      waitTimeout,
+
+		CorrelationInput: correlationInput,
 	}
 	// trigger workflow
-	_, err = client.TriggerWorkflow(ctx, &triggerReqBody)
+	triggerResp, err := client.TriggerWorkflow(ctx, &triggerReqBody)
+
+	// invalid inputs are listed, the common issues don't apply
+	var platformErr *types.PlatformError
+	if errors.As(err, &platformErr) && platformErr.Code == "invalid_inputs" && !structured {
+		fmt.Printf("<?> Error: %s\n", platformErr.Message)
+		os.Exit(constants.EXIT_ERROR)
+	}
+
+	if err != nil && structured {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to trigger workflow.\n<?> Error: %w", err))
+	}
+
 	if err != nil {
 		fmt.Printf("<?> Error: Failed to trigger workflow.\n")
 		fmt.Printf("<?> Error: %v\n\n", err)
@@ -150,18 +233,38 @@ func runTriggerCmd(cmd *cobra.Command, args []string) {
 		errorhandling.HandleError(err)
 	}
 
+	if structured {
+		os.Exit(writeTriggerResult(ctx, client, &triggerReqBody, triggerResp))
+	}
+
 	fmt.Println("✓ Workflow triggered successfully!")
 	fmt.Printf("   Repository: %s/%s\n", owner, repo)
 	fmt.Printf("   Workflow: %s\n", workflow)
 	fmt.Printf("   Branch: %s\n", branch)
 
+	triggeredRunID := triggerResp.RunID
+	if triggeredRunID != 0 {
+		fmt.Printf("   Run: #%d (ID: %d)\n", triggerResp.RunNumber, triggeredRunID)
+	} else {
+		fmt.Println("<!> Warn:  The triggered run couldn't be identified yet.")
+		fmt.Printf("   Find it later with: uniflow status %s\n", workflow)
+	}
+
+	if triggerResp.CorrelationID != "" {
+		fmt.Printf("   Correlation ID: %s\n", triggerResp.CorrelationID)
+	}
+
 	// retrieves repository information
 	repoInfo, err := client.GetRepositoryInfo(ctx)
 	if err != nil {
 		errMsg := fmt.Errorf("<?> Error: Failed to retrieve repository %s/%s info.\n<?> Error: %w", owner, repo, err)
 		errorhandling.HandleError(errMsg)
-	} else {
+	} else if triggerResp.URL != "" {
+		fmt.Printf("   View at: %s\n", triggerResp.URL)
+	} else if client.IsGithub() {
 		fmt.Printf("   View at: %s/actions\n", repoInfo.HTMLURL)
+	} else {
+		fmt.Printf("   View at: %s\n", repoInfo.HTMLURL)
 	}
 	if len(workflowInputs) > 0 {
 		fmt.Println("\n❯ Inputs:")
@@ -170,59 +273,168 @@ func runTriggerCmd(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	if streamLogs {
+	if streamLogs && triggeredRunID != 0 {
 		fmt.Println("❯ Waiting for workflow to start...")
 
 		var runStatus string
 		// wait for 30 secs
 		for range 30 {
-			ListWorkflowRuns := types.ListWorkflowRunsRequest{
-				RunID:        runID,
-				WorkflowName: workflow,
-				Branch:       branch,
-				Limit:        1,
-			}
-			runs, err := client.ListWorkflowRuns(ctx, &ListWorkflowRuns)
-			if err == nil && runs[0].Status != "queued" {
-				runStatus = runs[0].Status
-				break
+			status, err := client.GetStatus(ctx, &types.StatusRequest{Name: targetWorkflow, RunID: triggeredRunID})
+			if err == nil {
+				runStatus = status.Status
+				if runStatus != "queued" {
+					break
+				}
 			}
 
 			time.Sleep(1 * time.Second)
 		}
 
-		// Extract GitHub client for streamer
-		githubClient, err := helpers.ExtractGithubClient(client)
-		if err != nil {
-			errorhandling.HandleError(fmt.Errorf("streaming only supported for GitHub: %w", err))
-			return
-		}
-
 		if runStatus == "in_progress" || runStatus == "queued" {
-			// Stream logs
-			streamer := ghlogs.NewStreamer(
-				githubClient,
-				owner,
-				repo,
-				runID,
-				ghlogs.StreamerOptions{
-					Follow:    true,
-					TailLines: 0,
-					Colorize:  true,
-				})
-
-			setupGracefulShutdown(streamer)
-			err := streamer.Stream()
+			streamReq := types.LogsStreamRequest{
+				RunID:  triggeredRunID,
+				Follow: true,
+
+				WorkflowName: targetWorkflow,
+				Branch:       branch,
+			}
+
+			redactor, err := logsRedactor(cfg)
 			if err != nil {
 				errorhandling.HandleError(err)
 			}
 
+			logsClient := client
+			if redactor != nil {
+				logsClient = platforms.NewRedactingClient(client, redactor)
+			}
+
+			if err := streamPlatformLogs(logsClient, &streamReq, helpers.LogRenderOptions{}); err != nil {
+				errorhandling.HandleError(err)
+			}
 		} else {
 			fmt.Println("<!> Warn:  Workflow didn't start within expected time.")
-			fmt.Printf("   View logs later with: uniflow logs --run-id %d\n", runID)
+			fmt.Printf("   View logs later with: uniflow logs --run-id %d\n", triggeredRunID)
+		}
+	} else if triggeredRunID != 0 {
+		fmt.Printf("   View logs with: uniflow logs --run-id %d\n", triggeredRunID)
+		fmt.Printf("   Or stream with: uniflow logs --run-id %d --follow\n", triggeredRunID)
+	}
+
+	if triggerReqBody.Wait {
+		os.Exit(waitForTriggeredRun(ctx, client, &triggerReqBody, triggerResp))
+	}
+}
+
+// waitForTriggeredRun waits for the triggered run to complete and returns the exit code of its conclusion
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - req: trigger request (Timeout is the maximum waiting time)
+//   - resp: trigger response
+//
+// Examples:
+// code := waitForTriggeredRun(ctx, client, &req, resp)
+func waitForTriggeredRun(ctx context.Context, client platforms.PlatformClient, req *types.TriggerRequest, resp *types.TriggerResponse) int {
+	// waiting for the latest run could gate on someone else's run
+	if resp.RunID == 0 {
+		fmt.Println("<?> Error: Can't wait for a run that couldn't be identified")
+		return constants.EXIT_ERROR
+	}
+
+	statusReq := &types.StatusRequest{Name: req.WorkflowName, RunID: resp.RunID}
+
+	return waitForRunCompletion(ctx, client, statusReq, req.Timeout, helpers.ConclusionExitCode)
+}
+
+// writeTriggerResult writes the trigger response in the --output format and returns the exit code.
+// With --wait, the status of the completed run is written instead (the exit code reflects its conclusion).
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - req: trigger request (Timeout is the maximum waiting time)
+//   - resp: trigger response
+//
+// Examples:
+// os.Exit(writeTriggerResult(ctx, client, &req, resp))
+func writeTriggerResult(ctx context.Context, client platforms.PlatformClient, req *types.TriggerRequest, resp *types.TriggerResponse) int {
+	if !req.Wait {
+		errorhandling.HandleError(helpers.WriteOutput(os.Stdout, outputFormat, helpers.NewOutputTrigger(resp), outputColumns))
+		return constants.EXIT_SUCCESS
+	}
+
+	// waiting for the latest run could gate on someone else's run
+	if resp.RunID == 0 {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Can't wait for a run that couldn't be identified"))
+	}
+
+	statusReq := &types.StatusRequest{Name: req.WorkflowName, RunID: resp.RunID}
+
+	status, err := platforms.WaitForRun(ctx, client, statusReq, req.Timeout, nil)
+	if errors.Is(err, types.ErrTimeout) {
+		errorhandling.PrintError(fmt.Errorf("<?> Error: Run didn't complete within %s", req.Timeout))
+		return constants.EXIT_TIMEOUT
+	}
+
+	if err != nil {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to wait for the run.\n<?> Error: %w", err))
+	}
+
+	errorhandling.HandleError(helpers.WriteOutput(os.Stdout, outputFormat, helpers.NewOutputStatus(status), outputColumns))
+
+	return helpers.ConclusionExitCode(status.Conclusion)
+}
+
+// waitForRunCompletion waits for a run to complete, showing its transitions, and returns the exit code of its conclusion
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - statusReq: the run to wait for
+//   - timeout: maximum waiting time (0 waits forever)
+//   - exitCode: maps the conclusion to the exit code (eg: helpers.ConclusionExitCode)
+//
+// Examples:
+// code := waitForRunCompletion(ctx, client, &types.StatusRequest{RunID: 42}, waitTimeout, helpers.ConclusionExitCode)
+func waitForRunCompletion(ctx context.Context, client platforms.PlatformClient, statusReq *types.StatusRequest, timeout time.Duration, exitCode func(conclusion string) int) int {
+	fmt.Println("❯ Waiting for the run to complete...")
+
+	start := time.Now()
+	onTransition := func(previous, current *types.Status) {
+		elapsed := time.Since(start).Round(time.Second)
+
+		switch {
+		case previous == nil:
+			fmt.Printf("❯ Run #%d: %s (%s)\n", current.RunNumber, helpers.FormatStatus(current.Status), elapsed)
+		case types.IsCompleted(current.Status):
+			fmt.Printf("❯ Run #%d: %s → %s (%s)\n", current.RunNumber, helpers.FormatStatus(previous.Status), helpers.FormatConclusion(current.Conclusion), elapsed)
+		default:
+			fmt.Printf("❯ Run #%d: %s → %s (%s)\n", current.RunNumber, helpers.FormatStatus(previous.Status), helpers.FormatStatus(current.Status), elapsed)
 		}
+	}
+
+	status, err := platforms.WaitForRun(ctx, client, statusReq, timeout, onTransition)
+	if errors.Is(err, types.ErrTimeout) {
+		fmt.Printf("<?> Error: Run didn't complete within %s\n", timeout)
+		return constants.EXIT_TIMEOUT
+	}
+
+	if err != nil {
+		errorhandling.HandleError(fmt.Errorf("<?> Error: Failed to wait for the run.\n<?> Error: %w", err))
+	}
+
+	code := exitCode(status.Conclusion)
+	if code == constants.EXIT_SUCCESS {
+		fmt.Printf("✓ Run #%d completed: %s\n", status.RunNumber, helpers.FormatConclusion(status.Conclusion))
 	} else {
-		fmt.Printf("   View logs with: uniflow logs --run-id %d\n", runID)
-		fmt.Printf("   Or stream with: uniflow logs --run-id %d --follow\n", runID)
+		fmt.Printf("✗ Run #%d completed: %s\n", status.RunNumber, helpers.FormatConclusion(status.Conclusion))
 	}
+
+	if status.URL != "" {
+		fmt.Printf("   View at: %s\n", status.URL)
+	}
+
+	return code
 }
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/platforms"
+	ghhelpers "github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// interactiveTrigger is what the interactive mode resolved
+type interactiveTrigger struct {
+	Workflow string
+	Branch   string
+	Inputs   map[string]string
+
+	// Confirmed is false if the summary was declined
+	Confirmed bool
+}
+
+// promptTrigger asks for the workflow, the branch and the inputs, then for a confirmation
+// NOTE: inputs already given (--input) aren't asked for
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - prompter: terminal prompter
+//   - defaultBranch: branch preselected when the platform can't list branches (--branch)
+//   - given: inputs already given
+//
+// Returns an error if:
+//   - no dispatchable workflow
+//   - no answer (eg: stdin closed)
+//
+// Examples:
+// selection, err := promptTrigger(ctx, client, helpers.NewPrompter(os.Stdin, os.Stdout), "main", inputs)
+func promptTrigger(ctx context.Context, client platforms.PlatformClient, prompter *helpers.Prompter, defaultBranch string, given map[string]string) (*interactiveTrigger, error) {
+	workflows, err := client.ListWorkflows(ctx, &types.ListWorkflowsRequest{WithDispatch: true})
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list workflows.\n<?> Error: %w", err)
+	}
+
+	if len(workflows) == 0 {
+		return nil, fmt.Errorf("<?> Error: No workflow with a 'workflow_dispatch' trigger")
+	}
+
+	options := make([]helpers.Option, 0, len(workflows))
+	for _, wf := range workflows {
+		value := wf.Name
+		if wf.Path != "" {
+			value = filepath.Base(wf.Path)
+		}
+
+		options = append(options, helpers.Option{Value: value, Label: wf.Name})
+	}
+
+	selection := &interactiveTrigger{Inputs: make(map[string]string, len(given))}
+	for key, val := range given {
+		selection.Inputs[key] = val
+	}
+
+	selection.Workflow, err = prompter.Select("Workflow", options, "")
+	if err != nil {
+		return nil, err
+	}
+
+	selection.Branch, err = promptBranch(ctx, client, prompter, defaultBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	if describer, ok := client.(platforms.InputsDescriber); ok {
+		declared, err := describer.DescribeInputs(ctx, selection.Workflow, selection.Branch)
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to describe %s inputs.\n<?> Error: %w", selection.Workflow, err)
+		}
+
+		for _, input := range declared {
+			if _, ok := given[input.Name]; ok {
+				continue
+			}
+
+			value, err := promptInput(prompter, input)
+			if err != nil {
+				return nil, err
+			}
+
+			if value != "" {
+				selection.Inputs[input.Name] = value
+			}
+		}
+	}
+
+	fmt.Fprintln(prompter.Out, "\n❯ Summary:")
+	fmt.Fprintf(prompter.Out, "   Workflow: %s\n", selection.Workflow)
+	fmt.Fprintf(prompter.Out, "   Branch: %s\n", selection.Branch)
+
+	keys := make([]string, 0, len(selection.Inputs))
+	for key := range selection.Inputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(prompter.Out, "   Input %s: %s\n", key, selection.Inputs[key])
+	}
+
+	selection.Confirmed, err = prompter.Confirm("Trigger this workflow?")
+	if err != nil {
+		return nil, err
+	}
+
+	return selection, nil
+}
+
+// promptBranch picks the branch among the repository branches, or asks for it if the platform can't list them
+func promptBranch(ctx context.Context, client platforms.PlatformClient, prompter *helpers.Prompter, defaultBranch string) (string, error) {
+	lister, ok := client.(platforms.BranchLister)
+	if !ok {
+		return prompter.Ask("Branch", defaultBranch, requiredValue)
+	}
+
+	branches, err := lister.ListBranches(ctx)
+	if err != nil || len(branches) == 0 {
+		return prompter.Ask("Branch", defaultBranch, requiredValue)
+	}
+
+	options := make([]helpers.Option, 0, len(branches))
+	for _, name := range branches {
+		options = append(options, helpers.Option{Value: name})
+	}
+
+	// the default branch is listed first
+	return prompter.Select("Branch", options, branches[0])
+}
+
+// promptInput asks for a declared input, empty when an optional input is skipped
+func promptInput(prompter *helpers.Prompter, input *types.WorkflowInput) (string, error) {
+	label := input.Name
+	if input.Description != "" {
+		label = fmt.Sprintf("%s (%s)", input.Name, input.Description)
+	}
+
+	def := ""
+	if input.Default != nil {
+		def = fmt.Sprint(input.Default)
+	}
+
+	if input.Type == "choice" && len(input.Options) > 0 {
+		options := make([]helpers.Option, 0, len(input.Options))
+		for _, option := range input.Options {
+			options = append(options, helpers.Option{Value: option})
+		}
+
+		return prompter.Select(label, options, def)
+	}
+
+	if input.Type == "boolean" && def == "" {
+		def = "false"
+	}
+
+	return prompter.Ask(label, def, func(value string) error {
+		if value == "" {
+			if input.Required {
+				return fmt.Errorf("input %q is required", input.Name)
+			}
+
+			return nil
+		}
+
+		_, err := ghhelpers.CoerceInput(input, value)
+		return err
+	})
+}
+
+// requiredValue rejects empty answers
+func requiredValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("a value is required")
+	}
+
+	return nil
+}
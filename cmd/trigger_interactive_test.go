@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/cmd/helpers"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// interactiveClient only implements what the interactive mode uses
+type interactiveClient struct {
+	platforms.PlatformClient
+}
+
+func (c *interactiveClient) ListWorkflows(ctx context.Context, req *types.ListWorkflowsRequest) ([]*types.Workflow, error) {
+	return []*types.Workflow{
+		{Name: "CI", Path: ".github/workflows/ci.yml"},
+		{Name: "Deploy", Path: ".github/workflows/deploy.yml"},
+	}, nil
+}
+
+func (c *interactiveClient) ListBranches(ctx context.Context) ([]string, error) {
+	return []string{"main", "develop", "release/v1"}, nil
+}
+
+func (c *interactiveClient) DescribeInputs(ctx context.Context, workflow, branch string) ([]*types.WorkflowInput, error) {
+	return []*types.WorkflowInput{
+		{Name: "environment", Type: "choice", Required: true, Options: []string{"dev", "prod"}, Default: "dev"},
+		{Name: "dry_run", Type: "boolean"},
+		{Name: "replicas", Type: "number", Required: true},
+		{Name: "version", Description: "Version to deploy"},
+	}, nil
+}
+
+// Testing the selection by number, by filter and by default
+func TestPrompterSelect(t *testing.T) {
+	options := []helpers.Option{{Value: "ci.yml"}, {Value: "deploy.yml"}, {Value: "docs.yml"}}
+
+	tests := []struct {
+		name     string
+		answers  string
+		def      string
+		expected string
+	}{
+		{name: "by number", answers: "2\n", expected: "deploy.yml"},
+		{name: "by default", answers: "\n", def: "docs.yml", expected: "docs.yml"},
+		{name: "by exact value", answers: "ci.yml\n", expected: "ci.yml"},
+		{name: "filter then number", answers: "d\n2\n", expected: "docs.yml"},
+		{name: "filter to a single option", answers: "depl\n\n", expected: "deploy.yml"},
+		{name: "no match resets the filter", answers: "nothing\n1\n", expected: "ci.yml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prompter := helpers.NewPrompter(strings.NewReader(tt.answers), &bytes.Buffer{})
+
+			value, err := prompter.Select("Workflow", options, tt.def)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+// Testing that closed inputs stop the prompts
+func TestPrompterNoAnswer(t *testing.T) {
+	prompter := helpers.NewPrompter(strings.NewReader(""), &bytes.Buffer{})
+
+	_, err := prompter.Ask("Branch", "main", nil)
+	assert.Error(t, err)
+
+	_, err = prompter.Select("Workflow", []helpers.Option{{Value: "ci.yml"}, {Value: "deploy.yml"}}, "")
+	assert.Error(t, err)
+}
+
+// Testing the whole interactive flow
+func TestPromptTrigger(t *testing.T) {
+	answers := strings.Join([]string{
+		"deploy",  // workflow filter (single match)
+		"",        // accept it
+		"develop", // branch
+		"2",       // environment: prod
+		"maybe",   // dry_run: invalid boolean, asked again
+		"true",    // dry_run
+		"",        // replicas: required, asked again
+		"3",       // replicas
+		"y",       // confirmation
+	}, "\n") + "\n"
+
+	out := &bytes.Buffer{}
+	prompter := helpers.NewPrompter(strings.NewReader(answers), out)
+
+	selection, err := promptTrigger(context.Background(), &interactiveClient{}, prompter, "main", map[string]string{"version": "v1.0"})
+	require.NoError(t, err)
+
+	assert.True(t, selection.Confirmed)
+	assert.Equal(t, "deploy.yml", selection.Workflow)
+	assert.Equal(t, "develop", selection.Branch)
+	assert.Equal(t, map[string]string{
+		"environment": "prod",
+		"dry_run":     "true",
+		"replicas":    "3",
+		"version":     "v1.0",
+	}, selection.Inputs)
+
+	assert.Contains(t, out.String(), `input "dry_run" must be a boolean`)
+	assert.Contains(t, out.String(), `input "replicas" is required`)
+	assert.NotContains(t, out.String(), "Version to deploy")
+}
+
+// Testing that declining the summary isn't confirmed
+func TestPromptTriggerDeclined(t *testing.T) {
+	answers := "1\n\n\n\n1\n\nn\n"
+	prompter := helpers.NewPrompter(strings.NewReader(answers), &bytes.Buffer{})
+
+	selection, err := promptTrigger(context.Background(), &interactiveClient{}, prompter, "main", nil)
+	require.NoError(t, err)
+
+	assert.False(t, selection.Confirmed)
+	assert.Equal(t, "ci.yml", selection.Workflow)
+	assert.Equal(t, "main", selection.Branch)
+}
@@ -32,6 +32,16 @@ func TestTriggerFlags(t *testing.T) {
 			flagName:     "profile",
 			defaultValue: "default",
 		},
+		{
+			name:         "wait flag",
+			flagName:     "wait",
+			defaultValue: "false",
+		},
+		{
+			name:         "timeout flag",
+			flagName:     "timeout",
+			defaultValue: "30m0s",
+		},
 	}
 
 	for _, tt := range tests {
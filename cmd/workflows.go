@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ignorant05/Uniflow/cmd/helpers"
 	"github.com/ignorant05/Uniflow/internal/config"
 	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
 	"github.com/ignorant05/Uniflow/platforms"
@@ -51,6 +52,10 @@ func init() {
 
 // runWorkflows is the main function for status command
 func runWorkflows(cmd *cobra.Command, args []string) error {
+	if helpers.IsStructuredOutput(outputFormat) {
+		return writeWorkflows(cmd)
+	}
+
 	// if verbose mode is active
 	if workflowsVerbose {
 		fmt.Println("<!> Info: Verbose mode enabled")
@@ -137,3 +142,27 @@ func runWorkflows(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// writeWorkflows writes the workflows in the --output format
+//
+// Parameters:
+//   - cmd: the workflows command
+//
+// Errors possible causes:
+//   - invalid configuration
+//   - failed to list workflows
+func writeWorkflows(cmd *cobra.Command) error {
+	ctx := context.Background()
+
+	client, err := loadPlatformClient(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	workflows, err := client.ListWorkflows(ctx, &types.ListWorkflowsRequest{WithDispatch: wfWithDispatch})
+	if err != nil {
+		return err
+	}
+
+	return helpers.WriteOutput(cmd.OutOrStdout(), outputFormat, helpers.NewOutputWorkflows(workflows), outputColumns)
+}
@@ -0,0 +1,335 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	constants "github.com/ignorant05/Uniflow/internal/constants/cache"
+	"github.com/ignorant05/Uniflow/internal/helpers"
+)
+
+// Cache is an on-disk, content-addressed store with size-based LRU eviction
+// NOTE: the last access time of an entry is it's modification time (refreshed on Get).
+// The size is measured on the first write, then tracked, the entries are only walked again to evict
+type Cache struct {
+	Dir string
+
+	// MaxSize is the size above which the least recently used entries are evicted (0 = no limit)
+	MaxSize int64
+
+	mu    sync.Mutex
+	size  int64
+	sized bool
+}
+
+// Stats describes the content of a cache
+type Stats struct {
+	Entries int
+	Size    int64
+	MaxSize int64
+
+	// Oldest and Newest are the least and the most recent accesses
+	Oldest time.Time
+	Newest time.Time
+}
+
+// entry is a cached file
+type entry struct {
+	path     string
+	size     int64
+	accessed time.Time
+}
+
+// New creates a cache stored in dir.
+//
+// Parameters:
+//   - dir: cache directory (created on the first write)
+//   - maxSize: eviction threshold in bytes (0 = no limit)
+//
+// Example:
+// store := cache.New("/tmp/uniflow-cache", 64<<20)
+func New(dir string, maxSize int64) *Cache {
+	return &Cache{Dir: dir, MaxSize: maxSize}
+}
+
+// NewLogsCache creates the logs cache (~/.uniflow/cache/logs).
+//
+// Errors possible causes:
+//   - home directory not found
+//
+// Example:
+// store, err := cache.NewLogsCache()
+func NewLogsCache() (*Cache, error) {
+	configDir, err := helpers.GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return New(filepath.Join(configDir, constants.DEFAULT_LOGS_CACHE_DIR_PATH), constants.DEFAULT_CACHE_MAX_SIZE), nil
+}
+
+// Key hashes the parts identifying an entry (eg: platform, repo, run and job).
+//
+// Example:
+// key := cache.Key("github", "ignorant05/Uniflow", "42", "7")
+func Key(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// path is the file of an entry (entries are spread over 256 sub directories)
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.Dir, key[:2], key)
+}
+
+// Get reads an entry (and marks it as recently used).
+//
+// Parameters:
+//   - key: entry key (see Key)
+//
+// Example:
+// data, ok := store.Get(key)
+func (c *Cache) Get(key string) ([]byte, bool) {
+	path := c.path(key)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+
+	return data, true
+}
+
+// Put writes an entry, then evicts the least recently used entries once the cache outgrows MaxSize
+// (down to 90% of MaxSize, so the next writes don't evict again).
+//
+// Parameters:
+//   - key: entry key (see Key)
+//   - data: entry content
+//
+// Errors possible causes:
+//   - cache directory not writable
+//
+// Example:
+// err := store.Put(key, data)
+func (c *Cache) Put(key string, data []byte) error {
+	path := c.path(key)
+
+	var replaced int64
+	if info, err := os.Stat(path); err == nil {
+		replaced = info.Size()
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("<?> Error: Failed to create cache directory.\n<?> Error: %w", err)
+	}
+
+	// written aside then renamed, so readers never see a partial entry
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to write cache entry.\n<?> Error: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("<?> Error: Failed to write cache entry.\n<?> Error: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("<?> Error: Failed to write cache entry.\n<?> Error: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("<?> Error: Failed to write cache entry.\n<?> Error: %w", err)
+	}
+
+	if c.MaxSize > 0 {
+		return c.grow(int64(len(data)) - replaced)
+	}
+
+	return nil
+}
+
+// grow tracks the size change of a write, and evicts once the cache outgrows MaxSize
+func (c *Cache) grow(delta int64) error {
+	c.mu.Lock()
+
+	if c.sized {
+		c.size += delta
+	} else {
+		// the first write measures the cache (the new entry included)
+		_, size, err := c.entries()
+		if err != nil {
+			c.mu.Unlock()
+			return err
+		}
+
+		c.size, c.sized = size, true
+	}
+
+	outgrown := c.size > c.MaxSize
+	c.mu.Unlock()
+
+	if !outgrown {
+		return nil
+	}
+
+	_, _, err := c.Prune(c.MaxSize / 10 * 9)
+	return err
+}
+
+// Delete removes an entry (missing entries are ignored).
+//
+// Parameters:
+//   - key: entry key (see Key)
+//
+// Errors possible causes:
+//   - cache directory not writable
+//
+// Example:
+// err := store.Delete(key)
+func (c *Cache) Delete(key string) error {
+	path := c.path(key)
+
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to delete cache entry.\n<?> Error: %w", err)
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("<?> Error: Failed to delete cache entry.\n<?> Error: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sized {
+		c.size -= info.Size()
+	}
+
+	return nil
+}
+
+// Prune evicts the least recently used entries until the cache fits in maxSize.
+//
+// Parameters:
+//   - maxSize: size to fit in, in bytes (0 empties the cache)
+//
+// Returns the number of evicted entries and the freed size.
+//
+// Example:
+// removed, freed, err := store.Prune(100 << 20)
+func (c *Cache) Prune(maxSize int64) (int, int64, error) {
+	entries, size, err := c.entries()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].accessed.Before(entries[j].accessed)
+	})
+
+	var (
+		removed int
+		freed   int64
+	)
+	for _, e := range entries {
+		if size <= maxSize {
+			break
+		}
+
+		if err := os.Remove(e.path); err != nil && !os.IsNotExist(err) {
+			return removed, freed, fmt.Errorf("<?> Error: Failed to evict cache entry.\n<?> Error: %w", err)
+		}
+
+		size -= e.size
+		freed += e.size
+		removed++
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size, c.sized = size, true
+
+	return removed, freed, nil
+}
+
+// Stats describes the cache content.
+//
+// Example:
+// stats, err := store.Stats()
+func (c *Cache) Stats() (*Stats, error) {
+	entries, size, err := c.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{Entries: len(entries), Size: size, MaxSize: c.MaxSize}
+	for _, e := range entries {
+		if stats.Oldest.IsZero() || e.accessed.Before(stats.Oldest) {
+			stats.Oldest = e.accessed
+		}
+
+		if e.accessed.After(stats.Newest) {
+			stats.Newest = e.accessed
+		}
+	}
+
+	return stats, nil
+}
+
+// entries lists the cached entries and their total size (a missing directory is an empty cache)
+func (c *Cache) entries() ([]*entry, int64, error) {
+	var (
+		entries []*entry
+		size    int64
+	)
+
+	err := filepath.WalkDir(c.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() || strings.HasSuffix(path, ".tmp") {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			// evicted meanwhile
+			return nil
+		}
+
+		entries = append(entries, &entry{path: path, size: info.Size(), accessed: info.ModTime()})
+		size += info.Size()
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("<?> Error: Failed to read cache directory.\n<?> Error: %w", err)
+	}
+
+	return entries, size, nil
+}
@@ -2,9 +2,11 @@ package config
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/ignorant05/Uniflow/cmd/constants"
+	configConstants "github.com/ignorant05/Uniflow/internal/constants/config"
 )
 
 // The main configuration structure
@@ -12,12 +14,29 @@ type Config struct {
 	DefaultPlatform string              `yaml:"default_platform" mapstructure:"default_platform"`
 	Profiles        map[string]*Profile `yaml:"profiles" mapstructure:"profiles"`
 	Version         string              `yaml:"version" mapstructure:"version"`
+
+	// Redact holds the user defined redaction rules (profile tokens and common token formats are always masked)
+	Redact *RedactConfig `yaml:"redact,omitempty" mapstructure:"redact"`
+}
+
+// Logs redaction configuration
+type RedactConfig struct {
+	Rules []RedactRule `yaml:"rules,omitempty" mapstructure:"rules"`
+}
+
+// RedactRule masks the matches of a regular expression in logs
+type RedactRule struct {
+	Name    string `yaml:"name" mapstructure:"name"`
+	Pattern string `yaml:"pattern" mapstructure:"pattern"`
 }
 
 // The configuration profile (dev, prod, staging, etc...)
 type Profile struct {
-	Github  *GithubConfig   `yaml:"github,omitempty" mapstructure:"github"`
-	Jenkins *JenkinsConfig `yaml:"jenkins,omitempty" mapstructure:"jenkins"`
+	// Platforms holds the raw platform sections, by platform name (decoded by the registered sections, see Profile.Section)
+	Platforms map[string]interface{} `yaml:",inline" mapstructure:",remain"`
+
+	// Plugins holds the sections of external platforms (uniflow-platform-<name> executables), by name
+	Plugins map[string]PluginConfig `yaml:"plugins,omitempty" mapstructure:"plugins"`
 }
 
 // NewDefaultConfig creates configuration with default values
@@ -27,10 +46,12 @@ func NewDefaultConfig() *Config {
 		Version:         constants.DEFAULT_CONFIG_VERSION,
 		Profiles: map[string]*Profile{
 			constants.DEFAULT_CONFIG_PROFILE: {
-				Github: &GithubConfig{
-					Token:             constants.DEFAULT_GITHUB_TOKEN_PLACEHOLDER,
-					DefaultRepository: constants.DEFAULT_GITHUB_REPOSITORY,
-					BaseURL:           constants.DEFAULT_GITHUB_BASE_URL,
+				Platforms: map[string]interface{}{
+					configConstants.GITHUB: map[string]interface{}{
+						configConstants.TOKEN_FIELD:              constants.DEFAULT_GITHUB_TOKEN_PLACEHOLDER,
+						configConstants.DEFAULT_REPOSITORY_FIELD: constants.DEFAULT_GITHUB_REPOSITORY,
+						configConstants.BASE_URL_FIELD:           constants.DEFAULT_GITHUB_BASE_URL,
+					},
 				},
 			},
 		},
@@ -61,25 +82,84 @@ func (cfg *Config) GetProfile(name string) (*Profile, error) {
 	return profile, nil
 }
 
-// GetPlatform retrieves platform using platform name: name
+// GetPlatform retrieves the decoded section of a platform using platform name: name
 //
 // Parameters:
 //   - platformName: platform name
 //
 // Error possible causes:
+//   - unknown platform
 //   - platform isn't configured for this profile
+//   - invalid section
 //
 // Examples:
 // platform, err := p.GetPlatform("github")
 func (p *Profile) GetPlatform(platformName string) (interface{}, error) {
-	switch strings.ToLower(platformName) {
-	case strings.ToLower(constants.DEFAULT_CONFIG_PLATFORM):
-		if p.Github == nil {
-			return nil, fmt.Errorf("<?> Error: Github configuration not found for this profile")
-		}
-	default:
+	section, ok := LookupSection(platformName)
+	if !ok {
 		return nil, fmt.Errorf("<?> Error: Unsupported platform: %s", platformName)
 	}
 
-	return nil, nil
+	decoded, err := p.Section(section)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid %s configuration.\n<?> Error: %w", section.Title, err)
+	}
+
+	if decoded == nil {
+		return nil, fmt.Errorf("<?> Error: %s configuration not found for this profile", section.Title)
+	}
+
+	return decoded, nil
+}
+
+// Secrets lists the tokens and passwords configured in every profile (the secret fields of the registered sections),
+// and the ones the clients fall back to from the environment
+//
+// Examples:
+// secrets := cfg.Secrets()
+func (cfg *Config) Secrets() []string {
+	var secrets []string
+	add := func(values ...string) {
+		for _, value := range values {
+			if value != "" && !strings.HasPrefix(value, "${") {
+				secrets = append(secrets, value)
+			}
+		}
+	}
+
+	for _, profile := range cfg.Profiles {
+		if profile == nil {
+			continue
+		}
+
+		for _, section := range Sections() {
+			decoded, err := profile.Section(section)
+			if err != nil || decoded == nil {
+				continue
+			}
+
+			for _, field := range section.Fields(decoded) {
+				if field.Secret {
+					add(field.Value)
+				}
+			}
+		}
+
+		for _, plugin := range profile.Plugins {
+			for key, val := range plugin {
+				lower := strings.ToLower(key)
+				if str, ok := val.(string); ok && (strings.Contains(lower, "token") || strings.Contains(lower, "password") || strings.Contains(lower, "secret")) {
+					add(str)
+				}
+			}
+		}
+	}
+
+	for _, section := range Sections() {
+		for _, name := range section.SecretEnvVars {
+			add(os.Getenv(name))
+		}
+	}
+
+	return secrets
 }
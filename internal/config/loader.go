@@ -64,19 +64,49 @@ func Load() (*Config, error) {
 // Examples:
 // err := resolveEnvVars(cfg)
 func resolveEnvVars(cfg *Config) error {
-	for profileName, profile := range cfg.Profiles {
-		if profile.Github != nil {
-			profile.Github.Token = resolveEnvVar(profile.Github.Token)
-			profile.Github.DefaultRepository = resolveEnvVar(profile.Github.DefaultRepository)
-			profile.Github.BaseURL = resolveEnvVar(profile.Github.BaseURL)
+	for _, profile := range cfg.Profiles {
+		if profile == nil {
+			continue
 		}
 
-		cfg.Profiles[profileName] = profile
+		for name, section := range profile.Platforms {
+			profile.Platforms[name] = resolveEnvValues(section)
+		}
+
+		for _, plugin := range profile.Plugins {
+			for key, val := range plugin {
+				plugin[key] = resolveEnvValues(val)
+			}
+		}
 	}
 
 	return nil
 }
 
+// resolveEnvValues resolves the env vars of every string of a raw section
+//
+// Parameters:
+//   - value: raw value (string, map or list)
+//
+// Examples:
+// section = resolveEnvValues(section)
+func resolveEnvValues(value interface{}) interface{} {
+	switch val := value.(type) {
+	case string:
+		return resolveEnvVar(val)
+	case map[string]interface{}:
+		for key, item := range val {
+			val[key] = resolveEnvValues(item)
+		}
+	case []interface{}:
+		for idx, item := range val {
+			val[idx] = resolveEnvValues(item)
+		}
+	}
+
+	return value
+}
+
 // resolveEnvVars resolves env vars
 //
 // Parameters:
@@ -188,32 +218,37 @@ func Update(key, val string) error {
 //   - field value: val name
 //
 // Error possible causes:
-//   - configuration file not found
-//   - env vars not found
-//   - internal error (failed to read/parse config file)
+//   - unknown platform or field
+//   - invalid value (eg: not a boolean)
 //
 // Examples:
 // err := updatePlatformField(profile, "github", "token", "gibbrich string")
 func updatePlatformField(profile *Profile, platform, field, val string) error {
-	switch platform {
-	case constants.GITHUB:
-		if profile.Github == nil {
-			profile.Github = &GithubConfig{}
-		}
+	section, ok := LookupSection(platform)
+	if !ok {
+		return fmt.Errorf("<?> Error: Unsupported platform: %s", platform)
+	}
 
-		switch field {
-		case constants.TOKEN_FIELD:
-			profile.Github.Token = val
-		case constants.DEFAULT_REPOSITORY_FIELD:
-			profile.Github.DefaultRepository = val
-		case constants.BASE_URL_FIELD:
-			profile.Github.BaseURL = val
-		default:
-			return fmt.Errorf("<?> Error: Unknown github field: %s", field)
+	decoded, err := profile.Section(section)
+	if err != nil {
+		return fmt.Errorf("<?> Error: Invalid %s section.\n<?> Error: %w", platform, err)
+	}
+
+	// the first field creates the section
+	if decoded == nil {
+		if decoded, err = section.Decode(map[string]interface{}{}); err != nil {
+			return err
 		}
-	default:
-		return fmt.Errorf("<?> Error: Unsupported platform: %s", platform)
 	}
 
+	if err := section.SetField(decoded, field, val); err != nil {
+		return err
+	}
+
+	if profile.Platforms == nil {
+		profile.Platforms = make(map[string]interface{})
+	}
+	profile.Platforms[section.Name] = decoded
+
 	return nil
 }
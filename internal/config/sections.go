@@ -0,0 +1,311 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// PlatformSection describes how a platform is configured in a profile
+// NOTE: sections are registered by the platforms registry, each platform owns it's section type (config only stores raw sections)
+type PlatformSection struct {
+	// Name is the platform name (eg: "github"), it's also the profile section key
+	Name string
+
+	// Title is the display name of the platform (eg: "GitHub")
+	Title string
+
+	// Decode decodes the raw section of a profile (nil when the platform isn't configured)
+	// Example: config.DecodeSection[github.Config]
+	Decode func(raw interface{}) (interface{}, error)
+
+	// Validate validates a decoded section, prefix is the section field path (eg: "profiles.default.github")
+	Validate func(prefix string, section interface{}) []error
+
+	// SecretFields are the fields holding secrets (masked when displayed, redacted from logs)
+	SecretFields []string
+
+	// SecretEnvVars are the environment variables the client reads secrets from when the section leaves them empty
+	// Example: []string{"GITHUB_TOKEN"}
+	SecretEnvVars []string
+}
+
+// SectionField is a field of a decoded platform section
+type SectionField struct {
+	// Name is the field name in the configuration file (eg: "base_url")
+	Name string
+
+	Value string
+
+	// Secret is whether the value must be masked
+	Secret bool
+}
+
+var (
+	sectionsMu sync.RWMutex
+	sections   []*PlatformSection
+)
+
+// RegisterSection registers (or replaces) the configuration section of a platform
+//
+// Parameters:
+//   - section: platform section
+//
+// Examples:
+// config.RegisterSection(&config.PlatformSection{Name: "github", Decode: config.DecodeSection[github.Config]})
+func RegisterSection(section *PlatformSection) {
+	sectionsMu.Lock()
+	defer sectionsMu.Unlock()
+
+	for idx, registered := range sections {
+		if registered.Name == section.Name {
+			sections[idx] = section
+			return
+		}
+	}
+
+	sections = append(sections, section)
+}
+
+// Sections lists the registered platform sections (in registration order)
+//
+// Parameters:
+//   - None
+//
+// Examples:
+// for _, section := range config.Sections() {...}
+func Sections() []*PlatformSection {
+	sectionsMu.RLock()
+	defer sectionsMu.RUnlock()
+
+	return append([]*PlatformSection(nil), sections...)
+}
+
+// LookupSection returns the registered section of a platform
+//
+// Parameters:
+//   - name: platform name
+//
+// Examples:
+// section, ok := config.LookupSection("github")
+func LookupSection(name string) (*PlatformSection, bool) {
+	for _, section := range Sections() {
+		if section.Name == strings.ToLower(name) {
+			return section, true
+		}
+	}
+
+	return nil, false
+}
+
+// PlatformNames lists the names of the registered platforms
+//
+// Parameters:
+//   - None
+//
+// Examples:
+// names := config.PlatformNames()
+func PlatformNames() []string {
+	registered := Sections()
+
+	names := make([]string, 0, len(registered))
+	for _, section := range registered {
+		names = append(names, section.Name)
+	}
+
+	return names
+}
+
+// DecodeSection decodes a raw section into a new T, using it's yaml tags (nil when the section is absent)
+// NOTE: raw is what the profile holds, a section read from the file or a section set in code
+//
+// Parameters:
+//   - raw: raw section
+//
+// Examples:
+// section, err := config.DecodeSection[github.Config](profile.RawSection("github"))
+func DecodeSection[T any](raw interface{}) (interface{}, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	data, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	section := new(T)
+	if err := yaml.Unmarshal(data, section); err != nil {
+		return nil, err
+	}
+
+	return section, nil
+}
+
+// RawSection returns the raw section of a platform (or plugin) in the profile (nil when absent)
+//
+// Parameters:
+//   - name: platform name
+//
+// Examples:
+// raw := profile.RawSection("github")
+func (p *Profile) RawSection(name string) interface{} {
+	if raw, ok := p.Platforms[name]; ok && raw != nil {
+		return raw
+	}
+
+	if raw, ok := p.Plugins[name]; ok && raw != nil {
+		return raw
+	}
+
+	return nil
+}
+
+// Section decodes the section of a platform in the profile (nil when the platform isn't configured)
+//
+// Parameters:
+//   - section: platform section
+//
+// Examples:
+// decoded, err := profile.Section(section)
+func (p *Profile) Section(section *PlatformSection) (interface{}, error) {
+	return section.Decode(p.RawSection(section.Name))
+}
+
+// Fields lists the fields of a decoded section (in declaration order)
+//
+// Parameters:
+//   - section: decoded section
+//
+// Examples:
+// for _, field := range section.Fields(decoded) {...}
+func (s *PlatformSection) Fields(section interface{}) []SectionField {
+	value := reflect.Indirect(reflect.ValueOf(section))
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []SectionField
+	for idx := range value.NumField() {
+		name, ok := fieldName(value.Type().Field(idx))
+		if !ok {
+			continue
+		}
+
+		fields = append(fields, SectionField{
+			Name:   name,
+			Value:  fmt.Sprint(value.Field(idx).Interface()),
+			Secret: slices.Contains(s.SecretFields, name),
+		})
+	}
+
+	return fields
+}
+
+// UnknownKeys lists the keys of a raw section that aren't fields of it's decoded section (sorted)
+//
+// Parameters:
+//   - raw: raw section
+//   - section: decoded section
+//
+// Examples:
+// unknown := section.UnknownKeys(profile.RawSection("github"), decoded)
+func (s *PlatformSection) UnknownKeys(raw, section interface{}) []string {
+	data, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+
+	var keys map[string]interface{}
+	if err := yaml.Unmarshal(data, &keys); err != nil {
+		return nil
+	}
+
+	known := make(map[string]bool)
+	for _, field := range s.Fields(section) {
+		known[field.Name] = true
+	}
+
+	var unknown []string
+	for key := range keys {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	slices.Sort(unknown)
+
+	return unknown
+}
+
+// SetField sets a field of a decoded section from it's text value
+//
+// Parameters:
+//   - section: decoded section (a pointer)
+//   - name: field name in the configuration file
+//   - val: field value
+//
+// Error possible causes:
+//   - unknown field
+//   - invalid value (eg: not a boolean)
+//
+// Examples:
+// err := section.SetField(decoded, "timeout_seconds", "60")
+func (s *PlatformSection) SetField(section interface{}, name, val string) error {
+	value := reflect.Indirect(reflect.ValueOf(section))
+	if value.Kind() != reflect.Struct || !value.CanSet() {
+		return fmt.Errorf("<?> Error: The %s section can't be updated", s.Name)
+	}
+
+	for idx := range value.NumField() {
+		if fieldName, ok := fieldName(value.Type().Field(idx)); !ok || fieldName != name {
+			continue
+		}
+
+		field := value.Field(idx)
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(val)
+		case reflect.Bool:
+			parsed, err := strconv.ParseBool(val)
+			if err != nil {
+				return fmt.Errorf("<?> Error: %s must be a boolean", name)
+			}
+			field.SetBool(parsed)
+		case reflect.Int, reflect.Int64:
+			parsed, err := strconv.ParseInt(val, 10, 64)
+			if err != nil {
+				return fmt.Errorf("<?> Error: %s must be a number", name)
+			}
+			field.SetInt(parsed)
+		default:
+			return fmt.Errorf("<?> Error: %s can't be set from the command line", name)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("<?> Error: Unknown %s field: %s", s.Name, name)
+}
+
+// fieldName is the name of a section field in the configuration file
+func fieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "-" {
+		return "", false
+	}
+
+	if name == "" {
+		name = strings.ToLower(field.Name)
+	}
+
+	return name, true
+}
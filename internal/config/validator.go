@@ -2,11 +2,11 @@ package config
 
 import (
 	"fmt"
+	"regexp"
 	"slices"
 	"strings"
 
 	constants "github.com/ignorant05/Uniflow/internal/constants/config"
-	"github.com/ignorant05/Uniflow/internal/helpers"
 )
 
 // ValidationError struct
@@ -38,10 +38,11 @@ func (e *ValidationError) Error() string {
 func (cfg *Config) Validate() []error {
 	var errors []error
 
-	if !slices.Contains(constants.ValidPlarforms, cfg.DefaultPlatform) {
+	validPlatforms := PlatformNames()
+	if !slices.Contains(validPlatforms, cfg.DefaultPlatform) && !cfg.hasPlugin(cfg.DefaultPlatform) {
 		errors = append(errors, &ValidationError{
 			Field:   constants.VALIDATOR_PLATFORM,
-			Message: fmt.Sprintf("<?> Error: Must be one of: %s", strings.Join(constants.ValidPlarforms, ", ")),
+			Message: fmt.Sprintf("<?> Error: Must be one of: %s", strings.Join(validPlatforms, ", ")),
 		})
 	}
 
@@ -57,9 +58,31 @@ func (cfg *Config) Validate() []error {
 		errors = append(errors, profileErrors...)
 	}
 
+	if cfg.Redact != nil {
+		for idx, rule := range cfg.Redact.Rules {
+			if _, err := regexp.Compile(rule.Pattern); err != nil || rule.Pattern == "" {
+				errors = append(errors, &ValidationError{
+					Field:   fmt.Sprintf("%s[%d]", constants.VALIDATOR_REDACT_RULES, idx),
+					Message: fmt.Sprintf("<?> Error: Invalid pattern %q", rule.Pattern),
+				})
+			}
+		}
+	}
+
 	return errors
 }
 
+// hasPlugin checks if a plugin is configured in any profile
+func (cfg *Config) hasPlugin(name string) bool {
+	for _, profile := range cfg.Profiles {
+		if _, ok := profile.Plugins[name]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ValidateProfiles validates profile
 //
 // Parameters:
@@ -68,6 +91,7 @@ func (cfg *Config) Validate() []error {
 //
 // Error possible causes:
 //   - no platform configured
+//   - invalid section, or unknown field of a section
 //
 // Examples:
 // errs := ValidateProfiles("prod", profile)
@@ -75,55 +99,42 @@ func ValidateProfiles(name string, profile *Profile) []error {
 	var errors []error
 	prefix := fmt.Sprintf("profiles.%s", name)
 
-	if profile.Github == nil {
-		errors = append(errors, &ValidationError{
-			Field:   prefix,
-			Message: "<?> Error: At least one platform must be configured",
-		})
-
-	}
-
-	if profile.Github != nil {
-		ghErrors := ValidateGithub(prefix+".github", profile.Github)
-		errors = append(errors, ghErrors...)
+	configured := 0
+	for _, section := range Sections() {
+		decoded, err := profile.Section(section)
+		if err != nil {
+			errors = append(errors, &ValidationError{
+				Field:   prefix + "." + section.Name,
+				Message: fmt.Sprintf("<?> Error: Invalid section: %v", err),
+			})
+			continue
+		}
+
+		if decoded == nil {
+			continue
+		}
+		configured++
+
+		if section.Validate != nil {
+			errors = append(errors, section.Validate(prefix+"."+section.Name, decoded)...)
+		}
+
+		// decoding drops the keys it doesn't know (eg: typos)
+		for _, key := range section.UnknownKeys(profile.RawSection(section.Name), decoded) {
+			errors = append(errors, &ValidationError{
+				Field:   prefix + "." + section.Name + "." + key,
+				Message: "<?> Error: Unknown field",
+			})
+		}
 	}
 
-	return errors
-}
+	// plugins validate their own section
+	configured += len(profile.Plugins)
 
-// ValidateGithub validates github conf
-//
-// Parameters:
-//   - prefix: prefix string
-//   - cfg: github configuration struct
-//
-// Error possible causes:
-//   - github token is not sat
-//   - invalid url
-//
-// Examples:
-// errs := Update(prefix, cfg)
-func ValidateGithub(prefix string, cfg *GithubConfig) []error {
-	var errors []error
-
-	if cfg.Token == "" || strings.HasPrefix(cfg.Token, "${") {
-		errors = append(errors, &ValidationError{
-			Field:   prefix + ".token",
-			Message: "<?> Error: Token is required (set via environment variable or directly)",
-		})
-	}
-
-	if cfg.BaseURL == "" {
-		errors = append(errors, &ValidationError{
-			Field:   prefix + ".base_url",
-			Message: "<?> Error: Must be a valid URL",
-		})
-	}
-
-	if cfg.DefaultRepository == "" || helpers.IsValidRepoFormat(cfg.DefaultRepository) {
+	if configured == 0 {
 		errors = append(errors, &ValidationError{
-			Field:   prefix + ".default_repository",
-			Message: "<?> Error: Must be in format 'owner/repo'",
+			Field:   prefix,
+			Message: "<?> Error: At least one platform must be configured",
 		})
 	}
 
@@ -0,0 +1,9 @@
+package constants
+
+const (
+	// logs cache dir (relative to the config dir)
+	DEFAULT_LOGS_CACHE_DIR_PATH = "cache/logs"
+
+	// DEFAULT_CACHE_MAX_SIZE is the size above which the least recently used entries are evicted (bytes)
+	DEFAULT_CACHE_MAX_SIZE int64 = 512 << 20
+)
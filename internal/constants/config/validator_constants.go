@@ -7,11 +7,7 @@ const (
 
 	// validation profiles default field name
 	VALIDATOR_PROFILES = "profiles"
-)
 
-var (
-	// supported platforms
-	// NOTE: only github is supported right now
-	// NOTE: any other platform must be included here
-	ValidPlarforms = []string{"github"}
+	// validation redaction rules field name
+	VALIDATOR_REDACT_RULES = "redact.rules"
 )
@@ -0,0 +1,9 @@
+package constants
+
+const (
+	// REDACTED_MASK replaces the redacted values
+	REDACTED_MASK = "***"
+
+	// MIN_SECRET_LENGTH is the length under which configured secrets aren't masked (too many false positives)
+	MIN_SECRET_LENGTH = 6
+)
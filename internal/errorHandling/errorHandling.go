@@ -1,13 +1,104 @@
 package errorhandling
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/types"
 )
 
+// JSONErrors writes the errors to stderr as json objects instead of text (set by --output json|yaml)
+var JSONErrors bool
+
+// errorMarkers are the prefixes decorating the text errors
+var errorMarkers = []string{"<?> Error:", "<!> Warning:", "<!> Warn:", "<!> Info:", "</> Info:", "<?>", "<!>", "</>"}
+
+// ErrorOutput is the json object of an error
+type ErrorOutput struct {
+	Error ErrorDetails `json:"error"`
+}
+
+// ErrorDetails describes an error (Code, Platform and StatusCode are set for platform errors)
+type ErrorDetails struct {
+	Message    string `json:"message"`
+	Code       string `json:"code,omitempty"`
+	Platform   string `json:"platform,omitempty"`
+	StatusCode int    `json:"status_code,omitempty"`
+}
+
 func HandleError(err error) {
 	if err != nil {
-		fmt.Println(err)
+		PrintError(err)
 		os.Exit(1)
 	}
 }
+
+// PrintError prints an error without exiting (a json object on stderr with JSONErrors)
+//
+// Parameters:
+//   - err: the error
+//
+// Example:
+// errorhandling.PrintError(err)
+func PrintError(err error) {
+	if JSONErrors {
+		WriteJSONError(os.Stderr, err)
+		return
+	}
+
+	fmt.Println(err)
+}
+
+// WriteJSONError writes an error as a single line json object (see ErrorOutput)
+//
+// Parameters:
+//   - out: output (eg: os.Stderr)
+//   - err: the error
+//
+// Example:
+// errorhandling.WriteJSONError(os.Stderr, err)
+func WriteJSONError(out io.Writer, err error) {
+	details := ErrorDetails{Message: cleanMessage(err.Error())}
+
+	var platformErr *types.PlatformError
+	if errors.As(err, &platformErr) {
+		details.Code = platformErr.Code
+		details.Platform = platformErr.Platform
+		details.StatusCode = platformErr.StatusCode
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(ErrorOutput{Error: details})
+}
+
+// cleanMessage joins the lines of a text error, without their markers
+func cleanMessage(message string) string {
+	var parts []string
+
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimSpace(line)
+
+		// wrapped errors repeat the markers
+		for trimmed := true; trimmed; {
+			trimmed = false
+			for _, marker := range errorMarkers {
+				if strings.HasPrefix(line, marker) {
+					line = strings.TrimSpace(strings.TrimPrefix(line, marker))
+					trimmed = true
+					break
+				}
+			}
+		}
+
+		if line != "" {
+			parts = append(parts, line)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -1,6 +1,9 @@
 package helpers
 
-import "strings"
+import (
+	"strings"
+	"time"
+)
 
 func IsError(content string) bool {
 	if strings.Contains(content, "error") ||
@@ -48,3 +51,37 @@ func FormatLogs(line string) (string, string) {
 
 	return "", ""
 }
+
+// DetectLevel returns the log level name of a log line content (error, warning, debug, success or info)
+func DetectLevel(content string) string {
+	contentLower := strings.ToLower(content)
+
+	switch {
+	case IsError(contentLower):
+		return "error"
+	case IsDebug(contentLower):
+		return "debug"
+	case IsWarning(contentLower):
+		return "warning"
+	case IsSuccess(contentLower):
+		return "success"
+	default:
+		return "info"
+	}
+}
+
+// SplitLogLine splits the timestamp prefix of a log line (github, gitea) from it's content
+// NOTE: lines without a timestamp are stamped with the current time
+func SplitLogLine(line string) (time.Time, string) {
+	prefix, content, found := strings.Cut(line, " ")
+	if !found {
+		return time.Now(), line
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, prefix)
+	if err != nil {
+		return time.Now(), line
+	}
+
+	return ts, content
+}
@@ -0,0 +1,293 @@
+package redact
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	constants "github.com/ignorant05/Uniflow/internal/constants/redact"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// Rule masks the matches of a regular expression
+// NOTE: the text matched by a group named "keep" isn't masked (eg: `(?P<keep>password=)\S+`)
+type Rule struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+// Built-in detectors of common token formats
+var Detectors = []*Rule{
+	{Name: "github_token", Pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{22,255})\b`)},
+	{Name: "gitlab_token", Pattern: regexp.MustCompile(`\bgl(?:pat|dt|rt|cbt|ptt|ft|soat)-[A-Za-z0-9_-]{20,255}`)},
+	{Name: "aws_access_key", Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{Name: "aws_secret_key", Pattern: regexp.MustCompile(`(?i)(?P<keep>aws_secret_access_key["']?\s*[:=]\s*["']?)[A-Za-z0-9/+=]{40}`)},
+	{Name: "jwt", Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{5,}\.eyJ[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]{5,}`)},
+}
+
+// private key blocks span several lines
+var (
+	privateKeyBegin = regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----`)
+	privateKeyEnd   = regexp.MustCompile(`-----END [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----`)
+	privateKeyBlock = regexp.MustCompile(`(?s)-----BEGIN [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----.*?(-----END [A-Z0-9 ]*PRIVATE KEY( BLOCK)?-----|\z)`)
+)
+
+// Redactor masks secrets (configured tokens, detected token formats and user rules) in logs
+type Redactor struct {
+	secrets []string
+	rules   []*Rule
+
+	mu sync.Mutex
+	// inKey tells which jobs are inside a private key block (line by line redaction)
+	inKey map[string]bool
+}
+
+// New creates a redactor.
+//
+// Parameters:
+//   - secrets: literal values to mask (eg: tokens)
+//   - rules: regular expressions to mask (the built-in detectors are always added)
+//
+// Example:
+// redactor := redact.New([]string{token}, nil)
+func New(secrets []string, rules []*Rule) *Redactor {
+	r := &Redactor{rules: append(slices.Clone(Detectors), rules...), inKey: make(map[string]bool)}
+
+	for _, secret := range secrets {
+		if len(secret) >= constants.MIN_SECRET_LENGTH && !slices.Contains(r.secrets, secret) {
+			r.secrets = append(r.secrets, secret)
+		}
+	}
+
+	// longest first, so a secret containing another one is masked whole
+	slices.SortFunc(r.secrets, func(a, b string) int { return len(b) - len(a) })
+
+	return r
+}
+
+// FromConfig creates a redactor masking the configured secrets (environment fallbacks included) and rules.
+//
+// Parameters:
+//   - cfg: configuration
+//   - secrets: extra literal values to mask (eg: tokens read from the environment)
+//
+// Errors possible causes:
+//   - invalid rule pattern
+//
+// Example:
+// redactor, err := redact.FromConfig(cfg, os.Getenv("GITHUB_TOKEN"))
+func FromConfig(cfg *config.Config, secrets ...string) (*Redactor, error) {
+	var rules []*Rule
+	if cfg.Redact != nil {
+		for _, rule := range cfg.Redact.Rules {
+			pattern, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("<?> Error: Invalid redaction rule %s.\n<?> Error: %w", rule.Name, err)
+			}
+
+			rules = append(rules, &Rule{Name: rule.Name, Pattern: pattern})
+		}
+	}
+
+	return New(append(cfg.Secrets(), secrets...), rules), nil
+}
+
+// mask masks the secrets and the rules matches of a text
+func (r *Redactor) mask(text string) string {
+	for _, secret := range r.secrets {
+		text = strings.ReplaceAll(text, secret, constants.REDACTED_MASK)
+	}
+
+	for _, rule := range r.rules {
+		keep := rule.Pattern.SubexpIndex("keep")
+		if keep < 0 {
+			text = rule.Pattern.ReplaceAllLiteralString(text, constants.REDACTED_MASK)
+			continue
+		}
+
+		text = rule.Pattern.ReplaceAllString(text, "${keep}"+constants.REDACTED_MASK)
+	}
+
+	return text
+}
+
+// String redacts a whole text (private key blocks included).
+//
+// Parameters:
+//   - text: text to redact
+//
+// Example:
+// safe := redactor.String(content)
+func (r *Redactor) String(text string) string {
+	text = privateKeyBlock.ReplaceAllString(text, constants.REDACTED_MASK)
+
+	return r.mask(text)
+}
+
+// Line redacts a log line (a copy is returned), private key blocks are tracked line by line, per job.
+//
+// Parameters:
+//   - line: log line
+//
+// Example:
+// safe := redactor.Line(line)
+func (r *Redactor) Line(line *types.LogLine) *types.LogLine {
+	redacted := *line
+	content := line.Content
+
+	r.mu.Lock()
+	inKey := r.inKey[line.JobName]
+
+	switch {
+	case inKey:
+		if loc := privateKeyEnd.FindStringIndex(content); loc != nil {
+			content = constants.REDACTED_MASK + content[loc[1]:]
+			inKey = false
+		} else {
+			content = constants.REDACTED_MASK
+		}
+	case privateKeyBegin.MatchString(content):
+		if privateKeyEnd.MatchString(content) {
+			content = privateKeyBlock.ReplaceAllString(content, constants.REDACTED_MASK)
+		} else {
+			content = content[:privateKeyBegin.FindStringIndex(content)[0]] + constants.REDACTED_MASK
+			inKey = true
+		}
+	}
+
+	r.inKey[line.JobName] = inKey
+	r.mu.Unlock()
+
+	redacted.Content = r.mask(content)
+	redacted.Group = r.mask(line.Group)
+
+	if line.Annotation != nil {
+		annotation := *line.Annotation
+		annotation.Title = r.mask(annotation.Title)
+		redacted.Annotation = &annotation
+	}
+
+	return &redacted
+}
+
+// Path redacts a saved logs file or zip archive in place (files without secrets are left untouched).
+//
+// Parameters:
+//   - path: file or zip
+//
+// Errors possible causes:
+//   - unreadable or unwritable files
+//
+// Example:
+// err := redactor.Path("~/.uniflow/logs/deploy.zip")
+func (r *Redactor) Path(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: %w", path, err)
+	}
+
+	if info.IsDir() {
+		return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: it's a directory", path)
+	}
+
+	if strings.HasSuffix(path, ".zip") {
+		return r.zip(path, info.Mode().Perm())
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: %w", path, err)
+	}
+
+	if redacted := r.String(string(content)); redacted != string(content) {
+		if err := os.WriteFile(path, []byte(redacted), info.Mode().Perm()); err != nil {
+			return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// zip rewrites a zip archive with it's files redacted (if any of them holds a secret)
+func (r *Redactor) zip(path string, mode os.FileMode) error {
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: %w", path, err)
+	}
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	masked := false
+
+	for _, file := range reader.File {
+		header := file.FileHeader
+		entry, err := writer.CreateHeader(&header)
+		if err != nil {
+			_ = reader.Close()
+			return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: %w", path, err)
+		}
+
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		content, err := readZipped(file)
+		if err != nil {
+			_ = reader.Close()
+			return err
+		}
+
+		redacted := r.String(content)
+		masked = masked || redacted != content
+
+		if _, err := entry.Write([]byte(redacted)); err != nil {
+			_ = reader.Close()
+			return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: %w", path, err)
+		}
+	}
+
+	if err := reader.Close(); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: %w", path, err)
+	}
+
+	if !masked {
+		return nil
+	}
+
+	if err := os.WriteFile(path, buffer.Bytes(), mode); err != nil {
+		return fmt.Errorf("<?> Error: Failed to redact %s.\n<?> Error: %w", path, err)
+	}
+
+	return nil
+}
+
+// readZipped reads a zipped file
+func readZipped(file *zip.File) (string, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to read %s.\n<?> Error: %w", file.Name, err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "<!> Warn:  Failed to close %s: %v\n", file.Name, err)
+		}
+	}()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to read %s.\n<?> Error: %w", file.Name, err)
+	}
+
+	return string(content), nil
+}
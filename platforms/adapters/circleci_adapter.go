@@ -0,0 +1,915 @@
+package platforms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	internalHelpers "github.com/ignorant05/Uniflow/internal/helpers"
+	registry "github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci"
+	circleciConstants "github.com/ignorant05/Uniflow/platforms/configurations/circleci/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci/helpers"
+	"github.com/ignorant05/Uniflow/platforms/constants"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// init registers circleci in the platforms registry
+func init() {
+	registry.Register(&registry.Platform{
+		PlatformSection: config.PlatformSection{
+			Name:   constants.CIRCLE_CI_PLATFORM,
+			Title:  "CircleCI",
+			Decode: config.DecodeSection[circleci.Config],
+			Validate: func(prefix string, section interface{}) []error {
+				return circleci.ValidateConfig(prefix, section.(*circleci.Config))
+			},
+			SecretFields:  []string{"token"},
+			SecretEnvVars: []string{circleciConstants.CIRCLECI_TOKEN_ENV_VAR_NAME},
+		},
+		DetectionPaths: circleciConstants.DETECTION_PATHS,
+		Confidence:     80,
+		New: func(ctx context.Context, profile *config.Profile) (registry.PlatformClient, error) {
+			client, err := circleci.NewClientFromProfile(ctx, profile)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewCircleCIAdapter(client)
+		},
+	})
+}
+
+type CircleCIAdapter struct {
+	Client      *circleci.Client
+	projectSlug string
+}
+
+// NewCircleCIAdapter creates an adapter object
+//
+// Parameters:
+//   - client: circleci client
+//
+// Example:
+// adapter, err := NewCircleCIAdapter(client)
+func NewCircleCIAdapter(client *circleci.Client) (*CircleCIAdapter, error) {
+	slug, err := client.GetDefaultProjectSlug()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CircleCIAdapter{
+		Client:      client,
+		projectSlug: slug,
+	}, nil
+}
+
+// TriggerWorkflow triggers a pipeline on a branch, inputs are passed as pipeline parameters
+// NOTE: circleci runs every workflow of the config, RunID is the pipeline number
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.TriggerWorkflow(ctx, &types.TriggerRequest{ Branch: "main",})
+func (a *CircleCIAdapter) TriggerWorkflow(ctx context.Context, req *types.TriggerRequest) (*types.TriggerResponse, error) {
+	parameters := make(map[string]interface{}, len(req.Inputs))
+	for key, val := range req.Inputs {
+		parameters[key] = helpers.CoerceParameter(val)
+	}
+
+	pipeline, err := a.Client.TriggerPipeline(a.projectSlug, req.Branch, parameters)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "trigger_failed",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	queuedAt := pipeline.CreatedAt
+	if queuedAt.IsZero() {
+		queuedAt = time.Now()
+	}
+
+	return &types.TriggerResponse{
+		RunID:     pipeline.Number,
+		RunNumber: int(pipeline.Number),
+		URL:       a.pipelineURL(pipeline.Number),
+		Status:    "queued",
+		QueuedAt:  queuedAt,
+	}, nil
+}
+
+// GetStatus gets the status of a single pipeline (aggregated from it's workflows)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest pipeline, Name filters a workflow)
+//
+// Example:
+// status, err := a.GetStatus(ctx, &types.StatusRequest{ RunID: 1,})
+func (a *CircleCIAdapter) GetStatus(ctx context.Context, req *types.StatusRequest) (*types.Status, error) {
+	pipeline, err := a.resolvePipeline(req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "status_failed",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	workflows, err := a.pipelineWorkflows(pipeline, req.Name)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "status_failed",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	runStatus, conclusion := aggregateWorkflows(pipeline, workflows)
+
+	status := &types.Status{
+		RunID:      pipeline.Number,
+		RunNumber:  int(pipeline.Number),
+		Status:     runStatus,
+		Conclusion: conclusion,
+		StartedAt:  pipeline.CreatedAt,
+		QueuedAt:   pipeline.CreatedAt,
+		URL:        a.pipelineURL(pipeline.Number),
+		Metadata: map[string]interface{}{
+			"pipeline_id": pipeline.ID,
+			"state":       pipeline.State,
+		},
+	}
+
+	if pipeline.VCS != nil {
+		status.Branch = pipeline.VCS.Branch
+	}
+
+	if runStatus == "completed" {
+		for _, workflow := range workflows {
+			if workflow.StoppedAt != nil && workflow.StoppedAt.After(status.CompletedAt) {
+				status.CompletedAt = *workflow.StoppedAt
+			}
+		}
+
+		if !status.CompletedAt.IsZero() {
+			status.Duration = status.CompletedAt.Sub(status.StartedAt)
+		}
+	}
+
+	return status, nil
+}
+
+// ListWorkflows lists the pipeline definition of the project
+// NOTE: circleci workflows are declared in a single config file per project
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// workflows, err := a.ListWorkflows(ctx, &types.ListWorkflowsRequest{})
+func (a *CircleCIAdapter) ListWorkflows(ctx context.Context, req *types.ListWorkflowsRequest) ([]*types.Workflow, error) {
+	project, err := a.Client.GetProject(a.projectSlug)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "forbidden",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	return []*types.Workflow{
+		{
+			ID:           1,
+			Name:         project.Name,
+			Path:         circleciConstants.DEFAULT_CONFIG_PATH,
+			State:        "active",
+			URL:          a.projectURL(),
+			WithDispatch: req.WithDispatch,
+		},
+	}, nil
+}
+
+// ListWorkflowJobs lists the jobs of every workflow of a pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest pipeline, WorkflowName filters a workflow)
+//
+// Example:
+// jobs, err := a.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{ RunID: 42})
+func (a *CircleCIAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
+	var (
+		pipeline *circleci.Pipeline
+		err      error
+	)
+
+	if req.RunID == 0 && req.Branch != "" {
+		pipeline, err = a.latestPipeline(req.Branch)
+	} else {
+		pipeline, err = a.resolvePipeline(req.RunID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	workflows, err := a.pipelineWorkflows(pipeline, req.WorkflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*types.WorkflowJob
+	for _, workflow := range workflows {
+		jobs, err := a.Client.ListWorkflowJobs(workflow.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, job := range jobs {
+			jobStatus, conclusion := helpers.MapJobStatus(job.Status)
+			if req.Status != "" && req.Status != jobStatus {
+				continue
+			}
+
+			result = append(result, &types.WorkflowJob{
+				ID:           job.JobNumber,
+				RunID:        pipeline.Number,
+				WorkflowName: workflow.Name,
+				Name:         job.Name,
+				Status:       jobStatus,
+				Conclusion:   conclusion,
+				RunURL:       a.pipelineURL(pipeline.Number),
+				URL:          a.workflowURL(pipeline.Number, workflow.ID),
+				HTMLURL:      a.jobURL(pipeline.Number, workflow.ID, job.JobNumber),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// ListWorkflowRuns lists the most recent pipelines of the project (all that is <= limit)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (WorkflowName keeps pipelines running that workflow)
+//
+// Example:
+// runs, err := a.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{ Branch: "main"})
+func (a *CircleCIAdapter) ListWorkflowRuns(ctx context.Context, req *types.ListWorkflowRunsRequest) ([]*types.Run, error) {
+	pipelines, err := a.Client.ListPipelines(a.projectSlug, req.Branch, req.Limit)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	runs := make([]*types.Run, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		if req.Limit > 0 && len(runs) >= req.Limit {
+			break
+		}
+
+		workflows, err := a.pipelineWorkflows(pipeline, req.WorkflowName)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.WorkflowName != "" && len(workflows) == 0 {
+			continue
+		}
+
+		runStatus, conclusion := aggregateWorkflows(pipeline, workflows)
+		if req.Status != "" && req.Status != runStatus {
+			continue
+		}
+
+		var actor, event, branch, commitSHA string
+		if pipeline.Trigger != nil {
+			event = pipeline.Trigger.Type
+			if pipeline.Trigger.Actor != nil {
+				actor = pipeline.Trigger.Actor.Login
+			}
+		}
+		if pipeline.VCS != nil {
+			branch = pipeline.VCS.Branch
+			commitSHA = pipeline.VCS.Revision
+		}
+
+		runs = append(runs, &types.Run{
+			RunID:       pipeline.Number,
+			RunNumber:   int(pipeline.Number),
+			Status:      runStatus,
+			Conclusion:  conclusion,
+			Branch:      branch,
+			Actor:       actor,
+			Event:       event,
+			CommitSHA:   commitSHA,
+			TriggeredBy: actor,
+			CreatedAt:   pipeline.CreatedAt,
+			UpdatedAt:   pipeline.UpdatedAt,
+			URL:         a.pipelineURL(pipeline.Number),
+		})
+	}
+
+	return runs, nil
+}
+
+// StreamLogs streams the step outputs of every job of a pipeline line by line
+// NOTE: circleci only exposes the output of finished steps, running steps are delivered once done
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest pipeline)
+//   - callback: logs callback
+//
+// Example:
+// err := a.StreamLogs(ctx, &types.LogsStreamRequest{ RunID: 1, Follow: true}, &callback)
+func (a *CircleCIAdapter) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	pipeline, err := a.resolvePipeline(req.RunID)
+	if err != nil {
+		return &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	// delivered keeps track of step actions already streamed ("job:step:index")
+	delivered := make(map[string]bool)
+	var tail []*types.LogLine
+
+	emit := func(line *types.LogLine) error {
+		// with --tail, lines are only delivered once the whole output is known
+		if req.Tail > 0 && !req.Follow {
+			tail = append(tail, line)
+			if len(tail) > req.Tail {
+				tail = tail[1:]
+			}
+			return nil
+		}
+
+		if callback == nil || *callback == nil {
+			return nil
+		}
+
+		return (*callback)(line)
+	}
+
+	for {
+		workflows, err := a.Client.ListPipelineWorkflows(pipeline.ID)
+		if err != nil {
+			return &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.CIRCLE_CI_PLATFORM,
+			}
+		}
+		runStatus, _ := aggregateWorkflows(pipeline, workflows)
+
+		for _, workflow := range workflows {
+			jobs, err := a.Client.ListWorkflowJobs(workflow.ID)
+			if err != nil {
+				return &types.PlatformError{
+					Code:     "logs_failed",
+					Message:  err.Error(),
+					Platform: constants.CIRCLE_CI_PLATFORM,
+				}
+			}
+
+			for _, job := range jobs {
+				// approval jobs and jobs that never started have no output
+				if job.JobNumber == 0 || job.StartedAt == nil {
+					continue
+				}
+
+				if err := a.streamJob(job, delivered, emit); err != nil {
+					return err
+				}
+			}
+		}
+
+		if !req.Follow || runStatus == "completed" {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(circleciConstants.StepPollInterval):
+		}
+	}
+
+	if callback != nil && *callback != nil {
+		for _, line := range tail {
+			if err := (*callback)(line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListWorkflowRunLogs downloads the step outputs of every job of a pipeline (a file per job)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// logs, err := a.ListWorkflowRunLogs(ctx, &types.LogsRequest{ RunID: 1,})
+func (a *CircleCIAdapter) ListWorkflowRunLogs(ctx context.Context, req *types.LogsRequest) (*types.LogsResponse, error) {
+	pipeline, err := a.resolvePipeline(req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	dir := req.DownloadPath
+	if dir == "" {
+		configDir, err := internalHelpers.GetConfigDir()
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.ReplaceAll(a.projectSlug, "/", "-")
+		dir = filepath.Join(configDir, "logs", fmt.Sprintf("%s-%d", name, pipeline.Number))
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to create logs directory.\n<?> Error: %w", err)
+	}
+
+	workflows, err := a.Client.ListPipelineWorkflows(pipeline.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int
+	var files []string
+	for _, workflow := range workflows {
+		jobs, err := a.Client.ListWorkflowJobs(workflow.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, job := range jobs {
+			if job.JobNumber == 0 || job.StartedAt == nil {
+				continue
+			}
+
+			var content strings.Builder
+			err := a.streamJob(job, make(map[string]bool), func(line *types.LogLine) error {
+				content.WriteString(line.Content + "\n")
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			path := filepath.Join(dir, fmt.Sprintf("%d-%s-%s.log", job.JobNumber, workflow.Name, job.Name))
+			if err := os.WriteFile(path, []byte(content.String()), 0644); err != nil {
+				return nil, fmt.Errorf("<?> Error: Failed to write logs data: %w", err)
+			}
+
+			total += content.Len()
+			files = append(files, path)
+		}
+	}
+
+	return &types.LogsResponse{
+		URL:   a.pipelineURL(pipeline.Number),
+		Path:  dir,
+		Files: files,
+		Size:  int64(total),
+	}, nil
+}
+
+// GetWorkflowRunSummary retrieves the summary of a pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (ID is the pipeline number)
+//
+// Example:
+// summary, err := a.GetWorkflowRunSummary(ctx, &types.Workflow{ ID: 1,})
+func (a *CircleCIAdapter) GetWorkflowRunSummary(ctx context.Context, req *types.Workflow) (*types.WorkflowRunSummary, error) {
+	pipeline, err := a.resolvePipeline(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows, err := a.Client.ListPipelineWorkflows(pipeline.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	runStatus, conclusion := aggregateWorkflows(pipeline, workflows)
+
+	return &types.WorkflowRunSummary{
+		ID:         pipeline.Number,
+		Name:       fmt.Sprintf("%s #%d", a.projectSlug, pipeline.Number),
+		Status:     runStatus,
+		Conclusion: conclusion,
+		CreatedAt:  pipeline.CreatedAt.String(),
+		UpdatedAt:  pipeline.UpdatedAt.String(),
+		HTMLURL:    a.pipelineURL(pipeline.Number),
+	}, nil
+}
+
+// ListArtifacts isn't supported (job artifacts aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// artifacts, err := a.ListArtifacts(ctx, &types.ListArtifactsRequest{ RunID: 1,})
+func (a *CircleCIAdapter) ListArtifacts(ctx context.Context, req *types.ListArtifactsRequest) ([]*types.Artifact, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Job artifacts aren't supported by uniflow yet",
+		Platform: constants.CIRCLE_CI_PLATFORM,
+	}
+}
+
+// DownloadArtifact isn't supported (job artifacts aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.DownloadArtifact(ctx, &types.DownloadArtifactRequest{ RunID: 1, Artifact: artifact,})
+func (a *CircleCIAdapter) DownloadArtifact(ctx context.Context, req *types.DownloadArtifactRequest) (*types.DownloadArtifactResponse, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Job artifacts aren't supported by uniflow yet",
+		Platform: constants.CIRCLE_CI_PLATFORM,
+	}
+}
+
+// ListPendingApprovals isn't supported (approval jobs aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// approvals, err := a.ListPendingApprovals(ctx, &types.ListApprovalsRequest{})
+func (a *CircleCIAdapter) ListPendingApprovals(ctx context.Context, req *types.ListApprovalsRequest) ([]*types.PendingApproval, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Approval jobs aren't supported by uniflow yet",
+		Platform: constants.CIRCLE_CI_PLATFORM,
+	}
+}
+
+// ReviewApproval isn't supported (approval jobs aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.ReviewApproval(ctx, &types.ReviewApprovalRequest{ RunID: 1, Approve: true,})
+func (a *CircleCIAdapter) ReviewApproval(ctx context.Context, req *types.ReviewApprovalRequest) error {
+	return &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Approval jobs aren't supported by uniflow yet",
+		Platform: constants.CIRCLE_CI_PLATFORM,
+	}
+}
+
+// Cancel cancels every running workflow of a pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.Cancel(ctx, &types.Run{ RunID: 1,})
+func (a *CircleCIAdapter) Cancel(ctx context.Context, req *types.Run) error {
+	pipeline, err := a.resolvePipeline(req.RunID)
+	if err != nil {
+		return err
+	}
+
+	workflows, err := a.Client.ListPipelineWorkflows(pipeline.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, workflow := range workflows {
+		if workflowStatus, _ := helpers.MapWorkflowStatus(workflow.Status); workflowStatus == "completed" {
+			continue
+		}
+
+		if err := a.Client.CancelWorkflow(workflow.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Rerun reruns the completed workflows of a pipeline (or only the failed ones, from their failed jobs)
+// NOTE: reruns are new workflows of the same pipeline, the run keeps it's number
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.Rerun(ctx, &types.RerunRequest{ RunID: 1, FailedOnly: true})
+func (a *CircleCIAdapter) Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error) {
+	if req.Debug {
+		return nil, &types.PlatformError{
+			Code:     "not_supported",
+			Message:  "Debug logging isn't supported by circleci reruns (rerun with SSH from the web app instead)",
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	pipeline, err := a.resolvePipeline(req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	workflows, err := a.Client.ListPipelineWorkflows(pipeline.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rerun := 0
+	for _, workflow := range latestWorkflows(workflows) {
+		workflowStatus, conclusion := helpers.MapWorkflowStatus(workflow.Status)
+		if workflowStatus != "completed" || (req.FailedOnly && conclusion != "failure") {
+			continue
+		}
+
+		if _, err := a.Client.RerunWorkflow(workflow.ID, req.FailedOnly); err != nil {
+			return nil, &types.PlatformError{
+				Code:     "rerun_failed",
+				Message:  err.Error(),
+				Platform: constants.CIRCLE_CI_PLATFORM,
+			}
+		}
+		rerun++
+	}
+
+	if rerun == 0 {
+		return nil, &types.PlatformError{
+			Code:     "rerun_failed",
+			Message:  fmt.Sprintf("<?> Error: Pipeline %d has no workflow to rerun (running workflows can't be rerun)", pipeline.Number),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	return &types.RerunResponse{RunID: pipeline.Number, RunNumber: int(pipeline.Number), URL: a.pipelineURL(pipeline.Number)}, nil
+}
+
+// GetRepository returns the organization and the name of the configured project
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// org, name := a.GetRepository(ctx)
+func (a *CircleCIAdapter) GetRepository(ctx context.Context) (string, string) {
+	parts := strings.Split(strings.Trim(a.projectSlug, "/"), "/")
+	if len(parts) < 3 {
+		return "", a.projectSlug
+	}
+
+	return parts[1], parts[2]
+}
+
+// GetRepositoryInfo returns the configured project information
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// info, err := a.GetRepositoryInfo(ctx)
+func (a *CircleCIAdapter) GetRepositoryInfo(ctx context.Context) (*types.RepositoryInfo, error) {
+	project, err := a.Client.GetProject(a.projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RepositoryInfo{
+		Name:          project.Name,
+		FullName:      project.Slug,
+		DefaultBranch: project.VCSInfo.DefaultBranch,
+		HTMLURL:       project.VCSInfo.VCSURL,
+	}, nil
+}
+
+// GetUnderlyingClient returns the circleci client from the CircleCIAdapter struct but as an interface
+//
+// Parameters:
+//   - None
+//
+// Example:
+// client := a.GetUnderlyingClient()
+func (a *CircleCIAdapter) GetUnderlyingClient() interface{} {
+	return a.Client
+}
+
+// IsGithub verifies that the current client is a github client
+//
+// Parameters:
+//   - None
+//
+// Example:
+// valid:= a.IsGithub()
+func (a *CircleCIAdapter) IsGithub() bool {
+	return false
+}
+
+// streamJob delivers the output of every finished step action of a job that wasn't delivered yet
+func (a *CircleCIAdapter) streamJob(job *circleci.Job, delivered map[string]bool, emit func(*types.LogLine) error) error {
+	details, err := a.Client.GetJobDetails(a.projectSlug, job.JobNumber)
+	if err != nil {
+		return &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.CIRCLE_CI_PLATFORM,
+		}
+	}
+
+	for _, step := range details.Steps {
+		for _, action := range step.Actions {
+			key := fmt.Sprintf("%d:%d:%d", job.JobNumber, action.Step, action.Index)
+			if delivered[key] || action.Status == "running" {
+				continue
+			}
+			delivered[key] = true
+
+			if !action.HasOutput {
+				continue
+			}
+
+			messages, err := a.Client.GetStepOutput(action)
+			if err != nil {
+				return &types.PlatformError{
+					Code:     "logs_failed",
+					Message:  err.Error(),
+					Platform: constants.CIRCLE_CI_PLATFORM,
+				}
+			}
+
+			for _, message := range messages {
+				text := strings.ReplaceAll(message.Message, "\r\n", "\n")
+
+				for _, content := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+					level := internalHelpers.DetectLevel(content)
+					if message.Type == "err" && level == "info" {
+						level = "error"
+					}
+
+					line := &types.LogLine{
+						Content:   content,
+						Timestamp: message.Time,
+						JobName:   job.Name,
+						JobID:     job.JobNumber,
+						Level:     level,
+						Step:      step.Name,
+					}
+
+					if err := emit(line); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolvePipeline retrieves the requested pipeline, or the latest one
+//
+// Parameters:
+//   - number: pipeline number (0 = latest pipeline)
+//
+// Example:
+// pipeline, err := a.resolvePipeline(0)
+func (a *CircleCIAdapter) resolvePipeline(number int64) (*circleci.Pipeline, error) {
+	if number != 0 {
+		return a.Client.GetPipelineByNumber(a.projectSlug, number)
+	}
+
+	return a.latestPipeline("")
+}
+
+// latestPipeline retrieves the latest pipeline (of a branch if any)
+func (a *CircleCIAdapter) latestPipeline(branch string) (*circleci.Pipeline, error) {
+	pipelines, err := a.Client.ListPipelines(a.projectSlug, branch, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pipelines) == 0 {
+		return nil, fmt.Errorf("<?> Error: No pipelines found for project %s", a.projectSlug)
+	}
+
+	return pipelines[0], nil
+}
+
+// pipelineWorkflows lists the workflows of a pipeline, optionally keeping only the named one
+func (a *CircleCIAdapter) pipelineWorkflows(pipeline *circleci.Pipeline, name string) ([]*circleci.Workflow, error) {
+	workflows, err := a.Client.ListPipelineWorkflows(pipeline.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		return workflows, nil
+	}
+
+	filtered := make([]*circleci.Workflow, 0, len(workflows))
+	for _, workflow := range workflows {
+		if workflow.Name == name {
+			filtered = append(filtered, workflow)
+		}
+	}
+
+	return filtered, nil
+}
+
+// aggregateWorkflows computes the pipeline status and conclusion from it's workflows
+func aggregateWorkflows(pipeline *circleci.Pipeline, workflows []*circleci.Workflow) (string, string) {
+	latest := latestWorkflows(workflows)
+
+	statuses := make([]string, 0, len(latest))
+	for _, workflow := range latest {
+		statuses = append(statuses, workflow.Status)
+	}
+
+	return helpers.AggregateStatus(pipeline.State, statuses)
+}
+
+// latestWorkflows keeps the latest workflow of each name (reruns are new workflows of the same pipeline)
+func latestWorkflows(workflows []*circleci.Workflow) []*circleci.Workflow {
+	latest := make([]*circleci.Workflow, 0, len(workflows))
+	index := make(map[string]int, len(workflows))
+
+	for _, workflow := range workflows {
+		idx, ok := index[workflow.Name]
+		if !ok {
+			index[workflow.Name] = len(latest)
+			latest = append(latest, workflow)
+			continue
+		}
+
+		if workflow.CreatedAt.After(latest[idx].CreatedAt) {
+			latest[idx] = workflow
+		}
+	}
+
+	return latest
+}
+
+// projectURL, pipelineURL, workflowURL and jobURL build circleci web app urls
+// NOTE: circleci.com serves the web app from a dedicated host, server installations don't
+func (a *CircleCIAdapter) projectURL() string {
+	appURL := strings.TrimSuffix(a.Client.BaseURL.String(), "/")
+	if a.Client.BaseURL.Host == "circleci.com" {
+		appURL = "https://app.circleci.com"
+	}
+
+	return appURL + "/pipelines/" + strings.Trim(a.projectSlug, "/")
+}
+
+func (a *CircleCIAdapter) pipelineURL(number int64) string {
+	return fmt.Sprintf("%s/%d", a.projectURL(), number)
+}
+
+func (a *CircleCIAdapter) workflowURL(number int64, workflowID string) string {
+	return fmt.Sprintf("%s/workflows/%s", a.pipelineURL(number), workflowID)
+}
+
+func (a *CircleCIAdapter) jobURL(number int64, workflowID string, jobNumber int64) string {
+	return fmt.Sprintf("%s/jobs/%d", a.workflowURL(number, workflowID), jobNumber)
+}
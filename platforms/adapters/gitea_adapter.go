@@ -0,0 +1,773 @@
+package platforms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	internalHelpers "github.com/ignorant05/Uniflow/internal/helpers"
+	registry "github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea"
+	giteaConstants "github.com/ignorant05/Uniflow/platforms/configurations/gitea/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea/helpers"
+	"github.com/ignorant05/Uniflow/platforms/constants"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// init registers gitea (and forgejo) actions in the platforms registry
+// NOTE: gitea and forgejo also run .github/workflows
+func init() {
+	registry.Register(&registry.Platform{
+		PlatformSection: config.PlatformSection{
+			Name:   constants.GITEA_PLATFORM,
+			Title:  "Gitea",
+			Decode: config.DecodeSection[gitea.Config],
+			Validate: func(prefix string, section interface{}) []error {
+				return gitea.ValidateConfig(prefix, section.(*gitea.Config))
+			},
+			SecretFields:  []string{"token"},
+			SecretEnvVars: []string{giteaConstants.GITEA_TOKEN_ENV_VAR_NAME},
+		},
+		DetectionPaths:   giteaConstants.DETECTION_PATHS,
+		Confidence:       100,
+		SharesLayoutWith: []string{constants.GITHUB_PLATFORM},
+		New: func(ctx context.Context, profile *config.Profile) (registry.PlatformClient, error) {
+			client, err := gitea.NewClientFromProfile(ctx, profile)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewGiteaAdapter(client)
+		},
+	})
+}
+
+type GiteaAdapter struct {
+	Client *gitea.Client
+	owner  string
+	repo   string
+}
+
+// NewGiteaAdapter creates an adapter object
+//
+// Parameters:
+//   - client: gitea client
+//
+// Example:
+// adapter, err := NewGiteaAdapter(client)
+func NewGiteaAdapter(client *gitea.Client) (*GiteaAdapter, error) {
+	owner, repo, err := client.GetDefaultRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GiteaAdapter{
+		Client: client,
+		owner:  owner,
+		repo:   repo,
+	}, nil
+}
+
+// TriggerWorkflow dispatches a workflow and picks the run it created
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.TriggerWorkflow(ctx, &types.TriggerRequest{ WorkflowName: "deploy.yml",})
+func (a *GiteaAdapter) TriggerWorkflow(ctx context.Context, req *types.TriggerRequest) (*types.TriggerResponse, error) {
+	targetWorkflow := req.WorkflowName
+	if targetWorkflow == "" {
+		return nil, &types.PlatformError{
+			Code:     "trigger_failed",
+			Message:  "No workflow provided",
+			Platform: constants.GITEA_PLATFORM,
+		}
+	}
+
+	ref := req.Branch
+	if ref == "" {
+		repository, err := a.Client.GetRepository(a.owner, a.repo)
+		if err != nil {
+			return nil, &types.PlatformError{
+				Code:     "not_found",
+				Message:  err.Error(),
+				Platform: constants.GITEA_PLATFORM,
+			}
+		}
+		ref = repository.DefaultBranch
+	}
+
+	inputs := make(map[string]string, len(req.Inputs))
+	for key, val := range req.Inputs {
+		inputs[key] = fmt.Sprint(val)
+	}
+
+	dispatchedAt := time.Now()
+
+	if err := a.Client.DispatchWorkflow(a.owner, a.repo, targetWorkflow, ref, inputs); err != nil {
+		return nil, &types.PlatformError{
+			Code:     "trigger_failed",
+			Message:  err.Error(),
+			Platform: constants.GITEA_PLATFORM,
+		}
+	}
+
+	resp := &types.TriggerResponse{
+		Status:   "queued",
+		QueuedAt: dispatchedAt,
+	}
+
+	// the dispatch endpoint doesn't return the run, the most recent run of the workflow is picked
+	runs, err := a.Client.ListRuns(a.owner, a.repo, ref, "", 0)
+	if err != nil {
+		return resp, nil
+	}
+
+	for _, run := range runs {
+		if !helpers.MatchesWorkflow(run.Path, targetWorkflow) {
+			continue
+		}
+
+		runStatus, _ := helpers.MapRunStatus(run.Status, run.Conclusion)
+
+		resp.RunID = run.ID
+		resp.RunNumber = int(run.RunNumber)
+		resp.URL = run.HTMLURL
+		resp.Status = runStatus
+		break
+	}
+
+	return resp, nil
+}
+
+// GetStatus gets the status of a single workflow run
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest run)
+//
+// Example:
+// status, err := a.GetStatus(ctx, &types.StatusRequest{ RunID: 1,})
+func (a *GiteaAdapter) GetStatus(ctx context.Context, req *types.StatusRequest) (*types.Status, error) {
+	run, err := a.resolveRun(req.RunID, req.Name)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "status_failed",
+			Message:  err.Error(),
+			Platform: constants.GITEA_PLATFORM,
+		}
+	}
+
+	runStatus, conclusion := helpers.MapRunStatus(run.Status, run.Conclusion)
+
+	status := &types.Status{
+		RunID:       run.ID,
+		RunNumber:   int(run.RunNumber),
+		Status:      runStatus,
+		Conclusion:  conclusion,
+		StartedAt:   gitea.TimeOrZero(run.StartedAt),
+		CompletedAt: gitea.TimeOrZero(run.CompletedAt),
+		URL:         run.HTMLURL,
+		Branch:      run.HeadBranch,
+		QueuedAt:    gitea.TimeOrZero(run.CreatedAt),
+		Metadata: map[string]interface{}{
+			"path":  run.Path,
+			"event": run.Event,
+		},
+	}
+
+	if !status.CompletedAt.IsZero() && !status.StartedAt.IsZero() {
+		status.Duration = status.CompletedAt.Sub(status.StartedAt)
+	}
+
+	return status, nil
+}
+
+// ListWorkflows lists all workflows
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (WithDispatch keeps only workflows with a workflow_dispatch trigger)
+//
+// Example:
+// workflows, err := a.ListWorkflows(ctx, &types.ListWorkflowsRequest{ WithDispatch: true,})
+func (a *GiteaAdapter) ListWorkflows(ctx context.Context, req *types.ListWorkflowsRequest) ([]*types.Workflow, error) {
+	giteaWorkflows, err := a.Client.ListWorkflows(a.owner, a.repo)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "forbidden",
+			Message:  err.Error(),
+			Platform: constants.GITEA_PLATFORM,
+		}
+	}
+
+	workflows := make([]*types.Workflow, 0, len(giteaWorkflows))
+	for idx, wf := range giteaWorkflows {
+		if req.WithDispatch {
+			content, err := a.Client.GetFileContent(a.owner, a.repo, wf.Path)
+			if err != nil || !strings.Contains(content, "workflow_dispatch") {
+				continue
+			}
+		}
+
+		workflows = append(workflows, &types.Workflow{
+			// gitea identifies workflows by their file name, the position is stable for a given ref
+			ID:           int64(idx + 1),
+			Name:         wf.Name,
+			Path:         wf.Path,
+			State:        wf.State,
+			URL:          wf.HTMLURL,
+			WithDispatch: req.WithDispatch,
+		})
+	}
+
+	return workflows, nil
+}
+
+// ListWorkflowJobs lists the jobs of a workflow run
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest run of the workflow)
+//
+// Example:
+// jobs, err := a.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{ RunID: 42})
+func (a *GiteaAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
+	runID := req.RunID
+	workflowName := req.WorkflowName
+
+	if runID == 0 {
+		runs, err := a.Client.ListRuns(a.owner, a.repo, req.Branch, "", 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, run := range runs {
+			if helpers.MatchesWorkflow(run.Path, req.WorkflowName) {
+				runID = run.ID
+				workflowName = run.Path
+				break
+			}
+		}
+
+		if runID == 0 {
+			return nil, &types.PlatformError{
+				Code:     "not_found",
+				Message:  "<?> Error: No workflow runs found.",
+				Platform: constants.GITEA_PLATFORM,
+			}
+		}
+	}
+
+	giteaJobs, err := a.Client.ListRunJobs(a.owner, a.repo, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*types.WorkflowJob, 0, len(giteaJobs))
+	for _, job := range giteaJobs {
+		jobStatus, conclusion := helpers.MapRunStatus(job.Status, job.Conclusion)
+		if req.Status != "" && req.Status != jobStatus {
+			continue
+		}
+
+		jobs = append(jobs, &types.WorkflowJob{
+			ID:           job.ID,
+			RunID:        runID,
+			WorkflowName: workflowName,
+			Name:         job.Name,
+			Status:       jobStatus,
+			Conclusion:   conclusion,
+			RunURL:       job.RunURL,
+			URL:          job.URL,
+			HTMLURL:      job.HTMLURL,
+			StartedAt:    gitea.TimeOrZero(job.StartedAt),
+			CompletedAt:  gitea.TimeOrZero(job.CompletedAt),
+		})
+	}
+
+	return jobs, nil
+}
+
+// ListWorkflowRuns lists the most recent workflow runs (all that is <= limit)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// runs, err := a.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{ WorkflowName: "deploy.yml"})
+func (a *GiteaAdapter) ListWorkflowRuns(ctx context.Context, req *types.ListWorkflowRunsRequest) ([]*types.Run, error) {
+	giteaRuns, err := a.Client.ListRuns(a.owner, a.repo, req.Branch, "", 0)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  err.Error(),
+			Platform: constants.GITEA_PLATFORM,
+		}
+	}
+
+	runs := make([]*types.Run, 0, len(giteaRuns))
+	for _, run := range giteaRuns {
+		if req.Limit > 0 && len(runs) >= req.Limit {
+			break
+		}
+
+		if !helpers.MatchesWorkflow(run.Path, req.WorkflowName) {
+			continue
+		}
+
+		runStatus, conclusion := helpers.MapRunStatus(run.Status, run.Conclusion)
+		if req.Status != "" && req.Status != runStatus {
+			continue
+		}
+
+		var actor, triggeredBy string
+		if run.Actor != nil {
+			actor = run.Actor.Login
+		}
+		triggeredBy = actor
+		if run.TriggerActor != nil {
+			triggeredBy = run.TriggerActor.Login
+		}
+
+		updatedAt := gitea.TimeOrZero(run.CompletedAt)
+		if updatedAt.IsZero() {
+			updatedAt = gitea.TimeOrZero(run.StartedAt)
+		}
+
+		runs = append(runs, &types.Run{
+			RunID:       run.ID,
+			RunNumber:   int(run.RunNumber),
+			Status:      runStatus,
+			Conclusion:  conclusion,
+			Branch:      run.HeadBranch,
+			Actor:       actor,
+			Event:       run.Event,
+			CommitSHA:   run.HeadSHA,
+			TriggeredBy: triggeredBy,
+			CreatedAt:   gitea.TimeOrZero(run.CreatedAt),
+			UpdatedAt:   updatedAt,
+			URL:         run.HTMLURL,
+
+			WorkflowName: filepath.Base(strings.SplitN(run.Path, "@", 2)[0]),
+		})
+	}
+
+	return runs, nil
+}
+
+// StreamLogs streams the logs of every job of a workflow run line by line
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest run)
+//   - callback: logs callback
+//
+// Example:
+// err := a.StreamLogs(ctx, &types.LogsStreamRequest{ RunID: 1, Follow: true}, &callback)
+func (a *GiteaAdapter) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	run, err := a.resolveRun(req.RunID, "")
+	if err != nil {
+		return &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.GITEA_PLATFORM,
+		}
+	}
+
+	// offsets keeps track of how much of each job log was already delivered
+	offsets := make(map[int64]int)
+	var tail []*types.LogLine
+
+	emit := func(line *types.LogLine) error {
+		// with --tail, lines are only delivered once the whole output is known
+		if req.Tail > 0 && !req.Follow {
+			tail = append(tail, line)
+			if len(tail) > req.Tail {
+				tail = tail[1:]
+			}
+			return nil
+		}
+
+		if callback == nil || *callback == nil {
+			return nil
+		}
+
+		return (*callback)(line)
+	}
+
+	for {
+		current, err := a.Client.GetRun(a.owner, a.repo, run.ID)
+		if err != nil {
+			return &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.GITEA_PLATFORM,
+			}
+		}
+		runStatus, _ := helpers.MapRunStatus(current.Status, current.Conclusion)
+
+		jobs, err := a.Client.ListRunJobs(a.owner, a.repo, run.ID)
+		if err != nil {
+			return &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.GITEA_PLATFORM,
+			}
+		}
+
+		for _, job := range jobs {
+			jobStatus, _ := helpers.MapRunStatus(job.Status, job.Conclusion)
+			if jobStatus == "queued" || jobStatus == "waiting" {
+				continue
+			}
+
+			logs, err := a.Client.GetJobLogs(a.owner, a.repo, job.ID)
+			if err != nil {
+				return &types.PlatformError{
+					Code:     "logs_failed",
+					Message:  err.Error(),
+					Platform: constants.GITEA_PLATFORM,
+				}
+			}
+
+			offset := offsets[job.ID]
+			if offset > len(logs) {
+				offset = 0
+			}
+
+			pending := logs[offset:]
+			// a running job may still be writing it's last line
+			if jobStatus != "completed" {
+				if idx := strings.LastIndex(pending, "\n"); idx >= 0 {
+					pending = pending[:idx+1]
+				} else {
+					pending = ""
+				}
+			}
+			offsets[job.ID] = offset + len(pending)
+
+			if pending == "" {
+				continue
+			}
+
+			for _, raw := range strings.Split(strings.TrimSuffix(pending, "\n"), "\n") {
+				timestamp, content := internalHelpers.SplitLogLine(strings.TrimSuffix(raw, "\r"))
+
+				line := &types.LogLine{
+					Content:   content,
+					Timestamp: timestamp,
+					JobName:   job.Name,
+					JobID:     job.ID,
+					Level:     internalHelpers.DetectLevel(content),
+				}
+
+				if err := emit(line); err != nil {
+					return err
+				}
+			}
+		}
+
+		if !req.Follow || runStatus == "completed" {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(giteaConstants.LogsPollInterval):
+		}
+	}
+
+	if callback != nil && *callback != nil {
+		for _, line := range tail {
+			if err := (*callback)(line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListWorkflowRunLogs downloads the logs of every job of a workflow run (a file per job)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// logs, err := a.ListWorkflowRunLogs(ctx, &types.LogsRequest{ RunID: 1,})
+func (a *GiteaAdapter) ListWorkflowRunLogs(ctx context.Context, req *types.LogsRequest) (*types.LogsResponse, error) {
+	run, err := a.resolveRun(req.RunID, req.WorkflowName)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.GITEA_PLATFORM,
+		}
+	}
+
+	jobs, err := a.Client.ListRunJobs(a.owner, a.repo, run.ID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.GITEA_PLATFORM,
+		}
+	}
+
+	dir := req.DownloadPath
+	if dir == "" {
+		configDir, err := internalHelpers.GetConfigDir()
+		if err != nil {
+			return nil, err
+		}
+
+		dir = filepath.Join(configDir, "logs", fmt.Sprintf("%s-%s-%d", a.owner, a.repo, run.ID))
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to create logs directory.\n<?> Error: %w", err)
+	}
+
+	var total int
+	files := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		logs, err := a.Client.GetJobLogs(a.owner, a.repo, job.ID)
+		if err != nil {
+			return nil, &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.GITEA_PLATFORM,
+			}
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("%d-%s.log", job.ID, strings.ReplaceAll(job.Name, "/", "-")))
+		if err := os.WriteFile(path, []byte(logs), 0644); err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to write logs data: %w", err)
+		}
+		total += len(logs)
+		files = append(files, path)
+	}
+
+	return &types.LogsResponse{
+		URL:   run.HTMLURL,
+		Path:  dir,
+		Files: files,
+		Size:  int64(total),
+	}, nil
+}
+
+// GetWorkflowRunSummary retrieves the summary of a workflow run
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (ID is the run ID)
+//
+// Example:
+// summary, err := a.GetWorkflowRunSummary(ctx, &types.Workflow{ ID: 1,})
+func (a *GiteaAdapter) GetWorkflowRunSummary(ctx context.Context, req *types.Workflow) (*types.WorkflowRunSummary, error) {
+	run, err := a.resolveRun(req.ID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	runStatus, conclusion := helpers.MapRunStatus(run.Status, run.Conclusion)
+
+	return &types.WorkflowRunSummary{
+		ID:         run.ID,
+		Name:       run.DisplayTitle,
+		Status:     runStatus,
+		Conclusion: conclusion,
+		CreatedAt:  gitea.TimeOrZero(run.CreatedAt).String(),
+		UpdatedAt:  gitea.TimeOrZero(run.CompletedAt).String(),
+		HTMLURL:    run.HTMLURL,
+	}, nil
+}
+
+// ListArtifacts isn't supported (action artifacts aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// artifacts, err := a.ListArtifacts(ctx, &types.ListArtifactsRequest{ RunID: 1,})
+func (a *GiteaAdapter) ListArtifacts(ctx context.Context, req *types.ListArtifactsRequest) ([]*types.Artifact, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Action artifacts aren't supported by uniflow yet",
+		Platform: constants.GITEA_PLATFORM,
+	}
+}
+
+// DownloadArtifact isn't supported (action artifacts aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.DownloadArtifact(ctx, &types.DownloadArtifactRequest{ RunID: 1, Artifact: artifact,})
+func (a *GiteaAdapter) DownloadArtifact(ctx context.Context, req *types.DownloadArtifactRequest) (*types.DownloadArtifactResponse, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Action artifacts aren't supported by uniflow yet",
+		Platform: constants.GITEA_PLATFORM,
+	}
+}
+
+// ListPendingApprovals isn't supported (gitea actions have no deployment reviews)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// approvals, err := a.ListPendingApprovals(ctx, &types.ListApprovalsRequest{})
+func (a *GiteaAdapter) ListPendingApprovals(ctx context.Context, req *types.ListApprovalsRequest) ([]*types.PendingApproval, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Deployment reviews aren't supported by gitea actions",
+		Platform: constants.GITEA_PLATFORM,
+	}
+}
+
+// ReviewApproval isn't supported (gitea actions have no deployment reviews)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.ReviewApproval(ctx, &types.ReviewApprovalRequest{ RunID: 1, Approve: true,})
+func (a *GiteaAdapter) ReviewApproval(ctx context.Context, req *types.ReviewApprovalRequest) error {
+	return &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Deployment reviews aren't supported by gitea actions",
+		Platform: constants.GITEA_PLATFORM,
+	}
+}
+
+// Cancel isn't exposed by the gitea actions api (runs can only be cancelled from the web ui)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.Cancel(ctx, &types.Run{ RunID: 1,})
+func (a *GiteaAdapter) Cancel(ctx context.Context, req *types.Run) error {
+	return &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Cancelling runs isn't supported by the gitea actions api",
+		Platform: constants.GITEA_PLATFORM,
+	}
+}
+
+// Rerun isn't exposed by the gitea actions api (runs can only be rerun from the web ui)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.Rerun(ctx, &types.RerunRequest{ RunID: 1,})
+func (a *GiteaAdapter) Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Rerunning runs isn't supported by the gitea actions api",
+		Platform: constants.GITEA_PLATFORM,
+	}
+}
+
+// GetRepository returns current repository elements (owner/repo)
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// owner, repo := a.GetRepository(ctx)
+func (a *GiteaAdapter) GetRepository(ctx context.Context) (string, string) {
+	return a.owner, a.repo
+}
+
+// GetRepositoryInfo returns the configured repository information
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// info, err := a.GetRepositoryInfo(ctx)
+func (a *GiteaAdapter) GetRepositoryInfo(ctx context.Context) (*types.RepositoryInfo, error) {
+	repository, err := a.Client.GetRepository(a.owner, a.repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RepositoryInfo{
+		Name:          repository.Name,
+		FullName:      repository.FullName,
+		Description:   repository.Description,
+		DefaultBranch: repository.DefaultBranch,
+		Private:       repository.Private,
+		HTMLURL:       repository.HTMLURL,
+	}, nil
+}
+
+// GetUnderlyingClient returns the gitea client from the GiteaAdapter struct but as an interface
+//
+// Parameters:
+//   - None
+//
+// Example:
+// client := a.GetUnderlyingClient()
+func (a *GiteaAdapter) GetUnderlyingClient() interface{} {
+	return a.Client
+}
+
+// IsGithub verifies that the current client is a github client
+//
+// Parameters:
+//   - None
+//
+// Example:
+// valid:= a.IsGithub()
+func (a *GiteaAdapter) IsGithub() bool {
+	return false
+}
+
+// resolveRun retrieves the requested run, or the latest one (of a workflow if any)
+//
+// Parameters:
+//   - runID: run ID (0 = latest run)
+//   - workflowName: workflow file name (optional)
+//
+// Example:
+// run, err := a.resolveRun(0, "ci.yml")
+func (a *GiteaAdapter) resolveRun(runID int64, workflowName string) (*gitea.Run, error) {
+	if runID != 0 {
+		return a.Client.GetRun(a.owner, a.repo, runID)
+	}
+
+	runs, err := a.Client.ListRuns(a.owner, a.repo, "", "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, run := range runs {
+		if helpers.MatchesWorkflow(run.Path, workflowName) {
+			return run, nil
+		}
+	}
+
+	return nil, fmt.Errorf("<?> Error: No workflow runs found for %s/%s", a.owner, a.repo)
+}
@@ -4,16 +4,53 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	githubClient "github.com/google/go-github/v57/github"
+	"github.com/ignorant05/Uniflow/internal/config"
 	"github.com/ignorant05/Uniflow/platforms/configurations/github"
 
+	registry "github.com/ignorant05/Uniflow/platforms"
+	githubConstants "github.com/ignorant05/Uniflow/platforms/configurations/github/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
 	ghlogs "github.com/ignorant05/Uniflow/platforms/configurations/github/logs"
 	"github.com/ignorant05/Uniflow/platforms/constants"
 	"github.com/ignorant05/Uniflow/types"
 )
 
+// init registers github Actions in the platforms registry
+// NOTE: gitea dedicated directories must win over the shared layout, other platforms come after
+func init() {
+	registry.Register(&registry.Platform{
+		PlatformSection: config.PlatformSection{
+			Name:   constants.GITHUB_PLATFORM,
+			Title:  "GitHub",
+			Decode: config.DecodeSection[github.Config],
+			Validate: func(prefix string, section interface{}) []error {
+				return github.ValidateConfig(prefix, section.(*github.Config))
+			},
+			SecretFields:  []string{"token"},
+			SecretEnvVars: []string{githubConstants.GITHUB_TOKEN_ENV_VAR_NAME},
+		},
+		DetectionPaths: githubConstants.DETECTION_PATHS,
+		Confidence:     90,
+		New: func(ctx context.Context, profile *config.Profile) (registry.PlatformClient, error) {
+			client, err := github.NewClientFromProfile(ctx, profile)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewGithubAdapter(client)
+		},
+	})
+}
+
 type GithubAdapter struct {
 	Client *github.Client
 	owner  string
@@ -40,7 +77,8 @@ func NewGithubAdapter(client *github.Client) (*GithubAdapter, error) {
 	}, nil
 }
 
-// TriggerWorkflow triggers a workflow
+// TriggerWorkflow triggers a workflow and finds the run it created
+// NOTE: if the run doesn't show up in time, the response has no RunID (the workflow is dispatched anyway)
 //
 // Parameters:
 //   - ctx: the context variable
@@ -58,10 +96,52 @@ func (a *GithubAdapter) TriggerWorkflow(ctx context.Context, req *types.TriggerR
 		targetWorkflow = constants.DEFAULT_WORKFLOW
 	}
 
-	err := a.Client.TriggerWorkflow(
+	workflow, err := a.findWorkflow(targetWorkflow)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowID := workflow.GetID()
+	workflowPath := workflow.GetPath()
+
+	var correlationID string
+	if req.CorrelationInput != "" {
+		correlationID = helpers.NewCorrelationID()
+		inputs[req.CorrelationInput] = correlationID
+	}
+
+	inputs, err = a.resolveDispatchInputs(targetWorkflow, workflowPath, req.Branch, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the dispatch api doesn't return the run, it's looked up by event, ref, actor and creation time
+	branch := strings.TrimPrefix(strings.TrimPrefix(req.Branch, "refs/heads/"), "refs/tags/")
+	since := time.Now().Add(-githubConstants.CORRELATION_CLOCK_SKEW)
+
+	// no actor filter if the token can't read its user
+	actor, _ := a.Client.GetAuthenticatedLogin()
+
+	known := make(map[int64]bool)
+	previousRuns, err := a.Client.ListDispatchedRuns(a.owner, a.repo, workflowID, branch, actor, since)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, run := range previousRuns {
+		known[run.GetID()] = true
+	}
+
+	// without correlation ID, the run is also matched by commit (not checked if the ref can't be resolved)
+	var headSHA string
+	if correlationID == "" && branch != "" {
+		headSHA, _ = a.Client.GetRefSHA(a.owner, a.repo, branch)
+	}
+
+	err = a.Client.TriggerWorkflow(
 		a.owner,
 		a.repo,
-		targetWorkflow,
+		path.Base(workflowPath),
 		req.Branch,
 		inputs,
 	)
@@ -74,35 +154,131 @@ func (a *GithubAdapter) TriggerWorkflow(ctx context.Context, req *types.TriggerR
 		}
 	}
 
-	workflows, err := a.Client.ListWorkflows(a.owner, a.repo)
+	deadline := time.Now().Add(githubConstants.CORRELATION_TIMEOUT)
+	for {
+		runs, err := a.Client.ListDispatchedRuns(a.owner, a.repo, workflowID, branch, actor, since)
+		if err != nil {
+			return nil, err
+		}
+
+		if run := helpers.MatchDispatchedRun(runs, known, correlationID, branch, headSHA); run != nil {
+			return &types.TriggerResponse{
+				RunID:         run.GetID(),
+				RunNumber:     run.GetRunNumber(),
+				URL:           run.GetHTMLURL(),
+				Status:        run.GetStatus(),
+				QueuedAt:      run.GetCreatedAt().Time,
+				CorrelationID: correlationID,
+			}, nil
+		}
+
+		// dispatched, but the run didn't show up (yet)
+		if time.Now().After(deadline) {
+			return &types.TriggerResponse{
+				Status:        "queued",
+				CorrelationID: correlationID,
+			}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(githubConstants.CORRELATION_POLL_INTERVAL):
+		}
+	}
+}
+
+// ListBranches lists the repository branches (default branch first)
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// branches, err := a.ListBranches(ctx)
+func (a *GithubAdapter) ListBranches(ctx context.Context) ([]string, error) {
+	branches, err := a.Client.ListBranches(a.owner, a.repo)
 	if err != nil {
-		return nil, err
+		return nil, &types.PlatformError{
+			Code:     "list_failed",
+			Message:  err.Error(),
+			Platform: constants.GITHUB_PLATFORM,
+		}
 	}
 
-	var workflowID int64
-	for _, wf := range workflows {
-		if strings.Contains(wf.GetPath(), req.WorkflowName) {
-			workflowID = wf.GetID()
+	var defaultBranch string
+	if info, err := a.Client.GetRepositoryInfo(a.owner, a.repo); err == nil {
+		defaultBranch = info.DefaultBranch
+	}
+
+	names := make([]string, 0, len(branches))
+	for _, branch := range branches {
+		if branch.GetName() == defaultBranch {
+			names = append([]string{defaultBranch}, names...)
+			continue
 		}
+
+		names = append(names, branch.GetName())
 	}
 
-	if workflowID == 0 {
-		return nil, fmt.Errorf("<?> Error: workflow not found: %s", req.WorkflowName)
+	return names, nil
+}
+
+// DescribeInputs describes the workflow_dispatch inputs of a workflow
+//
+// Parameters:
+//   - ctx: the context variable
+//   - workflow: workflow file name (or path)
+//   - branch: branch the workflow runs on (the inputs are read from it)
+//
+// Example:
+// inputs, err := a.DescribeInputs(ctx, "deploy.yml", "main")
+func (a *GithubAdapter) DescribeInputs(ctx context.Context, workflow, branch string) ([]*types.WorkflowInput, error) {
+	wf, err := a.findWorkflow(workflow)
+	if err != nil {
+		return nil, err
 	}
 
-	runs, err := a.Client.GetWorkflowRuns(a.owner, a.repo, workflowID)
+	content, err := a.Client.GetWorkflowFileContent(a.owner, a.repo, wf.GetPath(), branch)
 	if err != nil {
 		return nil, err
 	}
 
-	latestRun := runs[0]
+	inputs, _, err := helpers.ParseDispatchInputs(content)
 
-	return &types.TriggerResponse{
-		RunID:     latestRun.GetID(),
-		RunNumber: latestRun.GetRunNumber(),
-		Status:    latestRun.GetStatus(),
-		QueuedAt:  latestRun.GetCreatedAt().Time,
-	}, nil
+	return inputs, err
+}
+
+// resolveDispatchInputs validates inputs against the workflow_dispatch schema of the workflow file (as of the dispatched ref)
+// NOTE: values are coerced and defaults filled in, validation is skipped if the file can't be read
+func (a *GithubAdapter) resolveDispatchInputs(workflow, path, ref string, inputs map[string]interface{}) (map[string]interface{}, error) {
+	content, err := a.Client.GetWorkflowFileContent(a.owner, a.repo, path, ref)
+	if err != nil {
+		return inputs, nil
+	}
+
+	schema, hasDispatch, err := helpers.ParseDispatchInputs(content)
+	if err != nil {
+		return inputs, nil
+	}
+
+	if !hasDispatch {
+		return nil, &types.PlatformError{
+			Code:     "trigger_failed",
+			Message:  fmt.Sprintf("Workflow %s has no workflow_dispatch trigger", workflow),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	resolved, err := helpers.ValidateDispatchInputs(workflow, schema, inputs)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "invalid_inputs",
+			Message:  err.Error(),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	return resolved, nil
 }
 
 // GetStatus gets the status of a single workflow
@@ -128,7 +304,11 @@ func (a *GithubAdapter) GetStatus(ctx context.Context, req *types.StatusRequest)
 		RunNumber: run.GetRunNumber(),
 		Status:    run.GetStatus(),
 		StartedAt: run.GetRunStartedAt().Time,
-		URL:       run.GetRerunURL(),
+		URL:       run.GetHTMLURL(),
+		Branch:    run.GetHeadBranch(),
+		Metadata: map[string]interface{}{
+			"attempt": run.GetRunAttempt(),
+		},
 	}
 
 	if run.GetConclusion() != "" {
@@ -193,47 +373,48 @@ func (a *GithubAdapter) ListWorkflows(ctx context.Context, req *types.ListWorkfl
 	return workflows, nil
 }
 
-// ListWorkflowJobs lists all workflow job
+// ListWorkflowJobs lists the jobs (and their steps) of a run, or of the latest run of a workflow
 //
 // Parameters:
 //   - ctx: the context variable
 //   - req: the request body
 //
 // Example:
-// runs, err := a.ListWorkflowJobs(ctx, &types.ListWorkflowJobsRequest{ WorkflowName: "deploy.yml"})
+// jobs, err := a.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{ RunID: 42})
 func (a *GithubAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
-	var workflowID int64
-	if req.WorkflowName != "" {
-		workflows, err := a.Client.ListWorkflows(a.owner, a.repo)
+	runID := req.RunID
+	if runID == 0 && req.WorkflowName != "" {
+		workflowID, err := a.resolveWorkflowID(req.WorkflowName)
 		if err != nil {
 			return nil, err
 		}
 
-		if len(workflows) <= 0 {
+		// the latest run
+		runs, err := a.Client.GetWorkflowRuns(a.owner, a.repo, workflowID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(runs) == 0 {
 			return nil, &types.PlatformError{
 				Code:     "not_found",
-				Message:  "<?> Error: No workflows found.",
+				Message:  "<?> Error: No workflow runs found for " + req.WorkflowName,
 				Platform: constants.GITHUB_PLATFORM,
 			}
 		}
 
-		for _, wf := range workflows {
-			if strings.Contains(wf.GetPath(), req.WorkflowName) {
-				workflowID = wf.GetID()
-				break
-			}
-		}
+		runID = runs[0].GetID()
+	}
 
-		if workflowID == 0 {
-			return nil, &types.PlatformError{
-				Code:     "not_found",
-				Message:  "<?> Error: No workflows found.",
-				Platform: constants.GITHUB_PLATFORM,
-			}
+	if runID == 0 {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  "<?> Error: A run ID (or a workflow) is required to list jobs",
+			Platform: constants.GITHUB_PLATFORM,
 		}
 	}
 
-	alljobs, err := a.Client.ListWorkflowJobs(a.owner, a.repo, workflowID)
+	alljobs, err := a.Client.ListWorkflowJobs(a.owner, a.repo, runID)
 	if err != nil {
 		return nil, err
 	}
@@ -248,6 +429,16 @@ func (a *GithubAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWok
 			continue
 		}
 
+		steps := make([]*types.WorkflowStep, 0, len(job.Steps))
+		for _, step := range job.Steps {
+			steps = append(steps, &types.WorkflowStep{
+				Number:     step.GetNumber(),
+				Name:       step.GetName(),
+				Status:     step.GetStatus(),
+				Conclusion: step.GetConclusion(),
+			})
+		}
+
 		jobs = append(jobs, &types.WorkflowJob{
 			ID:           job.GetID(),
 			RunID:        job.GetRunID(),
@@ -258,6 +449,9 @@ func (a *GithubAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWok
 			RunURL:       job.GetRunURL(),
 			URL:          job.GetURL(),
 			HTMLURL:      job.GetHTMLURL(),
+			Steps:        steps,
+			StartedAt:    job.GetStartedAt().Time,
+			CompletedAt:  job.GetCompletedAt().Time,
 		})
 	}
 
@@ -273,44 +467,20 @@ func (a *GithubAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWok
 // Example:
 // runs, err := a.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{ WorkflowName: "deploy.yml"})
 func (a *GithubAdapter) ListWorkflowRuns(ctx context.Context, req *types.ListWorkflowRunsRequest) ([]*types.Run, error) {
-	var workflowID int64
-	if req.WorkflowName != "" {
-		workflows, err := a.Client.ListWorkflows(a.owner, a.repo)
-		if err != nil {
-			return nil, err
-		}
-
-		if len(workflows) <= 0 {
-			return nil, &types.PlatformError{
-				Code:     "not_found",
-				Message:  "<?> Error: No workflows found.",
-				Platform: constants.GITHUB_PLATFORM,
-			}
-		}
-
-		for _, wf := range workflows {
-			if strings.Contains(wf.GetPath(), req.WorkflowName) {
-				workflowID = wf.GetID()
-				break
-			}
-		}
-
-		if workflowID == 0 {
-			return nil, &types.PlatformError{
-				Code:     "not_found",
-				Message:  "<?> Error: No workflows found.",
-				Platform: constants.GITHUB_PLATFORM,
-			}
-		}
+	workflowID, err := a.resolveWorkflowID(req.WorkflowName)
+	if err != nil {
+		return nil, err
 	}
+
 	ghruns, err := a.Client.GetWorkflowRuns(a.owner, a.repo, workflowID)
 	if err != nil {
 		return nil, err
 	}
 
 	runs := make([]*types.Run, 0, len(ghruns))
-	for idx, r := range ghruns {
-		if req.Limit > 0 && idx >= req.Limit {
+	for _, r := range ghruns {
+		// the limit applies to the filtered runs
+		if req.Limit > 0 && len(runs) >= req.Limit {
 			break
 		}
 
@@ -335,35 +505,210 @@ func (a *GithubAdapter) ListWorkflowRuns(ctx context.Context, req *types.ListWor
 			CreatedAt:   r.GetCreatedAt().Time,
 			UpdatedAt:   r.GetUpdatedAt().Time,
 			URL:         r.GetURL(),
+
+			WorkflowName: r.GetName(),
 		})
 	}
 
 	return runs, nil
 }
 
-// GetGithubClient streams logs
+// resolveWorkflowID finds the ID of the workflow of a path or file name (0 without name)
+func (a *GithubAdapter) resolveWorkflowID(name string) (int64, error) {
+	if name == "" {
+		return 0, nil
+	}
+
+	workflow, err := a.findWorkflow(name)
+	if err != nil {
+		return 0, err
+	}
+
+	return workflow.GetID(), nil
+}
+
+// findWorkflow finds the workflow of a path or file name
+func (a *GithubAdapter) findWorkflow(name string) (*githubClient.Workflow, error) {
+	workflows, err := a.Client.ListWorkflows(a.owner, a.repo)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, err := helpers.MatchWorkflow(workflows, name)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "ambiguous_workflow",
+			Message:  err.Error(),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	if workflow == nil {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  "<?> Error: Workflow not found: " + name,
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	return workflow, nil
+}
+
+// StreamLogs streams the logs of every job of a workflow run line by line
+// NOTE: lines carry their timestamp, job, step, group and level, workflow commands (::error file=...::) are parsed
 //
 // Parameters:
-//   - ctx: the context variable
+//   - ctx: the context variable (cancelling it stops following)
 //   - req: the request body
 //   - callback: logs callback
 //
 // Example:
-// err := a.StreamLogs(ctx, &types.LogsStreamRequest{ ID: 1,})
+// err := a.StreamLogs(ctx, &types.LogsStreamRequest{ RunID: 1, Follow: true}, &callback)
 func (a *GithubAdapter) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
-	_, err := a.Client.GetWorkflowRunLogs(a.owner, a.repo, req.RunID)
-	if err != nil {
+	if req.RunID == 0 {
 		return &types.PlatformError{
 			Code:     "logs_failed",
-			Message:  err.Error(),
+			Message:  "<?> Error: A run ID is required to stream logs",
 			Platform: constants.GITHUB_PLATFORM,
 		}
 	}
 
+	// offsets keeps track of how much of each job log was already delivered (only the rest is downloaded)
+	offsets := make(map[int64]int64)
+	// parsers keep track of the open group of each job
+	parsers := make(map[int64]*helpers.LogLineParser)
+	// tails keep the last lines of each job (in the jobs order)
+	tails := make(map[int64][]*types.LogLine)
+	var tailJobs []int64
+
+	emit := func(jobID int64, line *types.LogLine) error {
+		// with --tail, lines are only delivered once the whole output of the job is known
+		if req.Tail > 0 && !req.Follow {
+			if _, ok := tails[jobID]; !ok {
+				tailJobs = append(tailJobs, jobID)
+			}
+
+			tails[jobID] = append(tails[jobID], line)
+			if len(tails[jobID]) > req.Tail {
+				tails[jobID] = tails[jobID][1:]
+			}
+			return nil
+		}
+
+		if callback == nil || *callback == nil {
+			return nil
+		}
+
+		return (*callback)(line)
+	}
+
+	for {
+		run, err := a.Client.GetWorkflowRunStatus(a.owner, a.repo, req.RunID)
+		if err != nil {
+			return &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.GITHUB_PLATFORM,
+			}
+		}
+
+		jobs, err := a.Client.ListWorkflowJobs(a.owner, a.repo, req.RunID)
+		if err != nil {
+			return &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.GITHUB_PLATFORM,
+			}
+		}
+
+		for _, job := range jobs {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if job.GetStatus() == "queued" || job.GetStatus() == "waiting" {
+				continue
+			}
+
+			if len(req.JobIDs) > 0 && !slices.Contains(req.JobIDs, job.GetID()) {
+				continue
+			}
+
+			parser, ok := parsers[job.GetID()]
+			if !ok {
+				parser = &helpers.LogLineParser{JobName: job.GetName(), JobID: job.GetID()}
+				parsers[job.GetID()] = parser
+			}
+			parser.Steps = job.Steps
+
+			// large logs are read in chunks
+			for truncated := true; truncated && ctx.Err() == nil; {
+				var pending string
+				var start int64
+
+				pending, start, truncated, err = a.Client.GetJobLogs(a.owner, a.repo, job.GetID(), offsets[job.GetID()])
+				if err != nil {
+					// logs of a running job may not be available yet
+					if job.GetStatus() != "completed" {
+						break
+					}
+
+					return &types.PlatformError{
+						Code:     "logs_failed",
+						Message:  err.Error(),
+						Platform: constants.GITHUB_PLATFORM,
+					}
+				}
+
+				// a running job may still be writing it's last line, a chunk may end in the middle of one
+				if job.GetStatus() != "completed" || truncated {
+					if idx := strings.LastIndex(pending, "\n"); idx >= 0 {
+						pending = pending[:idx+1]
+					} else if !truncated {
+						pending = ""
+					}
+				}
+				offsets[job.GetID()] = start + int64(len(pending))
+
+				if pending == "" {
+					break
+				}
+
+				for _, raw := range strings.Split(strings.TrimSuffix(pending, "\n"), "\n") {
+					line := parser.Parse(raw)
+
+					if err := emit(job.GetID(), line); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if !req.Follow || run.GetStatus() == "completed" {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(githubConstants.PollInterval):
+		}
+	}
+
+	if callback != nil && *callback != nil {
+		for _, jobID := range tailJobs {
+			for _, line := range tails[jobID] {
+				if err := (*callback)(line); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
-// ListWorkflowRunLogs lists the logs for a workflow
+// ListWorkflowRunLogs downloads the logs archive of a run (unpacked with an index.json if req.Extract is set)
 //
 // Parameters:
 //   - ctx: the context variable
@@ -386,13 +731,40 @@ func (a *GithubAdapter) ListWorkflowRunLogs(ctx context.Context, req *types.Logs
 		path = req.WorkflowName
 	}
 
-	err = ghlogs.DownloadLogs(logsURL, path)
+	path, size, err := ghlogs.DownloadLogs(logsURL, path)
 	if err != nil {
 		return nil, err
 	}
 
+	if req.Extract {
+		dir := strings.TrimSuffix(path, ".zip")
+
+		index, err := ghlogs.ExtractArchive(path, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		files := []string{path}
+		for _, job := range index.Jobs {
+			for _, step := range job.Steps {
+				files = append(files, filepath.Join(dir, step.Path))
+			}
+		}
+
+		return &types.LogsResponse{
+			URL:     logsURL,
+			Path:    dir,
+			Archive: path,
+			Files:   files,
+			Size:    size,
+		}, nil
+	}
+
 	return &types.LogsResponse{
-		URL: logsURL,
+		URL:   logsURL,
+		Path:  path,
+		Files: []string{path},
+		Size:  size,
 	}, nil
 }
 
@@ -430,6 +802,264 @@ func (a *GithubAdapter) Cancel(ctx context.Context, req *types.Run) error {
 	return a.Client.CancelWorkflowRun(a.owner, a.repo, req.RunID)
 }
 
+// ListArtifacts lists the artifacts of a workflow run
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// artifacts, err := a.ListArtifacts(ctx, &types.ListArtifactsRequest{ RunID: 1,})
+func (a *GithubAdapter) ListArtifacts(ctx context.Context, req *types.ListArtifactsRequest) ([]*types.Artifact, error) {
+	ghartifacts, err := a.Client.ListRunArtifacts(a.owner, a.repo, req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "artifacts_failed",
+			Message:  err.Error(),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	artifacts := make([]*types.Artifact, 0, len(ghartifacts))
+	for _, artifact := range ghartifacts {
+		artifacts = append(artifacts, &types.Artifact{
+			ID:        artifact.GetID(),
+			Name:      artifact.GetName(),
+			Size:      artifact.GetSizeInBytes(),
+			Expired:   artifact.GetExpired(),
+			CreatedAt: artifact.GetCreatedAt().Time,
+			ExpiresAt: artifact.GetExpiresAt().Time,
+		})
+	}
+
+	return artifacts, nil
+}
+
+// DownloadArtifact downloads the zip of an artifact (unpacked into Dest/<name> if req.Extract is set)
+// NOTE: github redirects to a short lived download url, which is fetched without the api credentials
+//
+// Parameters:
+//   - ctx: the context variable (cancelling it stops the download)
+//   - req: the request body
+//
+// Example:
+// resp, err := a.DownloadArtifact(ctx, &types.DownloadArtifactRequest{ RunID: 1, Artifact: artifact, Extract: true,})
+func (a *GithubAdapter) DownloadArtifact(ctx context.Context, req *types.DownloadArtifactRequest) (*types.DownloadArtifactResponse, error) {
+	if req.Artifact.Expired {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  fmt.Sprintf("<?> Error: Artifact %s expired", req.Artifact.Name),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	if !filepath.IsLocal(req.Artifact.Name) {
+		return nil, fmt.Errorf("<?> Error: Invalid artifact name: %s", req.Artifact.Name)
+	}
+
+	downloadURL, err := a.Client.GetArtifactDownloadURL(a.owner, a.repo, req.Artifact.ID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "artifacts_failed",
+			Message:  err.Error(),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	dest := req.Dest
+	if dest == "" {
+		dest = "."
+	}
+
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to create artifacts directory.\n<?> Error: %w", err)
+	}
+
+	archive := filepath.Join(dest, req.Artifact.Name+".zip")
+
+	size, err := ghlogs.DownloadZip(ctx, http.DefaultClient, downloadURL, archive, githubConstants.ARTIFACT_MAX_SIZE, req.Progress)
+	if err != nil {
+		_ = os.Remove(archive)
+		return nil, err
+	}
+
+	if !req.Extract {
+		return &types.DownloadArtifactResponse{Path: archive, Size: size}, nil
+	}
+
+	dir := filepath.Join(dest, req.Artifact.Name)
+
+	files, err := ghlogs.ExtractZip(archive, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Remove(archive); err != nil {
+		fmt.Printf("<!> warning: Failed to remove artifact archive: %v\n", err)
+	}
+
+	return &types.DownloadArtifactResponse{Path: dir, Size: size, Files: files}, nil
+}
+
+// ListPendingApprovals lists the deployments waiting on environment protection rules (one per run and environment)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// approvals, err := a.ListPendingApprovals(ctx, &types.ListApprovalsRequest{ Name: "deploy.yml",})
+func (a *GithubAdapter) ListPendingApprovals(ctx context.Context, req *types.ListApprovalsRequest) ([]*types.PendingApproval, error) {
+	var runs []*githubClient.WorkflowRun
+	if req.RunID != 0 {
+		run, err := a.Client.GetWorkflowRunStatus(a.owner, a.repo, req.RunID)
+		if err != nil {
+			return nil, &types.PlatformError{
+				Code:     "not_found",
+				Message:  err.Error(),
+				Platform: constants.GITHUB_PLATFORM,
+			}
+		}
+
+		runs = append(runs, run)
+	} else {
+		workflowID, err := a.resolveWorkflowID(req.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if runs, err = a.Client.ListWaitingRuns(a.owner, a.repo, workflowID); err != nil {
+			return nil, err
+		}
+	}
+
+	approvals := make([]*types.PendingApproval, 0, len(runs))
+	for _, run := range runs {
+		deployments, err := a.Client.ListPendingDeployments(a.owner, a.repo, run.GetID())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, deployment := range deployments {
+			if deployment.Environment == nil {
+				continue
+			}
+
+			approvals = append(approvals, &types.PendingApproval{
+				RunID:        run.GetID(),
+				RunNumber:    run.GetRunNumber(),
+				WorkflowName: run.GetName(),
+				Branch:       run.GetHeadBranch(),
+				Environment:  deployment.Environment.Name,
+				Reviewers:    helpers.ReviewerNames(a.owner, deployment.Reviewers),
+				CanApprove:   deployment.CurrentUserCanApprove,
+				WaitingSince: helpers.WaitingSince(run, deployment.WaitTimerStartedAt),
+				URL:          run.GetHTMLURL(),
+			})
+		}
+	}
+
+	return approvals, nil
+}
+
+// ReviewApproval approves or rejects the pending deployments of a run
+// NOTE: environments are matched by name (case insensitive), all the pending ones are reviewed without names
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.ReviewApproval(ctx, &types.ReviewApprovalRequest{ RunID: 1, Environments: []string{"production"}, Approve: true,})
+func (a *GithubAdapter) ReviewApproval(ctx context.Context, req *types.ReviewApprovalRequest) error {
+	deployments, err := a.Client.ListPendingDeployments(a.owner, a.repo, req.RunID)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[string]int64, len(deployments))
+	names := make([]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		if deployment.Environment != nil {
+			pending[strings.ToLower(deployment.Environment.Name)] = deployment.Environment.ID
+			names = append(names, deployment.Environment.Name)
+		}
+	}
+
+	if len(pending) == 0 {
+		return &types.PlatformError{
+			Code:     "not_found",
+			Message:  fmt.Sprintf("<?> Error: Run %d isn't waiting for any deployment review", req.RunID),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	environmentIDs := slices.Sorted(maps.Values(pending))
+	if len(req.Environments) > 0 {
+		environmentIDs = make([]int64, 0, len(req.Environments))
+		for _, name := range req.Environments {
+			id, ok := pending[strings.ToLower(name)]
+			if !ok {
+				return &types.PlatformError{
+					Code:     "not_found",
+					Message:  fmt.Sprintf("<?> Error: Run %d isn't waiting for environment %s (pending: %s)", req.RunID, name, strings.Join(names, ", ")),
+					Platform: constants.GITHUB_PLATFORM,
+				}
+			}
+
+			environmentIDs = append(environmentIDs, id)
+		}
+	}
+
+	if err := a.Client.ReviewPendingDeployments(a.owner, a.repo, req.RunID, environmentIDs, req.Approve, req.Comment); err != nil {
+		return &types.PlatformError{
+			Code:     "review_failed",
+			Message:  err.Error(),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	return nil
+}
+
+// Rerun reruns a workflow run, in place (or only it's failed jobs)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.Rerun(ctx, &types.RerunRequest{ RunID: 1, FailedOnly: true})
+func (a *GithubAdapter) Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error) {
+	if err := a.Client.RerunWorkflowRun(a.owner, a.repo, req.RunID, req.FailedOnly, req.Debug); err != nil {
+		return nil, &types.PlatformError{
+			Code:     "rerun_failed",
+			Message:  err.Error(),
+			Platform: constants.GITHUB_PLATFORM,
+		}
+	}
+
+	resp := &types.RerunResponse{RunID: req.RunID}
+	if run, err := a.Client.GetWorkflowRunStatus(a.owner, a.repo, req.RunID); err == nil {
+		resp.RunNumber = run.GetRunNumber()
+		resp.URL = run.GetHTMLURL()
+	}
+
+	return resp, nil
+}
+
+// IsProtectedBranch checks whether a branch of the repository is protected
+//
+// Parameters:
+//   - ctx: the context variable
+//   - branch: branch name
+//
+// Example:
+// protected, err := a.IsProtectedBranch(ctx, "main")
+func (a *GithubAdapter) IsProtectedBranch(ctx context.Context, branch string) (bool, error) {
+	return a.Client.IsProtectedBranch(a.owner, a.repo, branch)
+}
+
 // GetGithubClient returns current repository elements (owner/repo)
 //
 // Parameters:
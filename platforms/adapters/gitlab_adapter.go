@@ -0,0 +1,778 @@
+package platforms
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	internalHelpers "github.com/ignorant05/Uniflow/internal/helpers"
+	registry "github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab"
+	gitlabConstants "github.com/ignorant05/Uniflow/platforms/configurations/gitlab/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab/helpers"
+	"github.com/ignorant05/Uniflow/platforms/constants"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// init registers gitlab ci in the platforms registry
+func init() {
+	registry.Register(&registry.Platform{
+		PlatformSection: config.PlatformSection{
+			Name:   constants.GITLAB_PLATFORM,
+			Title:  "GitLab",
+			Decode: config.DecodeSection[gitlab.Config],
+			Validate: func(prefix string, section interface{}) []error {
+				return gitlab.ValidateConfig(prefix, section.(*gitlab.Config))
+			},
+			SecretFields:  []string{"token"},
+			SecretEnvVars: []string{gitlabConstants.GITLAB_TOKEN_ENV_VAR_NAME},
+		},
+		DetectionPaths: gitlabConstants.DETECTION_PATHS,
+		Confidence:     80,
+		New: func(ctx context.Context, profile *config.Profile) (registry.PlatformClient, error) {
+			client, err := gitlab.NewClientFromProfile(ctx, profile)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewGitlabAdapter(client)
+		},
+	})
+}
+
+type GitlabAdapter struct {
+	Client  *gitlab.Client
+	project string
+}
+
+// NewGitlabAdapter creates an adapter object
+//
+// Parameters:
+//   - client: gitlab client
+//
+// Example:
+// adapter, err := NewGitlabAdapter(client)
+func NewGitlabAdapter(client *gitlab.Client) (*GitlabAdapter, error) {
+	project, err := client.GetDefaultProject()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GitlabAdapter{
+		Client:  client,
+		project: project,
+	}, nil
+}
+
+// TriggerWorkflow creates a pipeline on a ref, inputs are passed as pipeline variables
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.TriggerWorkflow(ctx, &types.TriggerRequest{ Branch: "main",})
+func (a *GitlabAdapter) TriggerWorkflow(ctx context.Context, req *types.TriggerRequest) (*types.TriggerResponse, error) {
+	ref := req.Branch
+	if ref == "" {
+		project, err := a.Client.GetProject(a.project)
+		if err != nil {
+			return nil, &types.PlatformError{
+				Code:     "not_found",
+				Message:  err.Error(),
+				Platform: constants.GITLAB_PLATFORM,
+			}
+		}
+		ref = project.DefaultBranch
+	}
+
+	variables := make(map[string]string, len(req.Inputs))
+	for key, val := range req.Inputs {
+		variables[key] = fmt.Sprint(val)
+	}
+
+	pipeline, err := a.Client.CreatePipeline(a.project, ref, variables)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "trigger_failed",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	pipelineStatus, _ := helpers.MapPipelineStatus(pipeline.Status)
+
+	queuedAt := gitlab.TimeOrZero(pipeline.CreatedAt)
+	if queuedAt.IsZero() {
+		queuedAt = time.Now()
+	}
+
+	return &types.TriggerResponse{
+		RunID:     pipeline.ID,
+		RunNumber: int(pipeline.IID),
+		URL:       pipeline.WebURL,
+		Status:    pipelineStatus,
+		QueuedAt:  queuedAt,
+	}, nil
+}
+
+// GetStatus gets the status of a single pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest pipeline)
+//
+// Example:
+// status, err := a.GetStatus(ctx, &types.StatusRequest{ RunID: 1,})
+func (a *GitlabAdapter) GetStatus(ctx context.Context, req *types.StatusRequest) (*types.Status, error) {
+	pipeline, err := a.resolvePipeline(req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "status_failed",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	pipelineStatus, conclusion := helpers.MapPipelineStatus(pipeline.Status)
+
+	status := &types.Status{
+		RunID:       pipeline.ID,
+		RunNumber:   int(pipeline.IID),
+		Status:      pipelineStatus,
+		Conclusion:  conclusion,
+		StartedAt:   gitlab.TimeOrZero(pipeline.StartedAt),
+		CompletedAt: gitlab.TimeOrZero(pipeline.FinishedAt),
+		Duration:    time.Duration(pipeline.Duration * float64(time.Second)),
+		URL:         pipeline.WebURL,
+		Branch:      pipeline.Ref,
+		QueuedAt:    gitlab.TimeOrZero(pipeline.CreatedAt),
+		Metadata: map[string]interface{}{
+			"gitlab_status": pipeline.Status,
+			"source":        pipeline.Source,
+			"ref":           pipeline.Ref,
+		},
+	}
+
+	return status, nil
+}
+
+// ListWorkflows lists the pipeline definition of the project
+// NOTE: gitlab has a single pipeline definition per project
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// workflows, err := a.ListWorkflows(ctx, &types.ListWorkflowsRequest{})
+func (a *GitlabAdapter) ListWorkflows(ctx context.Context, req *types.ListWorkflowsRequest) ([]*types.Workflow, error) {
+	project, err := a.Client.GetProject(a.project)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "forbidden",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	path := project.CIConfigPath
+	if path == "" {
+		path = gitlabConstants.DEFAULT_CI_CONFIG_PATH
+	}
+
+	return []*types.Workflow{
+		{
+			ID:           project.ID,
+			Name:         path,
+			Path:         path,
+			State:        "active",
+			URL:          project.WebURL + "/-/pipelines",
+			WithDispatch: req.WithDispatch,
+		},
+	}, nil
+}
+
+// ListWorkflowJobs lists the jobs of a pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest pipeline)
+//
+// Example:
+// jobs, err := a.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{ RunID: 42})
+func (a *GitlabAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
+	pipelineID := req.RunID
+	if pipelineID == 0 {
+		pipelines, err := a.Client.ListPipelines(a.project, req.Branch, "", 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pipelines) == 0 {
+			return nil, &types.PlatformError{
+				Code:     "not_found",
+				Message:  "No pipelines found",
+				Platform: constants.GITLAB_PLATFORM,
+			}
+		}
+		pipelineID = pipelines[0].ID
+	}
+
+	jobs, err := a.Client.ListPipelineJobs(a.project, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*types.WorkflowJob, 0, len(jobs))
+	for _, job := range jobs {
+		jobStatus, conclusion := helpers.MapPipelineStatus(job.Status)
+		if req.Status != "" && req.Status != jobStatus {
+			continue
+		}
+
+		var runURL string
+		if job.Pipeline != nil {
+			runURL = job.Pipeline.WebURL
+		}
+
+		result = append(result, &types.WorkflowJob{
+			ID:           job.ID,
+			RunID:        pipelineID,
+			WorkflowName: job.Stage,
+			Name:         job.Name,
+			Status:       jobStatus,
+			Conclusion:   conclusion,
+			RunURL:       runURL,
+			URL:          job.WebURL,
+			HTMLURL:      job.WebURL,
+			StartedAt:    gitlab.TimeOrZero(job.StartedAt),
+			CompletedAt:  gitlab.TimeOrZero(job.FinishedAt),
+		})
+	}
+
+	return result, nil
+}
+
+// ListWorkflowRuns lists the most recent pipelines of the project (all that is <= limit)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// runs, err := a.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{ Branch: "main"})
+func (a *GitlabAdapter) ListWorkflowRuns(ctx context.Context, req *types.ListWorkflowRunsRequest) ([]*types.Run, error) {
+	pipelines, err := a.Client.ListPipelines(a.project, req.Branch, helpers.ToGitlabStatus(req.Status), req.Limit)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	runs := make([]*types.Run, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		if req.Limit > 0 && len(runs) >= req.Limit {
+			break
+		}
+
+		pipelineStatus, conclusion := helpers.MapPipelineStatus(pipeline.Status)
+		// "completed" has no gitlab equivalent, it's filtered here
+		if req.Status != "" && req.Status != pipelineStatus {
+			continue
+		}
+
+		var actor string
+		if pipeline.User != nil {
+			actor = pipeline.User.Username
+		}
+
+		runs = append(runs, &types.Run{
+			RunID:       pipeline.ID,
+			RunNumber:   int(pipeline.IID),
+			Status:      pipelineStatus,
+			Conclusion:  conclusion,
+			Branch:      pipeline.Ref,
+			Actor:       actor,
+			Event:       pipeline.Source,
+			CommitSHA:   pipeline.SHA,
+			TriggeredBy: actor,
+			CreatedAt:   gitlab.TimeOrZero(pipeline.CreatedAt),
+			UpdatedAt:   gitlab.TimeOrZero(pipeline.UpdatedAt),
+			URL:         pipeline.WebURL,
+		})
+	}
+
+	return runs, nil
+}
+
+// StreamLogs streams the traces of every job of a pipeline line by line
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = latest pipeline)
+//   - callback: logs callback
+//
+// Example:
+// err := a.StreamLogs(ctx, &types.LogsStreamRequest{ RunID: 1, Follow: true}, &callback)
+func (a *GitlabAdapter) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	pipeline, err := a.resolvePipeline(req.RunID)
+	if err != nil {
+		return &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	// offsets keeps track of how much of each job trace was already delivered
+	offsets := make(map[int64]int64)
+	var tail []*types.LogLine
+
+	emit := func(job *gitlab.Job, content string) error {
+		line := &types.LogLine{
+			Content:   content,
+			Timestamp: time.Now(),
+			JobName:   job.Name,
+			JobID:     job.ID,
+			Level:     internalHelpers.DetectLevel(content),
+		}
+
+		// with --tail, lines are only delivered once the whole output is known
+		if req.Tail > 0 && !req.Follow {
+			tail = append(tail, line)
+			if len(tail) > req.Tail {
+				tail = tail[1:]
+			}
+			return nil
+		}
+
+		if callback == nil || *callback == nil {
+			return nil
+		}
+
+		return (*callback)(line)
+	}
+
+	for {
+		current, err := a.Client.GetPipeline(a.project, pipeline.ID)
+		if err != nil {
+			return &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.GITLAB_PLATFORM,
+			}
+		}
+		pipelineStatus, _ := helpers.MapPipelineStatus(current.Status)
+
+		jobs, err := a.Client.ListPipelineJobs(a.project, pipeline.ID)
+		if err != nil {
+			return &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.GITLAB_PLATFORM,
+			}
+		}
+
+		for _, job := range jobs {
+			jobStatus, _ := helpers.MapPipelineStatus(job.Status)
+			if jobStatus == "queued" || jobStatus == "waiting" {
+				continue
+			}
+
+			if len(req.JobIDs) > 0 && !slices.Contains(req.JobIDs, job.ID) {
+				continue
+			}
+
+			pending, start, err := a.Client.GetJobTraceFrom(a.project, job.ID, offsets[job.ID])
+			if err != nil {
+				return &types.PlatformError{
+					Code:     "logs_failed",
+					Message:  err.Error(),
+					Platform: constants.GITLAB_PLATFORM,
+				}
+			}
+
+			// a running job may still be writing it's last line
+			if jobStatus != "completed" {
+				if idx := strings.LastIndex(pending, "\n"); idx >= 0 {
+					pending = pending[:idx+1]
+				} else {
+					pending = ""
+				}
+			}
+			offsets[job.ID] = start + int64(len(pending))
+
+			for _, content := range strings.Split(strings.TrimSuffix(pending, "\n"), "\n") {
+				if pending == "" {
+					break
+				}
+
+				if err := emit(job, helpers.CleanTraceLine(content)); err != nil {
+					return err
+				}
+			}
+		}
+
+		if !req.Follow || pipelineStatus == "completed" {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(gitlabConstants.TracePollInterval):
+		}
+	}
+
+	if callback != nil && *callback != nil {
+		for _, line := range tail {
+			if err := (*callback)(line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListWorkflowRunLogs downloads the traces of every job of a pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// logs, err := a.ListWorkflowRunLogs(ctx, &types.LogsRequest{ RunID: 1,})
+func (a *GitlabAdapter) ListWorkflowRunLogs(ctx context.Context, req *types.LogsRequest) (*types.LogsResponse, error) {
+	pipeline, err := a.resolvePipeline(req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	jobs, err := a.Client.ListPipelineJobs(a.project, pipeline.ID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	dir := req.DownloadPath
+	if dir == "" {
+		configDir, err := internalHelpers.GetConfigDir()
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.ReplaceAll(a.project, "/", "-")
+		dir = filepath.Join(configDir, "logs", fmt.Sprintf("%s-%d", name, pipeline.ID))
+	}
+
+	var total int64
+	files := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		path := filepath.Join(dir, fmt.Sprintf("%d-%s.log", job.ID, strings.ReplaceAll(job.Name, "/", "-")))
+
+		written, err := a.Client.DownloadJobTrace(a.project, job.ID, path)
+		if err != nil {
+			return nil, &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.GITLAB_PLATFORM,
+			}
+		}
+		total += written
+		files = append(files, path)
+	}
+
+	return &types.LogsResponse{
+		URL:   pipeline.WebURL,
+		Path:  dir,
+		Files: files,
+		Size:  total,
+	}, nil
+}
+
+// GetWorkflowRunSummary retrieves the summary of a pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (ID is the pipeline ID)
+//
+// Example:
+// summary, err := a.GetWorkflowRunSummary(ctx, &types.Workflow{ ID: 1,})
+func (a *GitlabAdapter) GetWorkflowRunSummary(ctx context.Context, req *types.Workflow) (*types.WorkflowRunSummary, error) {
+	pipeline, err := a.resolvePipeline(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineStatus, conclusion := helpers.MapPipelineStatus(pipeline.Status)
+
+	return &types.WorkflowRunSummary{
+		ID:         pipeline.ID,
+		Name:       fmt.Sprintf("%s #%d (%s)", a.project, pipeline.IID, pipeline.Ref),
+		Status:     pipelineStatus,
+		Conclusion: conclusion,
+		CreatedAt:  gitlab.TimeOrZero(pipeline.CreatedAt).String(),
+		UpdatedAt:  gitlab.TimeOrZero(pipeline.UpdatedAt).String(),
+		HTMLURL:    pipeline.WebURL,
+	}, nil
+}
+
+// ListArtifacts isn't supported (job artifacts aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// artifacts, err := a.ListArtifacts(ctx, &types.ListArtifactsRequest{ RunID: 1,})
+func (a *GitlabAdapter) ListArtifacts(ctx context.Context, req *types.ListArtifactsRequest) ([]*types.Artifact, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Job artifacts aren't supported by uniflow yet",
+		Platform: constants.GITLAB_PLATFORM,
+	}
+}
+
+// DownloadArtifact isn't supported (job artifacts aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.DownloadArtifact(ctx, &types.DownloadArtifactRequest{ RunID: 1, Artifact: artifact,})
+func (a *GitlabAdapter) DownloadArtifact(ctx context.Context, req *types.DownloadArtifactRequest) (*types.DownloadArtifactResponse, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Job artifacts aren't supported by uniflow yet",
+		Platform: constants.GITLAB_PLATFORM,
+	}
+}
+
+// ListPendingApprovals isn't supported (manual jobs aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// approvals, err := a.ListPendingApprovals(ctx, &types.ListApprovalsRequest{})
+func (a *GitlabAdapter) ListPendingApprovals(ctx context.Context, req *types.ListApprovalsRequest) ([]*types.PendingApproval, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Manual jobs aren't supported by uniflow yet",
+		Platform: constants.GITLAB_PLATFORM,
+	}
+}
+
+// ReviewApproval isn't supported (manual jobs aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.ReviewApproval(ctx, &types.ReviewApprovalRequest{ RunID: 1, Approve: true,})
+func (a *GitlabAdapter) ReviewApproval(ctx context.Context, req *types.ReviewApprovalRequest) error {
+	return &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Manual jobs aren't supported by uniflow yet",
+		Platform: constants.GITLAB_PLATFORM,
+	}
+}
+
+// Cancel cancels a running pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.Cancel(ctx, &types.Run{ RunID: 1,})
+func (a *GitlabAdapter) Cancel(ctx context.Context, req *types.Run) error {
+	return a.Client.CancelPipeline(a.project, req.RunID)
+}
+
+// Rerun retries the failed jobs of a pipeline (in place), or creates a new pipeline on the same ref with the same variables
+// NOTE: debug logging sets CI_DEBUG_TRACE, which needs a new pipeline
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.Rerun(ctx, &types.RerunRequest{ RunID: 1, FailedOnly: true})
+func (a *GitlabAdapter) Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error) {
+	if req.FailedOnly {
+		if req.Debug {
+			return nil, &types.PlatformError{
+				Code:     "not_supported",
+				Message:  "Debug logging can't be enabled when retrying the failed jobs of a gitlab pipeline",
+				Platform: constants.GITLAB_PLATFORM,
+			}
+		}
+
+		pipeline, err := a.Client.RetryPipeline(a.project, req.RunID)
+		if err != nil {
+			return nil, &types.PlatformError{
+				Code:     "rerun_failed",
+				Message:  err.Error(),
+				Platform: constants.GITLAB_PLATFORM,
+			}
+		}
+
+		return &types.RerunResponse{RunID: pipeline.ID, RunNumber: int(pipeline.IID), URL: pipeline.WebURL}, nil
+	}
+
+	pipeline, err := a.Client.GetPipeline(a.project, req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	pipelineVariables, err := a.Client.ListPipelineVariables(a.project, req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "rerun_failed",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	variables := make(map[string]string, len(pipelineVariables)+1)
+	for _, variable := range pipelineVariables {
+		variables[variable.Key] = variable.Value
+	}
+
+	if req.Debug {
+		variables[gitlabConstants.DEBUG_TRACE_VARIABLE] = "true"
+	}
+
+	rerun, err := a.Client.CreatePipeline(a.project, pipeline.Ref, variables)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "rerun_failed",
+			Message:  err.Error(),
+			Platform: constants.GITLAB_PLATFORM,
+		}
+	}
+
+	return &types.RerunResponse{RunID: rerun.ID, RunNumber: int(rerun.IID), URL: rerun.WebURL}, nil
+}
+
+// IsProtectedBranch checks whether a branch of the project is protected
+//
+// Parameters:
+//   - ctx: the context variable
+//   - branch: branch name
+//
+// Example:
+// protected, err := a.IsProtectedBranch(ctx, "main")
+func (a *GitlabAdapter) IsProtectedBranch(ctx context.Context, branch string) (bool, error) {
+	return a.Client.IsProtectedBranch(a.project, branch)
+}
+
+// GetRepository returns the namespace and the name of the configured project
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// namespace, name := a.GetRepository(ctx)
+func (a *GitlabAdapter) GetRepository(ctx context.Context) (string, string) {
+	project := strings.Trim(a.project, "/")
+
+	idx := strings.LastIndex(project, "/")
+	if idx < 0 {
+		return "", project
+	}
+
+	return project[:idx], project[idx+1:]
+}
+
+// GetRepositoryInfo returns the configured project information
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// info, err := a.GetRepositoryInfo(ctx)
+func (a *GitlabAdapter) GetRepositoryInfo(ctx context.Context) (*types.RepositoryInfo, error) {
+	project, err := a.Client.GetProject(a.project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RepositoryInfo{
+		Name:          project.Name,
+		FullName:      project.PathWithNamespace,
+		Description:   project.Description,
+		DefaultBranch: project.DefaultBranch,
+		Private:       project.Visibility != "public",
+		HTMLURL:       project.WebURL,
+	}, nil
+}
+
+// GetUnderlyingClient returns the gitlab client from the GitlabAdapter struct but as an interface
+//
+// Parameters:
+//   - None
+//
+// Example:
+// client := a.GetUnderlyingClient()
+func (a *GitlabAdapter) GetUnderlyingClient() interface{} {
+	return a.Client
+}
+
+// IsGithub verifies that the current client is a github client
+//
+// Parameters:
+//   - None
+//
+// Example:
+// valid:= a.IsGithub()
+func (a *GitlabAdapter) IsGithub() bool {
+	return false
+}
+
+// resolvePipeline retrieves the requested pipeline, or the latest one
+//
+// Parameters:
+//   - pipelineID: pipeline ID (0 = latest pipeline)
+//
+// Example:
+// pipeline, err := a.resolvePipeline(0)
+func (a *GitlabAdapter) resolvePipeline(pipelineID int64) (*gitlab.Pipeline, error) {
+	if pipelineID != 0 {
+		return a.Client.GetPipeline(a.project, pipelineID)
+	}
+
+	pipelines, err := a.Client.ListPipelines(a.project, "", "", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pipelines) == 0 {
+		return nil, fmt.Errorf("<?> Error: No pipelines found for project %s", a.project)
+	}
+
+	return pipelines[0], nil
+}
@@ -0,0 +1,766 @@
+package platforms
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	internalHelpers "github.com/ignorant05/Uniflow/internal/helpers"
+	registry "github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins"
+	jenkinsConstants "github.com/ignorant05/Uniflow/platforms/configurations/jenkins/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins/helpers"
+	"github.com/ignorant05/Uniflow/platforms/constants"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// init registers jenkins in the platforms registry
+func init() {
+	registry.Register(&registry.Platform{
+		PlatformSection: config.PlatformSection{
+			Name:   constants.JENKINS_PLATFORM,
+			Title:  "Jenkins",
+			Decode: config.DecodeSection[jenkins.Config],
+			Validate: func(prefix string, section interface{}) []error {
+				return jenkins.ValidateConfig(prefix, section.(*jenkins.Config))
+			},
+			SecretFields:  []string{"api_token", "password"},
+			SecretEnvVars: []string{jenkinsConstants.JENKINS_TOKEN_ENV_VAR_NAME},
+		},
+		DetectionPaths: jenkinsConstants.DETECTION_PATHS,
+		Confidence:     80,
+		New: func(ctx context.Context, profile *config.Profile) (registry.PlatformClient, error) {
+			client, err := jenkins.NewClientFromProfile(ctx, profile)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewJenkinsAdapter(client)
+		},
+	})
+}
+
+type JenkinsAdapter struct {
+	Client  *jenkins.Client
+	jobName string
+}
+
+// NewJenkinsAdapter creates an adapter object
+//
+// Parameters:
+//   - client: jenkins client
+//
+// Example:
+// adapter, err := NewJenkinsAdapter(client)
+func NewJenkinsAdapter(client *jenkins.Client) (*JenkinsAdapter, error) {
+	return &JenkinsAdapter{
+		Client:  client,
+		jobName: client.Config.JobName,
+	}, nil
+}
+
+// TriggerWorkflow triggers a build and follows it's queue item until it gets a build number
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.TriggerWorkflow(ctx, &types.TriggerRequest{ WorkflowName: "release",})
+func (a *JenkinsAdapter) TriggerWorkflow(ctx context.Context, req *types.TriggerRequest) (*types.TriggerResponse, error) {
+	jobName, err := a.resolveJob(req.WorkflowName, req.Branch)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]string, len(req.Inputs))
+	for key, val := range req.Inputs {
+		params[key] = fmt.Sprint(val)
+	}
+
+	queuedAt := time.Now()
+
+	queueURL, err := a.Client.TriggerBuild(jobName, params)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "trigger_failed",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	// req.Timeout bounds the run (--wait), not the time spent in the queue
+	build, err := a.Client.WaitForQueueItem(ctx, queueURL, jenkinsConstants.QueueMaxWait)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "trigger_failed",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	return &types.TriggerResponse{
+		RunID:     build.Number,
+		RunNumber: int(build.Number),
+		URL:       build.URL,
+		Status:    "in_progress",
+		QueuedAt:  queuedAt,
+	}, nil
+}
+
+// GetStatus gets the status of a single build
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = last build)
+//
+// Example:
+// status, err := a.GetStatus(ctx, &types.StatusRequest{ RunID: 1,})
+func (a *JenkinsAdapter) GetStatus(ctx context.Context, req *types.StatusRequest) (*types.Status, error) {
+	jobName, err := a.resolveJob(req.Name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	build, err := a.Client.GetBuild(jobName, req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "status_failed",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	runStatus, conclusion := helpers.MapBuildStatus(build.Building, build.Result)
+	startedAt := helpers.MillisToTime(build.Timestamp)
+
+	status := &types.Status{
+		RunID:      build.Number,
+		RunNumber:  int(build.Number),
+		Status:     runStatus,
+		Conclusion: conclusion,
+		StartedAt:  startedAt,
+		QueuedAt:   startedAt,
+		URL:        build.URL,
+		Metadata: map[string]interface{}{
+			"result": build.Result,
+			"cause":  build.Cause(),
+		},
+	}
+
+	if revision := build.Revision(); revision != nil && len(revision.Branch) > 0 {
+		status.Branch = helpers.BranchName(revision.Branch[0].Name)
+	}
+
+	if !build.Building {
+		status.Duration = time.Duration(build.Duration) * time.Millisecond
+		status.CompletedAt = startedAt.Add(status.Duration)
+	}
+
+	return status, nil
+}
+
+// ListWorkflows lists all jobs (of the configured view if any)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (WithDispatch keeps only buildable jobs)
+//
+// Example:
+// workflows, err := a.ListWorkflows(ctx, &types.ListWorkflowsRequest{ WithDispatch: true,})
+func (a *JenkinsAdapter) ListWorkflows(ctx context.Context, req *types.ListWorkflowsRequest) ([]*types.Workflow, error) {
+	jobs, err := a.Client.ListJobs()
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "forbidden",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	workflows := make([]*types.Workflow, 0, len(jobs))
+	for _, job := range jobs {
+		if req.WithDispatch && !job.Buildable {
+			continue
+		}
+
+		state := "active"
+		if strings.HasPrefix(job.Color, "disabled") {
+			state = "disabled"
+		}
+
+		name := job.FullName
+		if name == "" {
+			name = job.Name
+		}
+
+		workflows = append(workflows, &types.Workflow{
+			ID:           helpers.JobID(name),
+			Name:         name,
+			Path:         name,
+			State:        state,
+			URL:          job.URL,
+			WithDispatch: req.WithDispatch,
+		})
+	}
+
+	return workflows, nil
+}
+
+// ListWorkflowJobs lists the pipeline stages of a build
+// NOTE: builds that aren't pipelines are reported as a single job
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// jobs, err := a.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{ RunID: 42})
+func (a *JenkinsAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
+	jobName, err := a.resolveJob(req.WorkflowName, req.Branch)
+	if err != nil {
+		return nil, err
+	}
+
+	build, err := a.Client.GetBuild(jobName, req.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []*types.WorkflowJob
+
+	run, err := a.Client.DescribeBuild(jobName, build.Number)
+	if err != nil || len(run.Stages) == 0 {
+		buildStatus, conclusion := helpers.MapBuildStatus(build.Building, build.Result)
+		jobs = append(jobs, &types.WorkflowJob{
+			ID:           build.Number,
+			RunID:        build.Number,
+			WorkflowName: jobName,
+			Name:         jobName,
+			Status:       buildStatus,
+			Conclusion:   conclusion,
+			RunURL:       build.URL,
+			URL:          build.URL,
+			HTMLURL:      build.URL,
+		})
+	} else {
+		for _, stage := range run.Stages {
+			stageStatus, conclusion := helpers.MapStageStatus(stage.Status)
+			id, _ := strconv.ParseInt(stage.ID, 10, 64)
+
+			jobs = append(jobs, &types.WorkflowJob{
+				ID:           id,
+				RunID:        build.Number,
+				WorkflowName: jobName,
+				Name:         stage.Name,
+				Status:       stageStatus,
+				Conclusion:   conclusion,
+				RunURL:       build.URL,
+				URL:          build.URL,
+				HTMLURL:      build.URL,
+			})
+		}
+	}
+
+	filtered := make([]*types.WorkflowJob, 0, len(jobs))
+	for _, job := range jobs {
+		if req.Status != "" && req.Status != job.Status {
+			continue
+		}
+
+		filtered = append(filtered, job)
+	}
+
+	return filtered, nil
+}
+
+// ListWorkflowRuns lists the most recent builds of a job (all that is <= limit)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// runs, err := a.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{ WorkflowName: "release"})
+func (a *JenkinsAdapter) ListWorkflowRuns(ctx context.Context, req *types.ListWorkflowRunsRequest) ([]*types.Run, error) {
+	jobName, err := a.resolveJob(req.WorkflowName, req.Branch)
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []*types.Run
+
+	// without a limit, only the most recent page of builds is listed
+	for from := 0; ; from += jenkinsConstants.DEFAULT_BUILDS_LIMIT {
+		builds, err := a.Client.ListBuildsRange(jobName, from, from+jenkinsConstants.DEFAULT_BUILDS_LIMIT)
+		if err != nil {
+			return nil, &types.PlatformError{
+				Code:     "not_found",
+				Message:  err.Error(),
+				Platform: constants.JENKINS_PLATFORM,
+			}
+		}
+
+		for _, build := range builds {
+			if req.Limit > 0 && len(runs) >= req.Limit {
+				return runs, nil
+			}
+
+			runStatus, conclusion := helpers.MapBuildStatus(build.Building, build.Result)
+			if req.Status != "" && req.Status != runStatus {
+				continue
+			}
+
+			runs = append(runs, buildRun(jobName, build, runStatus, conclusion))
+		}
+
+		if req.Limit <= 0 || len(runs) >= req.Limit || len(builds) < jenkinsConstants.DEFAULT_BUILDS_LIMIT {
+			break
+		}
+	}
+
+	if runs == nil {
+		runs = []*types.Run{}
+	}
+
+	return runs, nil
+}
+
+// StreamLogs streams the progressive console output of a build line by line
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (RunID 0 = last build)
+//   - callback: logs callback
+//
+// Example:
+// err := a.StreamLogs(ctx, &types.LogsStreamRequest{ RunID: 1, Follow: true}, &callback)
+func (a *JenkinsAdapter) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	jobName, err := a.resolveJob(req.WorkflowName, req.Branch)
+	if err != nil {
+		return err
+	}
+
+	build, err := a.Client.GetBuild(jobName, req.RunID)
+	if err != nil {
+		return &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	var (
+		start   int64
+		partial string
+		tail    []*types.LogLine
+	)
+
+	emit := func(content string) error {
+		line := &types.LogLine{
+			Content:   content,
+			Timestamp: time.Now(),
+			JobName:   jobName,
+			JobID:     build.Number,
+			Level:     internalHelpers.DetectLevel(content),
+		}
+
+		// with --tail, lines are only delivered once the whole output is known
+		if req.Tail > 0 && !req.Follow {
+			tail = append(tail, line)
+			if len(tail) > req.Tail {
+				tail = tail[1:]
+			}
+			return nil
+		}
+
+		if callback == nil || *callback == nil {
+			return nil
+		}
+
+		return (*callback)(line)
+	}
+
+	for {
+		chunk, err := a.Client.GetProgressiveConsoleText(jobName, build.Number, start)
+		if err != nil {
+			return &types.PlatformError{
+				Code:     "logs_failed",
+				Message:  err.Error(),
+				Platform: constants.JENKINS_PLATFORM,
+			}
+		}
+		start = chunk.NextStart
+
+		lines := strings.Split(partial+chunk.Text, "\n")
+		partial = lines[len(lines)-1]
+
+		for _, content := range lines[:len(lines)-1] {
+			if err := emit(strings.TrimSuffix(content, "\r")); err != nil {
+				return err
+			}
+		}
+
+		if !chunk.MoreData || !req.Follow {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(jenkinsConstants.ConsolePollInterval):
+		}
+	}
+
+	if partial != "" {
+		if err := emit(strings.TrimSuffix(partial, "\r")); err != nil {
+			return err
+		}
+	}
+
+	if callback != nil && *callback != nil {
+		for _, line := range tail {
+			if err := (*callback)(line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListWorkflowRunLogs downloads the console output of a build
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// logs, err := a.ListWorkflowRunLogs(ctx, &types.LogsRequest{ RunID: 1,})
+func (a *JenkinsAdapter) ListWorkflowRunLogs(ctx context.Context, req *types.LogsRequest) (*types.LogsResponse, error) {
+	jobName, err := a.resolveJob(req.WorkflowName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	build, err := a.Client.GetBuild(jobName, req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	path := req.DownloadPath
+	if path == "" {
+		configDir, err := internalHelpers.GetConfigDir()
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.ReplaceAll(jobName, "/", "-")
+		path = filepath.Join(configDir, "logs", fmt.Sprintf("%s-%d.log", name, build.Number))
+	}
+
+	written, err := a.Client.DownloadConsoleText(jobName, build.Number, path)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "logs_failed",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	return &types.LogsResponse{
+		URL:   build.URL + jenkinsConstants.CONSOLE_TEXT_PATH,
+		Path:  path,
+		Files: []string{path},
+		Size:  written,
+	}, nil
+}
+
+// GetWorkflowRunSummary retrieves the summary of a build
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body (ID is the build number, Name the job)
+//
+// Example:
+// summary, err := a.GetWorkflowRunSummary(ctx, &types.Workflow{ ID: 1,})
+func (a *JenkinsAdapter) GetWorkflowRunSummary(ctx context.Context, req *types.Workflow) (*types.WorkflowRunSummary, error) {
+	jobName, err := a.resolveJob(req.Name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	build, err := a.Client.GetBuild(jobName, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	runStatus, conclusion := helpers.MapBuildStatus(build.Building, build.Result)
+	startedAt := helpers.MillisToTime(build.Timestamp)
+
+	return &types.WorkflowRunSummary{
+		ID:         build.Number,
+		Name:       build.FullDisplayName,
+		Status:     runStatus,
+		Conclusion: conclusion,
+		CreatedAt:  startedAt.String(),
+		UpdatedAt:  startedAt.Add(time.Duration(build.Duration) * time.Millisecond).String(),
+		HTMLURL:    build.URL,
+	}, nil
+}
+
+// ListArtifacts isn't supported (archived artifacts aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// artifacts, err := a.ListArtifacts(ctx, &types.ListArtifactsRequest{ RunID: 1,})
+func (a *JenkinsAdapter) ListArtifacts(ctx context.Context, req *types.ListArtifactsRequest) ([]*types.Artifact, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Archived artifacts aren't supported by uniflow yet",
+		Platform: constants.JENKINS_PLATFORM,
+	}
+}
+
+// DownloadArtifact isn't supported (archived artifacts aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.DownloadArtifact(ctx, &types.DownloadArtifactRequest{ RunID: 1, Artifact: artifact,})
+func (a *JenkinsAdapter) DownloadArtifact(ctx context.Context, req *types.DownloadArtifactRequest) (*types.DownloadArtifactResponse, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Archived artifacts aren't supported by uniflow yet",
+		Platform: constants.JENKINS_PLATFORM,
+	}
+}
+
+// ListPendingApprovals isn't supported (input steps aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// approvals, err := a.ListPendingApprovals(ctx, &types.ListApprovalsRequest{})
+func (a *JenkinsAdapter) ListPendingApprovals(ctx context.Context, req *types.ListApprovalsRequest) ([]*types.PendingApproval, error) {
+	return nil, &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Input steps aren't supported by uniflow yet",
+		Platform: constants.JENKINS_PLATFORM,
+	}
+}
+
+// ReviewApproval isn't supported (input steps aren't supported yet)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.ReviewApproval(ctx, &types.ReviewApprovalRequest{ RunID: 1, Approve: true,})
+func (a *JenkinsAdapter) ReviewApproval(ctx context.Context, req *types.ReviewApprovalRequest) error {
+	return &types.PlatformError{
+		Code:     "not_supported",
+		Message:  "Input steps aren't supported by uniflow yet",
+		Platform: constants.JENKINS_PLATFORM,
+	}
+}
+
+// Cancel aborts a running build
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// err := a.Cancel(ctx, &types.Run{ RunID: 1, WorkflowName: "release",})
+func (a *JenkinsAdapter) Cancel(ctx context.Context, req *types.Run) error {
+	jobName, err := a.resolveJob(req.WorkflowName, req.Branch)
+	if err != nil {
+		return err
+	}
+
+	return a.Client.StopBuild(jobName, req.RunID)
+}
+
+// Rerun starts a new build of the job with the parameters of a previous one
+// NOTE: jenkins reruns are new builds, the response holds the new build number
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.Rerun(ctx, &types.RerunRequest{ RunID: 1, WorkflowName: "release",})
+func (a *JenkinsAdapter) Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error) {
+	if req.FailedOnly || req.Debug {
+		return nil, &types.PlatformError{
+			Code:     "not_supported",
+			Message:  "Rerunning failed stages or with debug logging isn't supported by jenkins (use the replay of the pipeline instead)",
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	jobName, err := a.resolveJob(req.WorkflowName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := a.Client.GetBuild(jobName, req.RunID)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "not_found",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	queueURL, err := a.Client.TriggerBuild(jobName, previous.ParameterValues())
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "rerun_failed",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	// like triggers, the time spent in the queue is bounded on it's own
+	build, err := a.Client.WaitForQueueItem(ctx, queueURL, jenkinsConstants.QueueMaxWait)
+	if err != nil {
+		return nil, &types.PlatformError{
+			Code:     "rerun_failed",
+			Message:  err.Error(),
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	return &types.RerunResponse{RunID: build.Number, RunNumber: int(build.Number), URL: build.URL}, nil
+}
+
+// GetRepository returns the jenkins host and the configured job
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// host, job := a.GetRepository(ctx)
+func (a *JenkinsAdapter) GetRepository(ctx context.Context) (string, string) {
+	return a.Client.BaseURL.Host, a.jobName
+}
+
+// GetRepositoryInfo returns the configured job information
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// info, err := a.GetRepositoryInfo(ctx)
+func (a *JenkinsAdapter) GetRepositoryInfo(ctx context.Context) (*types.RepositoryInfo, error) {
+	jobName, err := a.resolveJob("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := a.Client.GetJob(jobName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RepositoryInfo{
+		Name:        job.Name,
+		FullName:    job.FullName,
+		Description: job.Description,
+		HTMLURL:     job.URL,
+	}, nil
+}
+
+// GetUnderlyingClient returns the jenkins client from the JenkinsAdapter struct but as an interface
+//
+// Parameters:
+//   - None
+//
+// Example:
+// client := a.GetUnderlyingClient()
+func (a *JenkinsAdapter) GetUnderlyingClient() interface{} {
+	return a.Client
+}
+
+// IsGithub verifies that the current client is a github client
+//
+// Parameters:
+//   - None
+//
+// Example:
+// valid:= a.IsGithub()
+func (a *JenkinsAdapter) IsGithub() bool {
+	return false
+}
+
+// resolveJob picks the requested job (or the configured one) and resolves multibranch branches
+//
+// Parameters:
+//   - name: requested job name (optional)
+//   - branch: git branch (optional)
+//
+// Example:
+// jobName, err := a.resolveJob("release", "main")
+func (a *JenkinsAdapter) resolveJob(name, branch string) (string, error) {
+	if name == "" {
+		name = a.jobName
+	}
+
+	if name == "" {
+		return "", &types.PlatformError{
+			Code:     "not_configured",
+			Message:  "No job provided and no job_name configured for this profile",
+			Platform: constants.JENKINS_PLATFORM,
+		}
+	}
+
+	return a.Client.ResolveJob(name, branch)
+}
+
+// buildRun converts a build of a job into a run
+func buildRun(jobName string, build *jenkins.Build, runStatus, conclusion string) *types.Run {
+	var commitSHA, runBranch string
+	if revision := build.Revision(); revision != nil {
+		commitSHA = revision.SHA1
+		if len(revision.Branch) > 0 {
+			runBranch = helpers.BranchName(revision.Branch[0].Name)
+		}
+	}
+
+	startedAt := helpers.MillisToTime(build.Timestamp)
+
+	return &types.Run{
+		RunID:       build.Number,
+		RunNumber:   int(build.Number),
+		Status:      runStatus,
+		Conclusion:  conclusion,
+		Branch:      runBranch,
+		Actor:       build.Author(),
+		Event:       build.Cause(),
+		CommitSHA:   commitSHA,
+		TriggeredBy: build.Author(),
+		CreatedAt:   startedAt,
+		UpdatedAt:   startedAt.Add(time.Duration(build.Duration) * time.Millisecond),
+		URL:         build.URL,
+
+		WorkflowName: jobName,
+	}
+}
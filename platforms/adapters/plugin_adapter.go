@@ -0,0 +1,423 @@
+package platforms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	registry "github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/platforms/configurations/plugin"
+	pluginConstants "github.com/ignorant05/Uniflow/platforms/configurations/plugin/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/plugin/helpers"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// init registers the plugins resolver (uniflow-platform-<name> executables on PATH)
+// NOTE: compiled in platforms always win over plugins of the same name
+func init() {
+	registry.RegisterResolver(pluginResolver{})
+}
+
+// pluginResolver resolves platforms to plugin executables
+type pluginResolver struct{}
+
+// Resolve resolves a platform name to a plugin if its executable is on PATH
+func (pluginResolver) Resolve(name string) (*registry.Platform, bool) {
+	if _, err := helpers.LookupExecutable(name); err != nil {
+		return nil, false
+	}
+
+	return &registry.Platform{
+		PlatformSection: config.PlatformSection{
+			Name:  name,
+			Title: name,
+			// plugins validate their own section, an absent one is handed over empty
+			Decode: func(raw interface{}) (interface{}, error) {
+				if section, ok := raw.(config.PluginConfig); ok && section != nil {
+					return section, nil
+				}
+
+				return config.PluginConfig{}, nil
+			},
+		},
+		New: func(ctx context.Context, profile *config.Profile) (registry.PlatformClient, error) {
+			client, err := plugin.NewClientFromProfile(ctx, name, profile)
+			if err != nil {
+				return nil, err
+			}
+
+			return NewPluginAdapter(client)
+		},
+	}, true
+}
+
+// Names lists the plugins found on PATH
+func (pluginResolver) Names() []string {
+	return helpers.DiscoverPlugins()
+}
+
+type PluginAdapter struct {
+	Client *plugin.Client
+}
+
+// NewPluginAdapter starts the plugin and creates an adapter object
+//
+// Parameters:
+//   - client: plugin client
+//
+// Example:
+// adapter, err := NewPluginAdapter(client)
+func NewPluginAdapter(client *plugin.Client) (*PluginAdapter, error) {
+	if err := client.Start(); err != nil {
+		return nil, &types.PlatformError{
+			Code:     "plugin_failed",
+			Message:  err.Error(),
+			Platform: client.Name,
+		}
+	}
+
+	return &PluginAdapter{Client: client}, nil
+}
+
+// TriggerWorkflow forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.TriggerWorkflow(ctx, &types.TriggerRequest{ WorkflowName: "deploy",})
+func (a *PluginAdapter) TriggerWorkflow(ctx context.Context, req *types.TriggerRequest) (*types.TriggerResponse, error) {
+	var resp types.TriggerResponse
+	if err := a.call(ctx, "TriggerWorkflow", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetStatus forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// status, err := a.GetStatus(ctx, &types.StatusRequest{ RunID: 42,})
+func (a *PluginAdapter) GetStatus(ctx context.Context, req *types.StatusRequest) (*types.Status, error) {
+	var status types.Status
+	if err := a.call(ctx, "GetStatus", req, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// ListWorkflows forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// workflows, err := a.ListWorkflows(ctx, &types.ListWorkflowsRequest{})
+func (a *PluginAdapter) ListWorkflows(ctx context.Context, req *types.ListWorkflowsRequest) ([]*types.Workflow, error) {
+	var workflows []*types.Workflow
+	if err := a.call(ctx, "ListWorkflows", req, &workflows); err != nil {
+		return nil, err
+	}
+
+	return workflows, nil
+}
+
+// ListWorkflowJobs forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// jobs, err := a.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{ RunID: 42,})
+func (a *PluginAdapter) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
+	var jobs []*types.WorkflowJob
+	if err := a.call(ctx, "ListWorkflowJobs", req, &jobs); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// ListWorkflowRuns forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// runs, err := a.ListWorkflowRuns(ctx, &types.ListWorkflowRunsRequest{ Limit: 10,})
+func (a *PluginAdapter) ListWorkflowRuns(ctx context.Context, req *types.ListWorkflowRunsRequest) ([]*types.Run, error) {
+	var runs []*types.Run
+	if err := a.call(ctx, "ListWorkflowRuns", req, &runs); err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// StreamLogs forwards the request to the plugin, which sends a LogLine notification per line before responding
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//   - callback: called for each log line
+//
+// Example:
+// err := a.StreamLogs(ctx, &types.LogsStreamRequest{ RunID: 42, Follow: true,}, &callback)
+func (a *PluginAdapter) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	notify := func(method string, params json.RawMessage) error {
+		if method != pluginConstants.METHOD_LOG_LINE || callback == nil {
+			return nil
+		}
+
+		var line types.LogLine
+		if err := json.Unmarshal(params, &line); err != nil {
+			return fmt.Errorf("<?> Error: Invalid log line from plugin.\n<?> Error: %w", err)
+		}
+
+		return (*callback)(&line)
+	}
+
+	if err := a.Client.Call(ctx, "StreamLogs", req, nil, notify); err != nil {
+		return a.platformError(err)
+	}
+
+	return nil
+}
+
+// ListWorkflowRunLogs forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// logs, err := a.ListWorkflowRunLogs(ctx, &types.LogsRequest{ RunID: 42,})
+func (a *PluginAdapter) ListWorkflowRunLogs(ctx context.Context, req *types.LogsRequest) (*types.LogsResponse, error) {
+	var logs types.LogsResponse
+	if err := a.call(ctx, "ListWorkflowRunLogs", req, &logs); err != nil {
+		return nil, err
+	}
+
+	return &logs, nil
+}
+
+// GetWorkflowRunSummary forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// summary, err := a.GetWorkflowRunSummary(ctx, &types.Workflow{ Name: "deploy",})
+func (a *PluginAdapter) GetWorkflowRunSummary(ctx context.Context, req *types.Workflow) (*types.WorkflowRunSummary, error) {
+	var summary types.WorkflowRunSummary
+	if err := a.call(ctx, "GetWorkflowRunSummary", req, &summary); err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// ListArtifacts forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// artifacts, err := a.ListArtifacts(ctx, &types.ListArtifactsRequest{ RunID: 42,})
+func (a *PluginAdapter) ListArtifacts(ctx context.Context, req *types.ListArtifactsRequest) ([]*types.Artifact, error) {
+	var artifacts []*types.Artifact
+	if err := a.call(ctx, "ListArtifacts", req, &artifacts); err != nil {
+		return nil, err
+	}
+
+	return artifacts, nil
+}
+
+// DownloadArtifact forwards the request to the plugin (which writes the artifact into req.Dest)
+// NOTE: the progress callback isn't forwarded
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// resp, err := a.DownloadArtifact(ctx, &types.DownloadArtifactRequest{ RunID: 42, Artifact: artifact,})
+func (a *PluginAdapter) DownloadArtifact(ctx context.Context, req *types.DownloadArtifactRequest) (*types.DownloadArtifactResponse, error) {
+	var resp types.DownloadArtifactResponse
+	if err := a.call(ctx, "DownloadArtifact", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ListPendingApprovals forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// approvals, err := a.ListPendingApprovals(ctx, &types.ListApprovalsRequest{})
+func (a *PluginAdapter) ListPendingApprovals(ctx context.Context, req *types.ListApprovalsRequest) ([]*types.PendingApproval, error) {
+	var approvals []*types.PendingApproval
+	if err := a.call(ctx, "ListPendingApprovals", req, &approvals); err != nil {
+		return nil, err
+	}
+
+	return approvals, nil
+}
+
+// ReviewApproval forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the review
+//
+// Example:
+// err := a.ReviewApproval(ctx, &types.ReviewApprovalRequest{ RunID: 42, Approve: true,})
+func (a *PluginAdapter) ReviewApproval(ctx context.Context, req *types.ReviewApprovalRequest) error {
+	return a.call(ctx, "ReviewApproval", req, nil)
+}
+
+// Cancel forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the run to cancel
+//
+// Example:
+// err := a.Cancel(ctx, &types.Run{ RunID: 42,})
+func (a *PluginAdapter) Cancel(ctx context.Context, req *types.Run) error {
+	return a.call(ctx, "Cancel", req, nil)
+}
+
+// Rerun forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the run to rerun
+//
+// Example:
+// resp, err := a.Rerun(ctx, &types.RerunRequest{ RunID: 42,})
+func (a *PluginAdapter) Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error) {
+	var resp types.RerunResponse
+	if err := a.call(ctx, "Rerun", req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetRepository returns current repository elements (owner/repo), empty if the plugin has none
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// owner, repo := a.GetRepository(ctx)
+func (a *PluginAdapter) GetRepository(ctx context.Context) (string, string) {
+	var repository plugin.RepositoryResult
+	if err := a.call(ctx, "GetRepository", nil, &repository); err != nil {
+		return "", ""
+	}
+
+	return repository.Owner, repository.Repo
+}
+
+// GetRepositoryInfo forwards the request to the plugin
+//
+// Parameters:
+//   - ctx: the context variable
+//
+// Example:
+// info, err := a.GetRepositoryInfo(ctx)
+func (a *PluginAdapter) GetRepositoryInfo(ctx context.Context) (*types.RepositoryInfo, error) {
+	var info types.RepositoryInfo
+	if err := a.call(ctx, "GetRepositoryInfo", nil, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// GetUnderlyingClient returns the plugin client
+//
+// Parameters:
+//   - None
+//
+// Example:
+// client := a.GetUnderlyingClient()
+func (a *PluginAdapter) GetUnderlyingClient() interface{} {
+	return a.Client
+}
+
+// IsGithub checks if the current adapter is a github adapter
+//
+// Parameters:
+//   - None
+//
+// Example:
+// isGithub := a.IsGithub()
+func (a *PluginAdapter) IsGithub() bool {
+	return false
+}
+
+// call sends a request to the plugin and converts its errors
+func (a *PluginAdapter) call(ctx context.Context, method string, params, result interface{}) error {
+	if err := a.Client.Call(ctx, method, params, result, nil); err != nil {
+		return a.platformError(err)
+	}
+
+	return nil
+}
+
+// platformError converts plugin errors into platform errors
+// NOTE: plugins report platform errors in the json-rpc error data, unknown methods are unsupported operations
+func (a *PluginAdapter) platformError(err error) error {
+	var rpcErr *plugin.RPCError
+	if !errors.As(err, &rpcErr) {
+		return &types.PlatformError{
+			Code:     "plugin_failed",
+			Message:  err.Error(),
+			Platform: a.Client.Name,
+		}
+	}
+
+	platformErr := &types.PlatformError{
+		Code:     "plugin_error",
+		Message:  rpcErr.Message,
+		Platform: a.Client.Name,
+	}
+
+	if rpcErr.Code == pluginConstants.ERROR_METHOD_NOT_FOUND {
+		platformErr.Code = "not_supported"
+	}
+
+	if len(rpcErr.Data) > 0 {
+		var data types.PlatformError
+		if json.Unmarshal(rpcErr.Data, &data) == nil {
+			if data.Code != "" {
+				platformErr.Code = data.Code
+			}
+			platformErr.StatusCode = data.StatusCode
+			platformErr.Details = data.Details
+		}
+	}
+
+	return platformErr
+}
@@ -0,0 +1,92 @@
+package platforms
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// ArtifactResult is the outcome of an artifact download
+type ArtifactResult struct {
+	Artifact *types.Artifact
+
+	// Response is nil if the download failed
+	Response *types.DownloadArtifactResponse
+
+	Err error
+}
+
+// DownloadArtifacts downloads several artifacts at once (at most parallel at a time)
+// NOTE: a download failing doesn't stop the others, progress and done are never called concurrently
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - req: download request (Artifact and Progress are set per artifact)
+//   - artifacts: artifacts to download
+//   - parallel: maximum number of concurrent downloads
+//   - progress: receives the downloaded bytes of each artifact (optional)
+//   - done: receives the result of each download (optional)
+//
+// Example:
+// results := platforms.DownloadArtifacts(ctx, client, types.DownloadArtifactRequest{RunID: 42, Dest: "."}, artifacts, 4, nil, nil)
+func DownloadArtifacts(ctx context.Context, client PlatformClient, req types.DownloadArtifactRequest, artifacts []*types.Artifact, parallel int, progress func(artifact *types.Artifact, downloaded int64), done func(result *ArtifactResult)) []*ArtifactResult {
+	results := make([]*ArtifactResult, len(artifacts))
+
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	// progress and done are serialized
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	slots := make(chan struct{}, parallel)
+
+	for idx, artifact := range artifacts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result := &ArtifactResult{Artifact: artifact}
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+
+				artifactReq := req
+				artifactReq.Artifact = artifact
+				artifactReq.Progress = nil
+
+				if progress != nil {
+					artifactReq.Progress = func(downloaded int64) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						progress(artifact, downloaded)
+					}
+				}
+
+				// a slot may free up once the context is cancelled
+				if result.Err = ctx.Err(); result.Err == nil {
+					result.Response, result.Err = client.DownloadArtifact(ctx, &artifactReq)
+				}
+			case <-ctx.Done():
+				result.Err = ctx.Err()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			results[idx] = result
+			if done != nil {
+				done(result)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
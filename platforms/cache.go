@@ -0,0 +1,309 @@
+package platforms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/ignorant05/Uniflow/internal/cache"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// CachedClient serves the jobs and the logs of completed runs from an on-disk cache
+// NOTE: logs of a completed run attempt never change, entries are keyed by attempt (re-runs may keep the run ID, eg: github).
+// When the platform can't be reached, the last attempt cached is served
+type CachedClient struct {
+	PlatformClient
+
+	Store    *cache.Cache
+	platform string
+}
+
+// NewCachedClient wraps a client with a logs cache
+//
+// Parameters:
+//   - client: platform client
+//   - store: logs cache
+//   - platform: platform name (part of the cache keys)
+//
+// Example:
+// client = platforms.NewCachedClient(client, store, "github")
+func NewCachedClient(client PlatformClient, store *cache.Cache, platform string) *CachedClient {
+	return &CachedClient{PlatformClient: client, Store: store, platform: platform}
+}
+
+// cachedRun is a run attempt (the workflow is part of it, run IDs are only unique per job on some platforms, eg: jenkins)
+type cachedRun struct {
+	workflow string
+	runID    int64
+	attempt  string
+}
+
+// key builds the cache key of a run attempt entry (eg: "jobs", or "job" and the job ID)
+func (c *CachedClient) key(ctx context.Context, run *cachedRun, parts ...string) string {
+	owner, repo := c.PlatformClient.GetRepository(ctx)
+	return cache.Key(append([]string{c.platform, owner + "/" + repo, run.workflow, strconv.FormatInt(run.runID, 10), run.attempt}, parts...)...)
+}
+
+// attemptKey is the key of the last attempt cached of a run
+func (c *CachedClient) attemptKey(ctx context.Context, run *cachedRun) string {
+	return c.key(ctx, &cachedRun{workflow: run.workflow, runID: run.runID}, "attempt")
+}
+
+// completedRun finds the attempt of a run, if it's completed (only completed attempts are cached)
+// NOTE: when the status can't be retrieved, the last attempt cached is used
+func (c *CachedClient) completedRun(ctx context.Context, workflow string, runID int64) (*cachedRun, bool) {
+	run := &cachedRun{workflow: workflow, runID: runID}
+
+	status, err := c.PlatformClient.GetStatus(ctx, &types.StatusRequest{Name: workflow, RunID: runID})
+	if err != nil {
+		attempt, ok := c.Store.Get(c.attemptKey(ctx, run))
+		if !ok {
+			return nil, false
+		}
+
+		run.attempt = string(attempt)
+		return run, true
+	}
+
+	if !types.IsCompleted(status.Status) {
+		return nil, false
+	}
+
+	if attempt, ok := status.Metadata["attempt"]; ok {
+		run.attempt = fmt.Sprint(attempt)
+	}
+
+	return run, true
+}
+
+// ListWorkflowJobs lists the jobs of a run (from the cache once the run is completed)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// jobs, err := c.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{RunID: 42})
+func (c *CachedClient) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
+	if req.RunID == 0 {
+		return c.PlatformClient.ListWorkflowJobs(ctx, req)
+	}
+
+	run, completed := c.completedRun(ctx, req.WorkflowName, req.RunID)
+	if !completed {
+		return c.PlatformClient.ListWorkflowJobs(ctx, req)
+	}
+
+	if jobs, ok := c.cachedJobs(ctx, run); ok {
+		return jobs, nil
+	}
+
+	jobs, err := c.PlatformClient.ListWorkflowJobs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.storeJobs(ctx, run, jobs)
+
+	return jobs, nil
+}
+
+// StreamLogs delivers the logs of a run (from the cache once the run is completed)
+// NOTE: followed and running runs are streamed from the platform
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//   - callback: logs callback
+//
+// Example:
+// err := c.StreamLogs(ctx, &types.LogsStreamRequest{RunID: 42}, &callback)
+func (c *CachedClient) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	if req.RunID == 0 || req.Follow {
+		return c.PlatformClient.StreamLogs(ctx, req, callback)
+	}
+
+	run, completed := c.completedRun(ctx, req.WorkflowName, req.RunID)
+	if !completed {
+		return c.PlatformClient.StreamLogs(ctx, req, callback)
+	}
+
+	jobs, ok := c.cachedJobs(ctx, run)
+	if !ok {
+		var err error
+
+		jobs, err = c.PlatformClient.ListWorkflowJobs(ctx, &types.ListWokflowJobsRequest{RunID: req.RunID, WorkflowName: req.WorkflowName, Branch: req.Branch})
+		if err != nil || len(jobs) == 0 {
+			return c.PlatformClient.StreamLogs(ctx, req, callback)
+		}
+
+		c.storeJobs(ctx, run, jobs)
+	}
+
+	// lines of the selected jobs, cached or not
+	lines := make(map[int64][]*types.LogLine)
+	var missing []*types.WorkflowJob
+
+	for _, job := range jobs {
+		if len(req.JobIDs) > 0 && !slices.Contains(req.JobIDs, job.ID) {
+			continue
+		}
+
+		if cached, ok := c.cachedLines(ctx, run, job.ID); ok {
+			lines[job.ID] = cached
+			continue
+		}
+
+		missing = append(missing, job)
+	}
+
+	if len(missing) > 0 {
+		streamed, all, err := c.streamJobs(ctx, req, jobs, missing)
+		if err != nil {
+			return err
+		}
+
+		// an interrupted stream is incomplete
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// lines that can't be told apart by job are delivered as is, without caching
+		if streamed == nil {
+			return deliverLines(ctx, all, req.Tail, callback)
+		}
+
+		for _, job := range missing {
+			lines[job.ID] = streamed[job.ID]
+			c.store(c.key(ctx, run, "job", strconv.FormatInt(job.ID, 10)), streamed[job.ID])
+		}
+	}
+
+	var delivered []*types.LogLine
+	for _, job := range jobs {
+		delivered = append(delivered, lines[job.ID]...)
+	}
+
+	return deliverLines(ctx, delivered, req.Tail, callback)
+}
+
+// deliverLines calls the callback on the last tail lines (all lines if tail is 0)
+func deliverLines(ctx context.Context, lines []*types.LogLine, tail int, callback *types.LogCallback) error {
+	if tail > 0 && len(lines) > tail {
+		lines = lines[len(lines)-tail:]
+	}
+
+	if callback == nil || *callback == nil {
+		return nil
+	}
+
+	for _, line := range lines {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err := (*callback)(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cachedJobs reads the cached jobs of a run attempt
+func (c *CachedClient) cachedJobs(ctx context.Context, run *cachedRun) ([]*types.WorkflowJob, bool) {
+	data, ok := c.Store.Get(c.key(ctx, run, "jobs"))
+	if !ok {
+		return nil, false
+	}
+
+	var jobs []*types.WorkflowJob
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return nil, false
+	}
+
+	return jobs, true
+}
+
+// storeJobs caches the jobs of a run attempt, which becomes the last attempt cached
+func (c *CachedClient) storeJobs(ctx context.Context, run *cachedRun, jobs []*types.WorkflowJob) {
+	if len(jobs) == 0 {
+		return
+	}
+
+	c.store(c.key(ctx, run, "jobs"), jobs)
+	_ = c.Store.Put(c.attemptKey(ctx, run), []byte(run.attempt))
+}
+
+// cachedLines reads the cached lines of a job
+func (c *CachedClient) cachedLines(ctx context.Context, run *cachedRun, jobID int64) ([]*types.LogLine, bool) {
+	data, ok := c.Store.Get(c.key(ctx, run, "job", strconv.FormatInt(jobID, 10)))
+	if !ok {
+		return nil, false
+	}
+
+	var lines []*types.LogLine
+	if err := json.Unmarshal(data, &lines); err != nil {
+		return nil, false
+	}
+
+	return lines, true
+}
+
+// streamJobs streams the logs of the missing jobs of a run, by job ID, and all the streamed lines
+// NOTE: jobs may share a name (retries, matrix jobs), the lines by job are nil if some lines belong to no job of the run
+func (c *CachedClient) streamJobs(ctx context.Context, req *types.LogsStreamRequest, jobs, missing []*types.WorkflowJob) (map[int64][]*types.LogLine, []*types.LogLine, error) {
+	known := make(map[int64]bool)
+	for _, job := range jobs {
+		known[job.ID] = true
+	}
+
+	streamed := make(map[int64][]*types.LogLine)
+	ids := make([]int64, 0, len(missing))
+	for _, job := range missing {
+		streamed[job.ID] = []*types.LogLine{}
+		ids = append(ids, job.ID)
+	}
+
+	var (
+		all     []*types.LogLine
+		unknown bool
+	)
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		all = append(all, line)
+
+		if !known[line.JobID] {
+			unknown = true
+		}
+
+		// platforms ignoring JobIDs also stream the cached jobs
+		if _, ok := streamed[line.JobID]; ok {
+			streamed[line.JobID] = append(streamed[line.JobID], line)
+		}
+
+		return nil
+	}
+
+	if err := c.PlatformClient.StreamLogs(ctx, &types.LogsStreamRequest{RunID: req.RunID, NoColor: req.NoColor, JobIDs: ids, WorkflowName: req.WorkflowName, Branch: req.Branch}, &callback); err != nil {
+		return nil, nil, err
+	}
+
+	if unknown {
+		return nil, all, nil
+	}
+
+	return streamed, all, nil
+}
+
+// store caches a value (the cache is best effort, failures are ignored)
+func (c *CachedClient) store(key string, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+
+	_ = c.Store.Put(key, data)
+}
@@ -0,0 +1,225 @@
+package circleci
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci/constants"
+	platformConstants "github.com/ignorant05/Uniflow/platforms/constants"
+)
+
+type Client struct {
+	HTTPClient *http.Client
+	BaseURL    *url.URL
+	Ctx        context.Context
+	Config     *Config
+}
+
+// NewClient creates new client from configuration.
+//
+// Parameters:
+//   - ctx: context
+//   - cfg: user's circleci configuration
+//
+// Returns an error if:
+//   - no token configured (nor in env)
+//   - invalid base url (server installations)
+//
+// Example:
+//
+//	client, err := NewClient(context.Background(), cfg)
+func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
+	if cfg.Token == "" {
+		cfg.Token = os.Getenv(constants.CIRCLECI_TOKEN_ENV_VAR_NAME)
+		if cfg.Token == "" {
+			return nil, fmt.Errorf("<?> Error: No environment variable named %s found.\n<.> Please verify your ~/.zshrc (or ~/.bashrc) file", constants.CIRCLECI_TOKEN_ENV_VAR_NAME)
+		}
+	}
+
+	rawURL := cfg.BaseURL
+	if rawURL == "" {
+		rawURL = constants.DEFAULT_BASE_URL
+	}
+
+	// both "https://circleci.company.com" and "https://circleci.company.com/api/v2" are accepted
+	rawURL = strings.TrimSuffix(strings.TrimSuffix(rawURL, "/"), "/"+strings.TrimSuffix(constants.API_V2_PATH, "/"))
+
+	baseURL, err := url.Parse(rawURL + "/")
+	if err != nil || baseURL.Host == "" {
+		return nil, fmt.Errorf("<?> Error: Invalid circleci base URL: %s", cfg.BaseURL)
+	}
+
+	return &Client{
+		HTTPClient: &http.Client{Timeout: constants.DEFAULT_TIMEOUT},
+		BaseURL:    baseURL,
+		Ctx:        ctx,
+		Config:     cfg,
+	}, nil
+}
+
+// NewClientFromProfile creates new client from profile configuration.
+//
+// Parameters:
+//   - ctx: context
+//   - profile: user's profile configuration
+//
+// Returns an error if:
+//   - circleci isn't configured for this profile
+//   - circleci client creation failure
+//
+// Example:
+//
+//	client, err := NewClientFromProfile(context.Background(), profile)
+func NewClientFromProfile(ctx context.Context, profile *config.Profile) (*Client, error) {
+	section, err := config.DecodeSection[Config](profile.RawSection(platformConstants.CIRCLE_CI_PLATFORM))
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid circleci configuration.\n<?> Error: %w", err)
+	}
+
+	if section == nil {
+		return nil, fmt.Errorf("<?> Error: CircleCI isn't configured for this profile")
+	}
+
+	return NewClient(ctx, section.(*Config))
+}
+
+// GetDefaultProjectSlug retrieves the project slug configured for the current profile.
+//
+// Parameters:
+//   - None
+//
+// Returns an error if:
+//   - no project slug is configured
+//
+// Example:
+//
+//	slug, err := client.GetDefaultProjectSlug()
+func (c *Client) GetDefaultProjectSlug() (string, error) {
+	if c.Config.ProjectSlug == "" {
+		return "", fmt.Errorf("<?> Error: No default project slug configured")
+	}
+
+	return c.Config.ProjectSlug, nil
+}
+
+// newRequest creates an authenticated request, the body (if any) is json encoded
+// NOTE: the token is only sent to the configured host (step outputs are served from pre-signed urls)
+func (c *Client) newRequest(method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid path: %s.\n<?> Error: %w", path, err)
+	}
+
+	u := c.BaseURL.ResolveReference(ref)
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to encode request body.\n<?> Error: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(c.Ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "Uniflow-CLI")
+	req.Header.Set("Accept", "application/json")
+	if u.Host == c.BaseURL.Host {
+		req.Header.Set(constants.TOKEN_HEADER, c.Config.Token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// do sends the request and converts non 2xx responses into errors
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Request to %s failed.\n<?> Error: %w", req.URL.Path, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Printf("<!> warning: Failed to close response body: %v", err)
+			}
+		}()
+
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Method:     req.Method,
+			Path:       req.URL.Path,
+			Message:    apiErr.Message,
+		}
+	}
+
+	return resp, nil
+}
+
+// doJSON sends the request and decodes the json response into out (if not nil)
+func (c *Client) doJSON(method, path string, query url.Values, body, out interface{}) error {
+	req, err := c.newRequest(method, path, query, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("<?> Error: Failed to decode response of %s.\n<?> Error: %w", path, err)
+	}
+
+	return nil
+}
+
+// APIError represents a non successful circleci api response
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("<?> Error: %s %s returned status code: %d (%s)", e.Method, e.Path, e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("<?> Error: %s %s returned status code: %d", e.Method, e.Path, e.StatusCode)
+}
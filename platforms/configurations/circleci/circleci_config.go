@@ -0,0 +1,59 @@
+package circleci
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+)
+
+// Config is the circleci section of a profile
+type Config struct {
+	Token   string `yaml:"token" mapstructure:"token"`
+	BaseURL string `yaml:"base_url,omitempty" mapstructure:"base_url"`
+
+	// Project slug ("gh/org/repo", "bb/org/repo" or "circleci/<org-id>/<project-id>")
+	ProjectSlug string `yaml:"project_slug,omitempty" mapstructure:"project_slug"`
+}
+
+// ValidateConfig validates circleci conf
+//
+// Parameters:
+//   - prefix: prefix string
+//   - cfg: circleci configuration struct
+//
+// Error possible causes:
+//   - circleci token is not sat
+//   - invalid url (server installations)
+//   - invalid project slug
+//
+// Examples:
+// errs := ValidateConfig(prefix, cfg)
+func ValidateConfig(prefix string, cfg *Config) []error {
+	var errors []error
+
+	if cfg.Token == "" || strings.HasPrefix(cfg.Token, "${") {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".token",
+			Message: "<?> Error: Token is required (set via environment variable or directly)",
+		})
+	}
+
+	if cfg.BaseURL != "" {
+		if u, err := url.Parse(cfg.BaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+			errors = append(errors, &config.ValidationError{
+				Field:   prefix + ".base_url",
+				Message: "<?> Error: Must be a valid URL",
+			})
+		}
+	}
+
+	if len(strings.Split(strings.Trim(cfg.ProjectSlug, "/"), "/")) != 3 {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".project_slug",
+			Message: "<?> Error: Must be in format 'vcs/org/repo' (eg: 'gh/org/repo')",
+		})
+	}
+
+	return errors
+}
@@ -0,0 +1,59 @@
+package circleci
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci/helpers"
+)
+
+// GetJobDetails retrieves a job with it's steps (v1.1 api).
+//
+// Parameters:
+//   - slug: project slug
+//   - jobNumber: job number
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	details, err := client.GetJobDetails("gh/org/repo", 1234)
+func (c *Client) GetJobDetails(slug string, jobNumber int64) (*JobDetails, error) {
+	var details JobDetails
+
+	path := constants.API_V1_PATH + helpers.V1ProjectPath(slug) + strconv.FormatInt(jobNumber, 10)
+	if err := c.doJSON(http.MethodGet, path, nil, nil, &details); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get job #%d.\n<?> Error: %w", jobNumber, err)
+	}
+
+	return &details, nil
+}
+
+// GetStepOutput retrieves the output of a step action.
+//
+// Parameters:
+//   - action: step action (with an output url)
+//
+// Returns an error if:
+//   - The output url expired
+//   - The request fails
+//
+// Example:
+//
+//	messages, err := client.GetStepOutput(action)
+func (c *Client) GetStepOutput(action *Action) ([]*OutputMessage, error) {
+	if action.OutputURL == "" {
+		return nil, nil
+	}
+
+	var messages []*OutputMessage
+	if err := c.doJSON(http.MethodGet, action.OutputURL, nil, nil, &messages); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get output of step %s.\n<?> Error: %w", action.Name, err)
+	}
+
+	return messages, nil
+}
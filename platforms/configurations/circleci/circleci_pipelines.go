@@ -0,0 +1,243 @@
+package circleci
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci/helpers"
+)
+
+// GetProject retrieves a project by it's slug.
+//
+// Parameters:
+//   - slug: project slug
+//
+// Returns an error if:
+//   - The project doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	project, err := client.GetProject("gh/org/repo")
+func (c *Client) GetProject(slug string) (*Project, error) {
+	var project Project
+
+	path := constants.API_V2_PATH + strings.TrimSuffix(helpers.ProjectPath(slug), "/")
+	if err := c.doJSON(http.MethodGet, path, nil, nil, &project); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get project %s.\n<?> Error: %w", slug, err)
+	}
+
+	return &project, nil
+}
+
+// TriggerPipeline triggers a new pipeline on a branch with parameters.
+//
+// Parameters:
+//   - slug: project slug
+//   - branch: git branch (empty = project default branch)
+//   - parameters: pipeline parameters declared in the config
+//
+// Returns an error if:
+//   - The branch doesn't exist
+//   - A parameter isn't declared (or has the wrong type)
+//   - The API request fails
+//
+// Example:
+//
+//	pipeline, err := client.TriggerPipeline("gh/org/repo", "main", map[string]interface{}{
+//	  "deploy": true,
+//	})
+func (c *Client) TriggerPipeline(slug, branch string, parameters map[string]interface{}) (*Pipeline, error) {
+	body := TriggerPipelineRequest{
+		Branch:     branch,
+		Parameters: parameters,
+	}
+
+	var pipeline Pipeline
+	if err := c.doJSON(http.MethodPost, constants.API_V2_PATH+helpers.ProjectPath(slug)+"pipeline", nil, body, &pipeline); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to trigger pipeline.\n<?> Error: %w", err)
+	}
+
+	return &pipeline, nil
+}
+
+// GetPipelineByNumber retrieves a pipeline by it's number.
+//
+// Parameters:
+//   - slug: project slug
+//   - number: pipeline number
+//
+// Returns an error if:
+//   - The pipeline doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	pipeline, err := client.GetPipelineByNumber("gh/org/repo", 42)
+func (c *Client) GetPipelineByNumber(slug string, number int64) (*Pipeline, error) {
+	var pipeline Pipeline
+
+	path := constants.API_V2_PATH + helpers.ProjectPath(slug) + "pipeline/" + strconv.FormatInt(number, 10)
+	if err := c.doJSON(http.MethodGet, path, nil, nil, &pipeline); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get pipeline #%d.\n<?> Error: %w", number, err)
+	}
+
+	return &pipeline, nil
+}
+
+// ListPipelines lists the most recent pipelines of a project.
+//
+// Parameters:
+//   - slug: project slug
+//   - branch: filters by branch (optional)
+//   - limit: maximum number of pipelines (0 = first page only)
+//
+// Returns an error if:
+//   - The project doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	pipelines, err := client.ListPipelines("gh/org/repo", "main", 10)
+func (c *Client) ListPipelines(slug, branch string, limit int) ([]*Pipeline, error) {
+	var pipelines []*Pipeline
+
+	query := url.Values{}
+	if branch != "" {
+		query.Set("branch", branch)
+	}
+
+	for pages := 0; pages < constants.MAX_PAGES; pages++ {
+		var page pipelinePage
+		if err := c.doJSON(http.MethodGet, constants.API_V2_PATH+helpers.ProjectPath(slug)+"pipeline", query, nil, &page); err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to list pipelines.\n<?> Error: %w", err)
+		}
+
+		pipelines = append(pipelines, page.Items...)
+		if page.NextPageToken == "" || limit <= 0 || len(pipelines) >= limit {
+			break
+		}
+
+		query.Set("page-token", page.NextPageToken)
+	}
+
+	if limit > 0 && len(pipelines) > limit {
+		pipelines = pipelines[:limit]
+	}
+
+	return pipelines, nil
+}
+
+// ListPipelineWorkflows lists the workflows of a pipeline.
+//
+// Parameters:
+//   - pipelineID: pipeline ID (uuid)
+//
+// Returns an error if:
+//   - The pipeline doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	workflows, err := client.ListPipelineWorkflows("5034460f-c7c4-4c43-9457-de07e2029e7b")
+func (c *Client) ListPipelineWorkflows(pipelineID string) ([]*Workflow, error) {
+	var workflows []*Workflow
+
+	query := url.Values{}
+	for {
+		var page workflowPage
+		if err := c.doJSON(http.MethodGet, constants.API_V2_PATH+"pipeline/"+pipelineID+"/workflow", query, nil, &page); err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to list workflows of pipeline %s.\n<?> Error: %w", pipelineID, err)
+		}
+
+		workflows = append(workflows, page.Items...)
+		if page.NextPageToken == "" {
+			break
+		}
+
+		query.Set("page-token", page.NextPageToken)
+	}
+
+	return workflows, nil
+}
+
+// ListWorkflowJobs lists the jobs of a workflow.
+//
+// Parameters:
+//   - workflowID: workflow ID (uuid)
+//
+// Returns an error if:
+//   - The workflow doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	jobs, err := client.ListWorkflowJobs("fda08377-fe7e-46b1-8992-3a7aaecac9c3")
+func (c *Client) ListWorkflowJobs(workflowID string) ([]*Job, error) {
+	var jobs []*Job
+
+	query := url.Values{}
+	for {
+		var page jobPage
+		if err := c.doJSON(http.MethodGet, constants.API_V2_PATH+"workflow/"+workflowID+"/job", query, nil, &page); err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to list jobs of workflow %s.\n<?> Error: %w", workflowID, err)
+		}
+
+		jobs = append(jobs, page.Items...)
+		if page.NextPageToken == "" {
+			break
+		}
+
+		query.Set("page-token", page.NextPageToken)
+	}
+
+	return jobs, nil
+}
+
+// CancelWorkflow cancels a running workflow.
+//
+// Parameters:
+//   - workflowID: workflow ID (uuid)
+//
+// Returns an error if:
+//   - The workflow doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	err := client.CancelWorkflow("fda08377-fe7e-46b1-8992-3a7aaecac9c3")
+func (c *Client) CancelWorkflow(workflowID string) error {
+	if err := c.doJSON(http.MethodPost, constants.API_V2_PATH+"workflow/"+workflowID+"/cancel", nil, nil, nil); err != nil {
+		return fmt.Errorf("<?> Error: Failed to cancel workflow %s.\n<?> Error: %w", workflowID, err)
+	}
+
+	return nil
+}
+
+// RerunWorkflow reruns a workflow (or only it's failed jobs), as a new workflow of the same pipeline.
+//
+// Parameters:
+//   - workflowID: workflow ID (uuid)
+//   - fromFailed: only rerun the failed jobs (and the jobs depending on them)
+//
+// Returns the ID of the new workflow, or an error if:
+//   - The workflow doesn't exist (or is still running)
+//   - The API request fails
+//
+// Example:
+//
+//	workflowID, err := client.RerunWorkflow("fda08377-fe7e-46b1-8992-3a7aaecac9c3", true)
+func (c *Client) RerunWorkflow(workflowID string, fromFailed bool) (string, error) {
+	var rerun RerunWorkflowResponse
+
+	body := RerunWorkflowRequest{FromFailed: fromFailed}
+	if err := c.doJSON(http.MethodPost, constants.API_V2_PATH+"workflow/"+workflowID+"/rerun", nil, body, &rerun); err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to rerun workflow %s.\n<?> Error: %w", workflowID, err)
+	}
+
+	return rerun.WorkflowID, nil
+}
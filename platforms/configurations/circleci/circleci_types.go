@@ -0,0 +1,137 @@
+package circleci
+
+import "time"
+
+// NOTE: Only the fields used by uniflow are decoded from the circleci api
+
+// Project represents a circleci project
+type Project struct {
+	Slug             string `json:"slug"`
+	Name             string `json:"name"`
+	ID               string `json:"id"`
+	OrganizationName string `json:"organization_name"`
+	VCSInfo          struct {
+		VCSURL        string `json:"vcs_url"`
+		Provider      string `json:"provider"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"vcs_info"`
+}
+
+// Actor represents the user who triggered a pipeline
+type Actor struct {
+	Login string `json:"login"`
+}
+
+// Trigger represents what triggered a pipeline
+type Trigger struct {
+	Type       string    `json:"type"`
+	ReceivedAt time.Time `json:"received_at"`
+	Actor      *Actor    `json:"actor"`
+}
+
+// VCS represents the version control information of a pipeline
+type VCS struct {
+	Branch   string `json:"branch"`
+	Tag      string `json:"tag"`
+	Revision string `json:"revision"`
+}
+
+// Pipeline represents a circleci pipeline
+type Pipeline struct {
+	ID          string    `json:"id"`
+	Number      int64     `json:"number"`
+	ProjectSlug string    `json:"project_slug"`
+	State       string    `json:"state"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Trigger     *Trigger  `json:"trigger"`
+	VCS         *VCS      `json:"vcs"`
+}
+
+// Workflow represents a workflow of a pipeline
+type Workflow struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Status         string     `json:"status"`
+	PipelineID     string     `json:"pipeline_id"`
+	PipelineNumber int64      `json:"pipeline_number"`
+	ProjectSlug    string     `json:"project_slug"`
+	CreatedAt      time.Time  `json:"created_at"`
+	StoppedAt      *time.Time `json:"stopped_at"`
+}
+
+// Job represents a job of a workflow
+// NOTE: approval jobs have no job number
+type Job struct {
+	ID        string     `json:"id"`
+	JobNumber int64      `json:"job_number"`
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	Type      string     `json:"type"`
+	StartedAt *time.Time `json:"started_at"`
+	StoppedAt *time.Time `json:"stopped_at"`
+}
+
+// JobDetails represents a job as returned by the v1.1 api (with it's steps)
+type JobDetails struct {
+	BuildNum int64   `json:"build_num"`
+	Status   string  `json:"status"`
+	BuildURL string  `json:"build_url"`
+	Steps    []*Step `json:"steps"`
+}
+
+// Step represents a step of a job
+type Step struct {
+	Name    string    `json:"name"`
+	Actions []*Action `json:"actions"`
+}
+
+// Action represents a step execution (one per parallel container)
+type Action struct {
+	Index     int    `json:"index"`
+	Step      int    `json:"step"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	HasOutput bool   `json:"has_output"`
+	OutputURL string `json:"output_url"`
+}
+
+// OutputMessage represents a chunk of a step output
+type OutputMessage struct {
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+	Type    string    `json:"type"`
+}
+
+// TriggerPipelineRequest represents the body of a pipeline trigger
+type TriggerPipelineRequest struct {
+	Branch     string                 `json:"branch,omitempty"`
+	Tag        string                 `json:"tag,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// RerunWorkflowRequest represents the body of a workflow rerun
+type RerunWorkflowRequest struct {
+	FromFailed bool `json:"from_failed,omitempty"`
+}
+
+// RerunWorkflowResponse represents the workflow created by a rerun
+type RerunWorkflowResponse struct {
+	WorkflowID string `json:"workflow_id"`
+}
+
+// pipelinePage, workflowPage and jobPage represent a page of a paginated list
+type pipelinePage struct {
+	Items         []*Pipeline `json:"items"`
+	NextPageToken string      `json:"next_page_token"`
+}
+
+type workflowPage struct {
+	Items         []*Workflow `json:"items"`
+	NextPageToken string      `json:"next_page_token"`
+}
+
+type jobPage struct {
+	Items         []*Job `json:"items"`
+	NextPageToken string `json:"next_page_token"`
+}
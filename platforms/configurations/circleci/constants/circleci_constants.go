@@ -0,0 +1,45 @@
+package constants
+
+import "time"
+
+// Default values
+const (
+	// CIRCLECI_TOKEN_ENV_VAR_NAME represents the circleci personal api token name in env
+	CIRCLECI_TOKEN_ENV_VAR_NAME = "CIRCLECI_TOKEN"
+
+	// DEFAULT_BASE_URL is circleci.com base url
+	DEFAULT_BASE_URL = "https://circleci.com"
+
+	// API_V2_PATH is the v2 api prefix (pipelines, workflows and jobs)
+	API_V2_PATH = "api/v2/"
+
+	// API_V1_PATH is the v1.1 api prefix (job steps output isn't exposed by v2)
+	API_V1_PATH = "api/v1.1/"
+
+	// TOKEN_HEADER is the header holding the personal api token
+	TOKEN_HEADER = "Circle-Token"
+
+	// DEFAULT_CONFIG_PATH is the default pipeline definition file
+	DEFAULT_CONFIG_PATH = ".circleci/config.yml"
+
+	// DEFAULT_TIMEOUT is the http client timeout
+	DEFAULT_TIMEOUT = 30 * time.Second
+)
+
+// Pagination
+const (
+	// MAX_PAGES bounds the number of pages read when listing pipelines
+	MAX_PAGES = 5
+)
+
+// Polling configuration
+const (
+	// StepPollInterval is the interval between two step output requests
+	StepPollInterval = 5 * time.Second
+)
+
+// Repository detection
+var (
+	// DETECTION_PATHS are the files/directories (suffixed with "/") identifying a circleci repository
+	DETECTION_PATHS = []string{".circleci/config.yml", ".circleci/config.yaml"}
+)
@@ -0,0 +1,166 @@
+package helpers
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ProjectPath is a helper function that builds the api path of a project slug.
+//
+// Parameters:
+//   - slug: project slug (eg: "gh/org/repo", "bb/org/repo", "circleci/<org-id>/<project-id>")
+//
+// Example:
+// path := helpers.ProjectPath("gh/org/repo") // "project/gh/org/repo/"
+func ProjectPath(slug string) string {
+	return "project/" + strings.Trim(slug, "/") + "/"
+}
+
+// V1ProjectPath is a helper function that builds the v1.1 api path of a project slug.
+// NOTE: v1.1 expects the full vcs name instead of the short one
+//
+// Parameters:
+//   - slug: project slug (eg: "gh/org/repo")
+//
+// Example:
+// path := helpers.V1ProjectPath("gh/org/repo") // "project/github/org/repo/"
+func V1ProjectPath(slug string) string {
+	parts := strings.SplitN(strings.Trim(slug, "/"), "/", 2)
+
+	switch parts[0] {
+	case "gh":
+		parts[0] = "github"
+	case "bb":
+		parts[0] = "bitbucket"
+	}
+
+	return "project/" + strings.Join(parts, "/") + "/"
+}
+
+// MapWorkflowStatus is a helper function that maps a circleci workflow status onto uniflow's status and conclusion.
+//
+// Parameters:
+//   - status: circleci workflow status (success, running, not_run, failed, error, failing, on_hold, canceled, unauthorized)
+//
+// Example:
+// status, conclusion := helpers.MapWorkflowStatus("failing") // "in_progress", ""
+func MapWorkflowStatus(status string) (string, string) {
+	switch strings.ToLower(status) {
+	case "running", "failing":
+		return "in_progress", ""
+	case "on_hold":
+		return "waiting", ""
+	case "success":
+		return "completed", "success"
+	case "failed", "error", "unauthorized":
+		return "completed", "failure"
+	case "canceled":
+		return "completed", "cancelled"
+	case "not_run":
+		return "completed", "skipped"
+	default:
+		return "queued", ""
+	}
+}
+
+// MapJobStatus is a helper function that maps a circleci job status onto uniflow's status and conclusion.
+//
+// Parameters:
+//   - status: circleci job status (success, running, not_run, failed, retried, queued, not_running, infrastructure_fail, timedout, on_hold, terminated-unknown, blocked, canceled, unauthorized)
+//
+// Example:
+// status, conclusion := helpers.MapJobStatus("timedout") // "completed", "failure"
+func MapJobStatus(status string) (string, string) {
+	switch strings.ToLower(status) {
+	case "running":
+		return "in_progress", ""
+	case "on_hold":
+		return "waiting", ""
+	case "success":
+		return "completed", "success"
+	case "failed", "infrastructure_fail", "timedout", "terminated-unknown", "unauthorized":
+		return "completed", "failure"
+	case "canceled":
+		return "completed", "cancelled"
+	case "not_run", "retried":
+		return "completed", "skipped"
+	default:
+		return "queued", ""
+	}
+}
+
+// AggregateStatus is a helper function that computes a pipeline status and conclusion from it's workflows.
+//
+// Parameters:
+//   - pipelineState: circleci pipeline state (created, errored, setup-pending, setup, pending)
+//   - workflowStatuses: circleci statuses of the pipeline workflows
+//
+// Example:
+// status, conclusion := helpers.AggregateStatus("created", []string{"success", "failed"}) // "completed", "failure"
+func AggregateStatus(pipelineState string, workflowStatuses []string) (string, string) {
+	if strings.EqualFold(pipelineState, "errored") {
+		return "completed", "failure"
+	}
+
+	if len(workflowStatuses) == 0 {
+		return "queued", ""
+	}
+
+	var waiting, running, failed, cancelled, succeeded bool
+	for _, status := range workflowStatuses {
+		runStatus, conclusion := MapWorkflowStatus(status)
+
+		switch {
+		case runStatus == "waiting":
+			waiting = true
+		case runStatus != "completed":
+			running = true
+		case conclusion == "failure":
+			failed = true
+		case conclusion == "cancelled":
+			cancelled = true
+		case conclusion == "success":
+			succeeded = true
+		}
+	}
+
+	switch {
+	case running:
+		return "in_progress", ""
+	case waiting:
+		return "waiting", ""
+	case failed:
+		return "completed", "failure"
+	case cancelled:
+		return "completed", "cancelled"
+	case succeeded:
+		return "completed", "success"
+	default:
+		return "completed", "skipped"
+	}
+}
+
+// CoerceParameter is a helper function that converts a cli string value into the typed value circleci expects.
+// NOTE: circleci rejects "true" for a boolean parameter, and "3" for an integer one
+//
+// Parameters:
+//   - value: raw value
+//
+// Example:
+// val := helpers.CoerceParameter("true") // true
+func CoerceParameter(value interface{}) interface{} {
+	str, ok := value.(string)
+	if !ok {
+		return value
+	}
+
+	if b, err := strconv.ParseBool(str); err == nil && (str == "true" || str == "false") {
+		return b
+	}
+
+	if i, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return i
+	}
+
+	return str
+}
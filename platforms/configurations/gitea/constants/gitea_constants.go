@@ -0,0 +1,36 @@
+package constants
+
+import "time"
+
+// Default values
+const (
+	// GITEA_TOKEN_ENV_VAR_NAME represents the gitea (or forgejo) token name in env
+	GITEA_TOKEN_ENV_VAR_NAME = "GITEA_TOKEN"
+
+	// API_PATH is the api prefix appended to the base url
+	API_PATH = "api/v1/"
+
+	// DEFAULT_WORKFLOWS_DIR is the directory gitea reads workflows from (before .github/workflows)
+	DEFAULT_WORKFLOWS_DIR = ".gitea/workflows"
+
+	// DEFAULT_TIMEOUT is the http client timeout
+	DEFAULT_TIMEOUT = 30 * time.Second
+)
+
+// Default rate limiting configuration.
+const (
+	// Default rate limiting
+	DEFAULT_PER_PAGE = 50
+)
+
+// Polling configuration
+const (
+	// LogsPollInterval is the interval between two job logs requests
+	LogsPollInterval = 3 * time.Second
+)
+
+// Repository detection
+var (
+	// DETECTION_PATHS are the files/directories (suffixed with "/") identifying a gitea (or forgejo) actions repository
+	DETECTION_PATHS = []string{".gitea/workflows/", ".forgejo/workflows/"}
+)
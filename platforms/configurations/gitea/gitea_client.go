@@ -0,0 +1,223 @@
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea/constants"
+	githubHelpers "github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
+	platformConstants "github.com/ignorant05/Uniflow/platforms/constants"
+)
+
+type Client struct {
+	HTTPClient *http.Client
+	BaseURL    *url.URL
+	Ctx        context.Context
+	Config     *Config
+}
+
+// NewClient creates new client from configuration.
+// NOTE: forgejo exposes the same api, the same client is used for both
+//
+// Parameters:
+//   - ctx: context
+//   - cfg: user's gitea configuration
+//
+// Returns an error if:
+//   - no base url configured (gitea is always self-hosted)
+//   - no token configured (nor in env)
+//
+// Example:
+//
+//	client, err := NewClient(context.Background(), cfg)
+func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("<?> Error: No gitea base URL configured")
+	}
+
+	if cfg.Token == "" {
+		cfg.Token = os.Getenv(constants.GITEA_TOKEN_ENV_VAR_NAME)
+		if cfg.Token == "" {
+			return nil, fmt.Errorf("<?> Error: No environment variable named %s found.\n<.> Please verify your ~/.zshrc (or ~/.bashrc) file", constants.GITEA_TOKEN_ENV_VAR_NAME)
+		}
+	}
+
+	// both "https://gitea.company.com" and "https://gitea.company.com/api/v1" are accepted
+	rawURL := strings.TrimSuffix(strings.TrimSuffix(cfg.BaseURL, "/"), "/"+strings.TrimSuffix(constants.API_PATH, "/"))
+
+	baseURL, err := url.Parse(rawURL + "/" + constants.API_PATH)
+	if err != nil || baseURL.Host == "" {
+		return nil, fmt.Errorf("<?> Error: Invalid gitea base URL: %s", cfg.BaseURL)
+	}
+
+	return &Client{
+		HTTPClient: &http.Client{Timeout: constants.DEFAULT_TIMEOUT},
+		BaseURL:    baseURL,
+		Ctx:        ctx,
+		Config:     cfg,
+	}, nil
+}
+
+// NewClientFromProfile creates new client from profile configuration.
+//
+// Parameters:
+//   - ctx: context
+//   - profile: user's profile configuration
+//
+// Returns an error if:
+//   - gitea isn't configured for this profile
+//   - gitea client creation failure
+//
+// Example:
+//
+//	client, err := NewClientFromProfile(context.Background(), profile)
+func NewClientFromProfile(ctx context.Context, profile *config.Profile) (*Client, error) {
+	section, err := config.DecodeSection[Config](profile.RawSection(platformConstants.GITEA_PLATFORM))
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid gitea configuration.\n<?> Error: %w", err)
+	}
+
+	if section == nil {
+		return nil, fmt.Errorf("<?> Error: Gitea isn't configured for this profile")
+	}
+
+	return NewClient(ctx, section.(*Config))
+}
+
+// GetDefaultRepository retrieves the repository configured for the current profile.
+//
+// Parameters:
+//   - None
+//
+// Returns an error if:
+//   - no default repository is configured
+//   - invalid repository format
+//
+// Example:
+//
+//	owner, repo, err := client.GetDefaultRepository()
+func (c *Client) GetDefaultRepository() (string, string, error) {
+	if c.Config.DefaultRepository == "" {
+		return "", "", fmt.Errorf("<?> Error: No default repository configured")
+	}
+
+	return githubHelpers.ParseRepository(c.Config.DefaultRepository)
+}
+
+// newRequest creates an authenticated request, the body (if any) is json encoded
+func (c *Client) newRequest(method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid path: %s.\n<?> Error: %w", path, err)
+	}
+
+	u := c.BaseURL.ResolveReference(ref)
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to encode request body.\n<?> Error: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(c.Ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "Uniflow-CLI")
+	req.Header.Set("Authorization", "token "+c.Config.Token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// do sends the request and converts non 2xx responses into errors
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Request to %s failed.\n<?> Error: %w", req.URL.Path, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Printf("<!> warning: Failed to close response body: %v", err)
+			}
+		}()
+
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Method:     req.Method,
+			Path:       req.URL.Path,
+			Message:    apiErr.Message,
+		}
+	}
+
+	return resp, nil
+}
+
+// doJSON sends the request and decodes the json response into out (if not nil)
+func (c *Client) doJSON(method, path string, query url.Values, body, out interface{}) error {
+	req, err := c.newRequest(method, path, query, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("<?> Error: Failed to decode response of %s.\n<?> Error: %w", path, err)
+	}
+
+	return nil
+}
+
+// APIError represents a non successful gitea api response
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("<?> Error: %s %s returned status code: %d (%s)", e.Method, e.Path, e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("<?> Error: %s %s returned status code: %d", e.Method, e.Path, e.StatusCode)
+}
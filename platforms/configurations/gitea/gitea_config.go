@@ -0,0 +1,55 @@
+package gitea
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+)
+
+// Config is the gitea (and forgejo) section of a profile
+type Config struct {
+	Token             string `yaml:"token" mapstructure:"token"`
+	BaseURL           string `yaml:"base_url" mapstructure:"base_url"`
+	DefaultRepository string `yaml:"default_repository,omitempty" mapstructure:"default_repository"`
+}
+
+// ValidateConfig validates gitea (or forgejo) conf
+//
+// Parameters:
+//   - prefix: prefix string
+//   - cfg: gitea configuration struct
+//
+// Error possible causes:
+//   - gitea token is not sat
+//   - invalid url
+//   - invalid repository format
+//
+// Examples:
+// errs := ValidateConfig(prefix, cfg)
+func ValidateConfig(prefix string, cfg *Config) []error {
+	var errors []error
+
+	if cfg.Token == "" || strings.HasPrefix(cfg.Token, "${") {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".token",
+			Message: "<?> Error: Token is required (set via environment variable or directly)",
+		})
+	}
+
+	if u, err := url.Parse(cfg.BaseURL); cfg.BaseURL == "" || err != nil || u.Scheme == "" || u.Host == "" {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".base_url",
+			Message: "<?> Error: Must be a valid URL",
+		})
+	}
+
+	if len(strings.Split(strings.Trim(cfg.DefaultRepository, "/"), "/")) != 2 {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".default_repository",
+			Message: "<?> Error: Must be in format 'owner/repo'",
+		})
+	}
+
+	return errors
+}
@@ -0,0 +1,106 @@
+package gitea
+
+import "time"
+
+// NOTE: Only the fields used by uniflow are decoded from the gitea api
+
+// Repository represents a gitea repository
+type Repository struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Description   string `json:"description"`
+	DefaultBranch string `json:"default_branch"`
+	Private       bool   `json:"private"`
+	HTMLURL       string `json:"html_url"`
+}
+
+// User represents a gitea user
+type User struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+// Workflow represents an actions workflow (it's ID is the file name)
+type Workflow struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+}
+
+// Run represents an actions workflow run
+type Run struct {
+	ID           int64      `json:"id"`
+	RunNumber    int64      `json:"run_number"`
+	DisplayTitle string     `json:"display_title"`
+	Path         string     `json:"path"`
+	Event        string     `json:"event"`
+	Status       string     `json:"status"`
+	Conclusion   string     `json:"conclusion"`
+	HeadBranch   string     `json:"head_branch"`
+	HeadSHA      string     `json:"head_sha"`
+	HTMLURL      string     `json:"html_url"`
+	URL          string     `json:"url"`
+	Actor        *User      `json:"actor"`
+	TriggerActor *User      `json:"trigger_actor"`
+	CreatedAt    *time.Time `json:"created_at"`
+	StartedAt    *time.Time `json:"started_at"`
+	CompletedAt  *time.Time `json:"completed_at"`
+}
+
+// Job represents an actions job
+type Job struct {
+	ID          int64      `json:"id"`
+	RunID       int64      `json:"run_id"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion"`
+	HeadBranch  string     `json:"head_branch"`
+	HTMLURL     string     `json:"html_url"`
+	URL         string     `json:"url"`
+	RunURL      string     `json:"run_url"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// ContentsResponse represents a file of a repository
+type ContentsResponse struct {
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+	Encoding string `json:"encoding"`
+	Content  string `json:"content"`
+}
+
+// DispatchWorkflowRequest represents the body of a workflow dispatch
+type DispatchWorkflowRequest struct {
+	Ref    string            `json:"ref"`
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+// workflowList, runList and jobList represent the list responses
+type workflowList struct {
+	Workflows  []*Workflow `json:"workflows"`
+	TotalCount int64       `json:"total_count"`
+}
+
+type runList struct {
+	WorkflowRuns []*Run `json:"workflow_runs"`
+	TotalCount   int64  `json:"total_count"`
+}
+
+type jobList struct {
+	Jobs       []*Job `json:"jobs"`
+	TotalCount int64  `json:"total_count"`
+}
+
+// TimeOrZero returns the dereferenced time or the zero time
+func TimeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
@@ -0,0 +1,249 @@
+package gitea
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea/helpers"
+)
+
+// GetRepository retrieves a repository.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//
+// Returns an error if:
+//   - The repository doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	repository, err := client.GetRepository("owner", "repo")
+func (c *Client) GetRepository(owner, repo string) (*Repository, error) {
+	var repository Repository
+
+	if err := c.doJSON(http.MethodGet, strings.TrimSuffix(helpers.RepoPath(owner, repo), "/"), nil, nil, &repository); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get repository %s/%s.\n<?> Error: %w", owner, repo, err)
+	}
+
+	return &repository, nil
+}
+
+// DispatchWorkflow triggers a workflow_dispatch event.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - workflowFileName: Workflow file name (e.g., "deploy.yml")
+//   - ref: Git reference (branch, tag)
+//   - inputs: Workflow inputs as key-value pairs
+//
+// Returns an error if:
+//   - The workflow doesn't exist
+//   - The workflow has no workflow_dispatch trigger
+//   - The API request fails
+//
+// Example:
+//
+//	err := client.DispatchWorkflow("owner", "repo", "deploy.yml", "main", map[string]string{
+//	  "environment": "production",
+//	})
+func (c *Client) DispatchWorkflow(owner, repo, workflowFileName, ref string, inputs map[string]string) error {
+	body := DispatchWorkflowRequest{Ref: ref, Inputs: inputs}
+
+	reqPath := helpers.RepoPath(owner, repo) + "actions/workflows/" + url.PathEscape(path.Base(workflowFileName)) + "/dispatches"
+	if err := c.doJSON(http.MethodPost, reqPath, nil, body, nil); err != nil {
+		return fmt.Errorf("<?> Error: Failed to dispatch workflow %s.\n<?> Error: %w", workflowFileName, err)
+	}
+
+	return nil
+}
+
+// ListWorkflows lists the actions workflows of a repository.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//
+// Returns an error if:
+//   - The repository doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	workflows, err := client.ListWorkflows("owner", "repo")
+func (c *Client) ListWorkflows(owner, repo string) ([]*Workflow, error) {
+	var list workflowList
+
+	if err := c.doJSON(http.MethodGet, helpers.RepoPath(owner, repo)+"actions/workflows", nil, nil, &list); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list workflows.\n<?> Error: %w", err)
+	}
+
+	return list.Workflows, nil
+}
+
+// GetFileContent retrieves the decoded content of a repository file.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - filePath: file path (eg: ".gitea/workflows/ci.yml")
+//
+// Returns an error if:
+//   - The file doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	content, err := client.GetFileContent("owner", "repo", ".gitea/workflows/ci.yml")
+func (c *Client) GetFileContent(owner, repo, filePath string) (string, error) {
+	var contents ContentsResponse
+
+	if err := c.doJSON(http.MethodGet, helpers.RepoPath(owner, repo)+"contents/"+strings.TrimPrefix(filePath, "/"), nil, nil, &contents); err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to get content of %s.\n<?> Error: %w", filePath, err)
+	}
+
+	if contents.Encoding != "base64" {
+		return contents.Content, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(contents.Content)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to decode content of %s.\n<?> Error: %w", filePath, err)
+	}
+
+	return string(data), nil
+}
+
+// ListRuns lists the most recent workflow runs of a repository.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - branch: filters by branch (optional)
+//   - status: filters by status (optional)
+//   - limit: maximum number of runs (0 = default)
+//
+// Returns an error if:
+//   - The repository doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	runs, err := client.ListRuns("owner", "repo", "main", "", 10)
+func (c *Client) ListRuns(owner, repo, branch, status string, limit int) ([]*Run, error) {
+	if limit <= 0 || limit > constants.DEFAULT_PER_PAGE {
+		limit = constants.DEFAULT_PER_PAGE
+	}
+
+	query := url.Values{"limit": {strconv.Itoa(limit)}}
+	if branch != "" {
+		query.Set("branch", branch)
+	}
+	if status != "" {
+		query.Set("status", status)
+	}
+
+	var list runList
+	if err := c.doJSON(http.MethodGet, helpers.RepoPath(owner, repo)+"actions/runs", query, nil, &list); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list workflow runs.\n<?> Error: %w", err)
+	}
+
+	return list.WorkflowRuns, nil
+}
+
+// GetRun retrieves a workflow run by it's ID.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - runID: run ID
+//
+// Returns an error if:
+//   - The run doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	run, err := client.GetRun("owner", "repo", 42)
+func (c *Client) GetRun(owner, repo string, runID int64) (*Run, error) {
+	var run Run
+
+	if err := c.doJSON(http.MethodGet, helpers.RepoPath(owner, repo)+"actions/runs/"+strconv.FormatInt(runID, 10), nil, nil, &run); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get workflow run by ID: %d.\n<?> Error: %w", runID, err)
+	}
+
+	return &run, nil
+}
+
+// ListRunJobs lists the jobs of a workflow run.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - runID: run ID
+//
+// Returns an error if:
+//   - The run doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	jobs, err := client.ListRunJobs("owner", "repo", 42)
+func (c *Client) ListRunJobs(owner, repo string, runID int64) ([]*Job, error) {
+	query := url.Values{"limit": {strconv.Itoa(constants.DEFAULT_PER_PAGE)}}
+
+	var list jobList
+	if err := c.doJSON(http.MethodGet, helpers.RepoPath(owner, repo)+"actions/runs/"+strconv.FormatInt(runID, 10)+"/jobs", query, nil, &list); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list jobs of run %d.\n<?> Error: %w", runID, err)
+	}
+
+	return list.Jobs, nil
+}
+
+// GetJobLogs retrieves the logs of a job.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - jobID: job ID
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	logs, err := client.GetJobLogs("owner", "repo", 6789)
+func (c *Client) GetJobLogs(owner, repo string, jobID int64) (string, error) {
+	req, err := c.newRequest(http.MethodGet, helpers.RepoPath(owner, repo)+"actions/jobs/"+strconv.FormatInt(jobID, 10)+"/logs", nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to get logs of job %d.\n<?> Error: %w", jobID, err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to read job logs.\n<?> Error: %w", err)
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,60 @@
+package helpers
+
+import (
+	"path"
+	"strings"
+)
+
+// RepoPath is a helper function that builds the api path of a repository.
+//
+// Parameters:
+//   - owner: repository owner (user or organization)
+//   - repo: repository name
+//
+// Example:
+// path := helpers.RepoPath("owner", "repo") // "repos/owner/repo/"
+func RepoPath(owner, repo string) string {
+	return "repos/" + owner + "/" + repo + "/"
+}
+
+// MapRunStatus is a helper function that normalizes a gitea run (or job) status onto uniflow's status and conclusion.
+// NOTE: recent gitea versions report github-like statuses, older ones report the result as the status
+//
+// Parameters:
+//   - status: gitea status (queued, waiting, blocked, running, in_progress, completed, success, failure, cancelled, skipped)
+//   - conclusion: gitea conclusion (only set once completed)
+//
+// Example:
+// status, conclusion := helpers.MapRunStatus("failure", "") // "completed", "failure"
+func MapRunStatus(status, conclusion string) (string, string) {
+	switch strings.ToLower(status) {
+	case "running", "in_progress":
+		return "in_progress", ""
+	case "waiting", "blocked":
+		return "waiting", ""
+	case "completed":
+		return "completed", conclusion
+	case "success", "failure", "cancelled", "skipped":
+		return "completed", strings.ToLower(status)
+	default:
+		return "queued", ""
+	}
+}
+
+// MatchesWorkflow is a helper function that checks if a run path ("ci.yml@refs/heads/main") belongs to a workflow.
+//
+// Parameters:
+//   - runPath: path of the run workflow (as reported by gitea)
+//   - workflow: workflow file name or path (eg: "ci.yml", ".gitea/workflows/ci.yml")
+//
+// Example:
+// ok := helpers.MatchesWorkflow("ci.yml@refs/heads/main", ".gitea/workflows/ci.yml") // true
+func MatchesWorkflow(runPath, workflow string) bool {
+	if workflow == "" {
+		return true
+	}
+
+	file, _, _ := strings.Cut(runPath, "@")
+
+	return path.Base(file) == path.Base(workflow)
+}
@@ -3,4 +3,10 @@ package constants
 const (
 	DEFAULT_DOWNLOAD_FILE_NAME = "logs.zip"
 	DEFAULT_DOWNLOAD_DIR_PATH  = "~/.uniflow/logs"
+
+	// index file of an extracted logs archive
+	ARCHIVE_INDEX_FILE_NAME = "index.json"
+
+	// artifacts max size (github caps artifacts at 10 GB)
+	ARTIFACT_MAX_SIZE = 10 * 1024 * 1024 * 1024
 )
@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 // Default values
 const (
 	// GITHUB_TOKEN_ENV_VAR_NAME represents the github token name in env
@@ -7,6 +9,9 @@ const (
 
 	// GITHUB_LOGS_MAX_INDIRECT represents maxIndirects (default is 5)
 	GITHUB_LOGS_MAX_INDIRECT = 5
+
+	// LOGS_DOWNLOAD_TIMEOUT is the maximum time to download the logs of a job
+	LOGS_DOWNLOAD_TIMEOUT = 30 * time.Second
 )
 
 // Default rate limiting configuration.
@@ -14,3 +19,21 @@ const (
 	// Default rate limiting
 	DEFAULT_PER_PAGE = 100
 )
+
+// Dispatch correlation (finding the run created by a workflow_dispatch)
+const (
+	// CORRELATION_TIMEOUT is the maximum time to wait for the dispatched run to appear
+	CORRELATION_TIMEOUT = 60 * time.Second
+
+	// CORRELATION_POLL_INTERVAL is the interval between two run lookups
+	CORRELATION_POLL_INTERVAL = 2 * time.Second
+
+	// CORRELATION_CLOCK_SKEW is the margin applied to the dispatch time (local and github clocks differ)
+	CORRELATION_CLOCK_SKEW = 10 * time.Second
+)
+
+// Repository detection
+var (
+	// DETECTION_PATHS are the files/directories (suffixed with "/") identifying a github actions repository
+	DETECTION_PATHS = []string{".github/workflows/", ".github/workflows/*.yaml", ".github/workflows/*.yml"}
+)
@@ -4,7 +4,7 @@ import (
 	"time"
 )
 
-// Streamer constants
+// Logs constants
 const (
 	MAX_REDIRECTS = 10
 
@@ -13,7 +13,5 @@ const (
 )
 
 const (
-	PollInterval    = 3 * time.Second
-	MaxPollAttempts = 600
-	JobCheckIntervl = 5 * time.Second
+	PollInterval = 3 * time.Second
 )
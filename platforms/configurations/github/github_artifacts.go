@@ -0,0 +1,64 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/google/go-github/v57/github"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/constants"
+)
+
+// ListRunArtifacts lists the artifacts of a workflow run.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - runID: workflow run ID
+//
+// Returns an error if:
+//   - The workflow run doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	artifacts, err := client.ListRunArtifacts("owner", "repo", 12345)
+func (c *Client) ListRunArtifacts(owner, repo string, runID int64) ([]*github.Artifact, error) {
+	opts := &github.ListOptions{PerPage: constants.DEFAULT_PER_PAGE}
+
+	var artifacts []*github.Artifact
+	for {
+		list, resp, err := c.Actions.ListWorkflowRunArtifacts(c.Ctx, owner, repo, runID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to list artifacts of run: %d.\n<?> Error: %w", runID, err)
+		}
+
+		artifacts = append(artifacts, list.Artifacts...)
+		if resp.NextPage == 0 {
+			return artifacts, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
+
+// GetArtifactDownloadURL resolves the (short lived) download url of an artifact archive.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - artifactID: artifact ID
+//
+// Returns an error if:
+//   - The artifact doesn't exist (or expired)
+//   - The API request fails
+//
+// Example:
+//
+//	url, err := client.GetArtifactDownloadURL("owner", "repo", 12345)
+func (c *Client) GetArtifactDownloadURL(owner, repo string, artifactID int64) (string, error) {
+	url, _, err := c.Actions.DownloadArtifact(c.Ctx, owner, repo, artifactID, constants.GITHUB_LOGS_MAX_INDIRECT)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to get download url of artifact: %d.\n<?> Error: %w", artifactID, err)
+	}
+
+	return url.String(), nil
+}
@@ -9,13 +9,14 @@ import (
 	"github.com/ignorant05/Uniflow/internal/config"
 	"github.com/ignorant05/Uniflow/platforms/configurations/github/constants"
 	"github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
+	platformConstants "github.com/ignorant05/Uniflow/platforms/constants"
 	"golang.org/x/oauth2"
 )
 
 type Client struct {
 	*github.Client
 	Ctx    context.Context
-	Config *config.GithubConfig
+	Config *Config
 }
 
 // NewClient creates new client from configuration.
@@ -32,7 +33,7 @@ type Client struct {
 // Example:
 //
 //	client, err, err := NewClient(context.Background(), cfg)
-func NewClient(ctx context.Context, cfg *config.GithubConfig) (*Client, error) {
+func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
 	if cfg.Token == "" {
 		cfg.Token = os.Getenv(constants.GITHUB_TOKEN_ENV_VAR_NAME)
 		if cfg.Token == "" {
@@ -74,11 +75,16 @@ func NewClient(ctx context.Context, cfg *config.GithubConfig) (*Client, error) {
 //
 //	client, err, err := NewClientFromProfile(context.Background(), profile)
 func NewClientFromProfile(ctx context.Context, profile *config.Profile) (*Client, error) {
-	if profile.Github == nil {
+	section, err := config.DecodeSection[Config](profile.RawSection(platformConstants.GITHUB_PLATFORM))
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid github configuration.\n<?> Error: %w", err)
+	}
+
+	if section == nil {
 		return nil, fmt.Errorf("<?> Error: Github isn't configured for this profile")
 	}
 
-	return NewClient(ctx, profile.Github)
+	return NewClient(ctx, section.(*Config))
 }
 
 // GetDefaultRepository retrieves owner and repo for the current configuration.
@@ -0,0 +1,54 @@
+package github
+
+import (
+	"strings"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	internalHelpers "github.com/ignorant05/Uniflow/internal/helpers"
+)
+
+// Config is the github section of a profile
+type Config struct {
+	Token             string `yaml:"token" mapstructure:"token"`
+	DefaultRepository string `yaml:"default_repository,omitempty" mapstructure:"default_repository"`
+	BaseURL           string `yaml:"base_url,omitempty" mapstructure:"base_url"`
+}
+
+// ValidateConfig validates github conf
+//
+// Parameters:
+//   - prefix: prefix string
+//   - cfg: github configuration struct
+//
+// Error possible causes:
+//   - github token is not sat
+//   - invalid url
+//
+// Examples:
+// errs := ValidateConfig(prefix, cfg)
+func ValidateConfig(prefix string, cfg *Config) []error {
+	var errors []error
+
+	if cfg.Token == "" || strings.HasPrefix(cfg.Token, "${") {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".token",
+			Message: "<?> Error: Token is required (set via environment variable or directly)",
+		})
+	}
+
+	if cfg.BaseURL == "" {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".base_url",
+			Message: "<?> Error: Must be a valid URL",
+		})
+	}
+
+	if !internalHelpers.IsValidRepoFormat(cfg.DefaultRepository) {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".default_repository",
+			Message: "<?> Error: Must be in format 'owner/repo'",
+		})
+	}
+
+	return errors
+}
@@ -0,0 +1,131 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v57/github"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/constants"
+)
+
+// PendingDeployment represents a deployment of a run waiting on environment protection rules
+// NOTE: go-github doesn't expose the pending deployments of a run (only their review)
+type PendingDeployment struct {
+	Environment *PendingDeploymentEnvironment `json:"environment"`
+
+	// WaitTimer is the delay (in minutes) before the deployment can proceed
+	WaitTimer          int               `json:"wait_timer"`
+	WaitTimerStartedAt *github.Timestamp `json:"wait_timer_started_at"`
+
+	CurrentUserCanApprove bool                       `json:"current_user_can_approve"`
+	Reviewers             []*github.RequiredReviewer `json:"reviewers"`
+}
+
+// PendingDeploymentEnvironment represents the environment of a pending deployment
+type PendingDeploymentEnvironment struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	HTMLURL string `json:"html_url"`
+}
+
+// ListWaitingRuns lists the runs waiting on environment protection rules.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - workflowID: workflow id (0 for the runs of all workflows)
+//
+// Returns an error if:
+//   - The API request fails
+//
+// Example:
+//
+//	runs, err := client.ListWaitingRuns("owner", "repo", 0)
+func (c *Client) ListWaitingRuns(owner, repo string, workflowID int64) ([]*github.WorkflowRun, error) {
+	opts := &github.ListWorkflowRunsOptions{
+		Status:      "waiting",
+		ListOptions: github.ListOptions{PerPage: constants.DEFAULT_PER_PAGE},
+	}
+
+	var (
+		runs *github.WorkflowRuns
+		err  error
+	)
+
+	if workflowID != 0 {
+		runs, _, err = c.Actions.ListWorkflowRunsByID(c.Ctx, owner, repo, workflowID, opts)
+	} else {
+		runs, _, err = c.Actions.ListRepositoryWorkflowRuns(c.Ctx, owner, repo, opts)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list waiting runs.\n<?> Error: %w", err)
+	}
+
+	return runs.WorkflowRuns, nil
+}
+
+// ListPendingDeployments lists the deployments of a run waiting on environment protection rules.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - runID: workflow run ID
+//
+// Returns an error if:
+//   - The workflow run doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	deployments, err := client.ListPendingDeployments("owner", "repo", 12345)
+func (c *Client) ListPendingDeployments(owner, repo string, runID int64) ([]*PendingDeployment, error) {
+	path := fmt.Sprintf("repos/%s/%s/actions/runs/%d/pending_deployments", owner, repo, runID)
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to create pending deployments request.\n<?> Error: %w", err)
+	}
+
+	var deployments []*PendingDeployment
+	if _, err := c.Do(c.Ctx, req, &deployments); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list pending deployments of run: %d.\n<?> Error: %w", runID, err)
+	}
+
+	return deployments, nil
+}
+
+// ReviewPendingDeployments approves or rejects the pending deployments of a run.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - runID: workflow run ID
+//   - environmentIDs: reviewed environments
+//   - approve: approves the deployments (rejects them otherwise)
+//   - comment: review comment
+//
+// Returns an error if:
+//   - The authenticated user isn't a reviewer of the environments
+//   - The API request fails
+//
+// Example:
+//
+//	err := client.ReviewPendingDeployments("owner", "repo", 12345, []int64{161088068}, true, "ship it")
+func (c *Client) ReviewPendingDeployments(owner, repo string, runID int64, environmentIDs []int64, approve bool, comment string) error {
+	state := "rejected"
+	if approve {
+		state = "approved"
+	}
+
+	request := &github.PendingDeploymentsRequest{
+		EnvironmentIDs: environmentIDs,
+		State:          state,
+		Comment:        comment,
+	}
+
+	if _, _, err := c.Actions.PendingDeployments(c.Ctx, owner, repo, runID, request); err != nil {
+		return fmt.Errorf("<?> Error: Failed to review pending deployments of run: %d.\n<?> Error: %w", runID, err)
+	}
+
+	return nil
+}
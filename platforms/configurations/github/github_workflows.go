@@ -1,8 +1,11 @@
 package github
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v57/github"
 	"github.com/ignorant05/Uniflow/platforms/configurations/github/constants"
@@ -118,7 +121,7 @@ func (c *Client) ListWorkflowJobs(owner, repo string, runID int64) ([]*github.Wo
 // Parameters:
 //   - owner: Repository owner (username or organization)
 //   - repo: Repository name
-//   - runID: workflow ID
+//   - runID: workflow ID (0 for the runs of all workflows)
 //
 // Returns an error if:
 //   - The workflow doesn't exist (or invalid runID)
@@ -131,7 +134,18 @@ func (c *Client) GetWorkflowRuns(owner, repo string, workflowID int64) ([]*githu
 	opts := &github.ListWorkflowRunsOptions{
 		ListOptions: github.ListOptions{PerPage: constants.DEFAULT_PER_PAGE},
 	}
-	runs, _, err := c.Actions.ListWorkflowRunsByID(c.Ctx, owner, repo, workflowID, opts)
+
+	var (
+		runs *github.WorkflowRuns
+		err  error
+	)
+
+	if workflowID != 0 {
+		runs, _, err = c.Actions.ListWorkflowRunsByID(c.Ctx, owner, repo, workflowID, opts)
+	} else {
+		runs, _, err = c.Actions.ListRepositoryWorkflowRuns(c.Ctx, owner, repo, opts)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("<?> Error: Failed to get workflow runs by ID: %d.\n<?> Error: %w", workflowID, err)
 	}
@@ -139,6 +153,82 @@ func (c *Client) GetWorkflowRuns(owner, repo string, workflowID int64) ([]*githu
 	return runs.WorkflowRuns, nil
 }
 
+// ListDispatchedRuns lists the workflow_dispatch runs of a workflow created since a given time.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - workflowID: workflow id
+//   - branch: branch (or tag) the runs ran on (optional)
+//   - actor: user who dispatched the runs (optional)
+//   - since: minimum creation time
+//
+// Returns an error if:
+//   - The API request fails
+//
+// Example:
+//
+//	runs, err := client.ListDispatchedRuns("owner", "repo", 12345, "main", "ignorant05", since)
+func (c *Client) ListDispatchedRuns(owner, repo string, workflowID int64, branch, actor string, since time.Time) ([]*github.WorkflowRun, error) {
+	opts := &github.ListWorkflowRunsOptions{
+		Event:       "workflow_dispatch",
+		Branch:      branch,
+		Actor:       actor,
+		Created:     ">=" + since.UTC().Format(time.RFC3339),
+		ListOptions: github.ListOptions{PerPage: constants.DEFAULT_PER_PAGE},
+	}
+
+	runs, _, err := c.Actions.ListWorkflowRunsByID(c.Ctx, owner, repo, workflowID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list dispatched runs of workflow: %d.\n<?> Error: %w", workflowID, err)
+	}
+
+	return runs.WorkflowRuns, nil
+}
+
+// GetRefSHA resolves the commit a branch or tag points to.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - ref: Git reference (branch or tag)
+//
+// Returns an error if:
+//   - The reference doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	sha, err := client.GetRefSHA("ignorant05", "Uniflow", "main")
+func (c *Client) GetRefSHA(owner, repo, ref string) (string, error) {
+	sha, _, err := c.Repositories.GetCommitSHA1(c.Ctx, owner, repo, ref, "")
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to resolve ref: %s.\n<?> Error: %w", ref, err)
+	}
+
+	return sha, nil
+}
+
+// GetAuthenticatedLogin retrieves the login of the authenticated user.
+//
+// Parameters:
+//   - None
+//
+// Returns an error if:
+//   - The API request fails
+//
+// Example:
+//
+//	login, err := client.GetAuthenticatedLogin()
+func (c *Client) GetAuthenticatedLogin() (string, error) {
+	user, _, err := c.Users.Get(c.Ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to get authenticated user.\n<?> Error: %w", err)
+	}
+
+	return user.GetLogin(), nil
+}
+
 // GetWorkflowRunStatus show the status of workflows present in the repo.
 //
 // Parameters:
@@ -185,6 +275,40 @@ func (c *Client) GetWorkflowRunLogs(owner, repo string, runID int64) (string, er
 	return url.String(), nil
 }
 
+// GetJobLogs retrieves the logs of a job written after an offset (at most DATA_LOGS_MAX_SIZE bytes at once).
+// NOTE: github redirects to a short lived download url, which is fetched without the api credentials.
+// Only the new bytes are downloaded when the storage supports range requests.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - jobID: job ID
+//   - offset: bytes already read (0 for the whole logs)
+//
+// Returns an error if:
+//   - The job doesn't exist (or has no logs yet)
+//   - The API request fails
+//
+// Example:
+//
+//	logs, start, truncated, err := client.GetJobLogs("owner", "repo", 6789, 0)
+func (c *Client) GetJobLogs(owner, repo string, jobID, offset int64) (string, int64, bool, error) {
+	url, _, err := c.Actions.GetWorkflowJobLogs(c.Ctx, owner, repo, jobID, constants.GITHUB_LOGS_MAX_INDIRECT)
+	if err != nil {
+		return "", 0, false, fmt.Errorf("<?> Error: Failed to get logs of job %d.\n<?> Error: %w", jobID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Ctx, constants.LOGS_DOWNLOAD_TIMEOUT)
+	defer cancel()
+
+	logs, start, truncated, err := helpers.ReadLogsFrom(ctx, http.DefaultClient, url.String(), offset, constants.DATA_LOGS_MAX_SIZE)
+	if err != nil {
+		return "", 0, false, fmt.Errorf("<?> Error: Failed to download logs of job %d.\n<?> Error: %w", jobID, err)
+	}
+
+	return logs, start, truncated, nil
+}
+
 // CancelWorkflowRun cancels a workflow by it's ID
 //
 // Parameters:
@@ -208,6 +332,66 @@ func (c *Client) CancelWorkflowRun(owner, repo string, runID int64) error {
 	return nil
 }
 
+// RerunWorkflowRun reruns a workflow run (or only it's failed jobs and their dependents).
+// NOTE: github reruns in place, the run keeps it's ID (with a new attempt)
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - runID: workflow run ID
+//   - failedOnly: only rerun the failed jobs
+//   - debug: enable debug logging (runner and step debug logs)
+//
+// Returns an error if:
+//   - The workflow run doesn't exist (or is still running)
+//   - The API request fails
+//
+// Example:
+//
+//	err := client.RerunWorkflowRun("owner", "repo", 12345, true, false)
+func (c *Client) RerunWorkflowRun(owner, repo string, runID int64, failedOnly, debug bool) error {
+	endpoint := "rerun"
+	if failedOnly {
+		endpoint = "rerun-failed-jobs"
+	}
+
+	// go-github doesn't expose the debug option
+	path := fmt.Sprintf("repos/%s/%s/actions/runs/%d/%s", owner, repo, runID, endpoint)
+	req, err := c.NewRequest(http.MethodPost, path, map[string]bool{"enable_debug_logging": debug})
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to create rerun request.\n<?> Error: %w", err)
+	}
+
+	if _, err := c.Do(c.Ctx, req, nil); err != nil {
+		return fmt.Errorf("<?> Error: Failed to rerun workflow run with runID: %d.\n<?> Error: %w", runID, err)
+	}
+
+	return nil
+}
+
+// IsProtectedBranch checks whether a branch is protected.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - branch: branch name
+//
+// Returns an error if:
+//   - The branch doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	protected, err := client.IsProtectedBranch("owner", "repo", "main")
+func (c *Client) IsProtectedBranch(owner, repo, branch string) (bool, error) {
+	githubBranch, _, err := c.Repositories.GetBranch(c.Ctx, owner, repo, branch, constants.MAX_REDIRECTS)
+	if err != nil {
+		return false, fmt.Errorf("<?> Error: Failed to get branch %s of %s/%s.\n<?> Error: %w", branch, owner, repo, err)
+	}
+
+	return githubBranch.GetProtected(), nil
+}
+
 // GetWorkflowRunSummary retrieves the workflow summary of the running workflow.
 //
 // Parameters:
@@ -280,19 +464,7 @@ func (c *Client) FilterWorkflowsWithDispatchOnly(owner, repo string) ([]string,
 
 	for _, content := range dirContent {
 		if strings.HasSuffix(*content.Name, ".yaml") || strings.HasSuffix(*content.Name, ".yml") {
-			fileContent, _, _, err := c.Repositories.GetContents(
-				c.Ctx,
-				owner,
-				repo,
-				fmt.Sprintf("./github/workflows/%s", *content.Name),
-				&github.RepositoryContentGetOptions{},
-			)
-
-			if err != nil {
-				continue
-			}
-
-			contentStr, err := fileContent.GetContent()
+			contentStr, err := c.GetWorkflowFileContent(owner, repo, ".github/workflows/"+*content.Name, "")
 			if err != nil {
 				continue
 			}
@@ -308,6 +480,82 @@ func (c *Client) FilterWorkflowsWithDispatchOnly(owner, repo string) ([]string,
 	return workflowsWithDispatch, nil
 }
 
+// ListBranches lists the branches of a repository.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//
+// Returns an error if:
+//   - The API request fails
+//
+// Example:
+//
+//	branches, err := client.ListBranches("owner", "repo")
+func (c *Client) ListBranches(owner, repo string) ([]*github.Branch, error) {
+	var allBranches []*github.Branch
+
+	opts := &github.BranchListOptions{
+		ListOptions: github.ListOptions{PerPage: constants.DEFAULT_PER_PAGE},
+	}
+
+	for {
+		branches, resp, err := c.Repositories.ListBranches(c.Ctx, owner, repo, opts)
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to list branches of %s/%s.\n<?> Error: %w", owner, repo, err)
+		}
+
+		allBranches = append(allBranches, branches...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return allBranches, nil
+}
+
+// GetWorkflowFileContent retrieves the content of a workflow file.
+//
+// Parameters:
+//   - owner: Repository owner (username or organization)
+//   - repo: Repository name
+//   - path: workflow file path (e.g., ".github/workflows/deploy.yml")
+//   - ref: branch, tag or commit to read the file at (the default branch if empty)
+//
+// Returns an error if:
+//   - The file doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	content, err := client.GetWorkflowFileContent("owner", "repo", ".github/workflows/deploy.yml", "main")
+func (c *Client) GetWorkflowFileContent(owner, repo, path, ref string) (string, error) {
+	fileContent, _, _, err := c.Repositories.GetContents(
+		c.Ctx,
+		owner,
+		repo,
+		path,
+		&github.RepositoryContentGetOptions{Ref: ref},
+	)
+
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to get workflow file %s.\n<?> Error: %w", path, err)
+	}
+
+	if fileContent == nil {
+		return "", fmt.Errorf("<?> Error: %s is not a file", path)
+	}
+
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to decode workflow file %s.\n<?> Error: %w", path, err)
+	}
+
+	return content, nil
+}
+
 // ListWorkflowsWithDispatchOnly lists only the workflows that contains workflow_dispatch trigger.
 //
 // Parameters:
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"time"
+
+	"github.com/google/go-github/v57/github"
+)
+
+// ReviewerNames is a helper function that names the required reviewers of an environment (users by login, teams as org/slug).
+//
+// Parameters:
+//   - owner: repository owner (organization of the teams)
+//   - reviewers: required reviewers
+//
+// Example:
+// names := helpers.ReviewerNames("ignorant05", deployment.Reviewers) // ["octocat", "ignorant05/release-managers"]
+func ReviewerNames(owner string, reviewers []*github.RequiredReviewer) []string {
+	names := make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		if reviewer == nil {
+			continue
+		}
+
+		switch r := reviewer.Reviewer.(type) {
+		case *github.User:
+			names = append(names, r.GetLogin())
+		case *github.Team:
+			names = append(names, owner+"/"+r.GetSlug())
+		}
+	}
+
+	return names
+}
+
+// WaitingSince is a helper function that tells when a run started waiting for a deployment review.
+// NOTE: without a wait timer, the last update of the run is when it started waiting
+//
+// Parameters:
+//   - run: waiting run
+//   - timerStartedAt: start of the wait timer of the environment (optional)
+//
+// Example:
+// since := helpers.WaitingSince(run, deployment.WaitTimerStartedAt)
+func WaitingSince(run *github.WorkflowRun, timerStartedAt *github.Timestamp) time.Time {
+	if timerStartedAt != nil && !timerStartedAt.IsZero() {
+		return timerStartedAt.Time
+	}
+
+	return run.GetUpdatedAt().Time
+}
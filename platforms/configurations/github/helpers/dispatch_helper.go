@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/google/go-github/v57/github"
+)
+
+// NewCorrelationID is a helper function that generates a unique ID to find a dispatched run.
+//
+// Parameters:
+//   - None
+//
+// Example:
+// id := helpers.NewCorrelationID() // "uniflow-3f9a1c2b7d4e8f60"
+func NewCorrelationID() string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+
+	return "uniflow-" + hex.EncodeToString(buf)
+}
+
+// MatchDispatchedRun is a helper function that picks the run created by a dispatch.
+// NOTE: runs are expected to be filtered by event, branch, actor and creation time already,
+// the earliest new run is picked (later ones belong to later dispatches).
+// Without correlation ID, the run must also be of the dispatched ref and commit
+//
+// Parameters:
+//   - runs: candidate runs
+//   - known: IDs of the runs existing before the dispatch
+//   - correlationID: correlation ID injected in the inputs (optional, matched against the run title)
+//   - ref: dispatched branch or tag (optional)
+//   - headSHA: commit the ref pointed to when dispatched (optional)
+//
+// Example:
+// run := helpers.MatchDispatchedRun(runs, known, "uniflow-3f9a1c2b7d4e8f60", "main", "")
+func MatchDispatchedRun(runs []*github.WorkflowRun, known map[int64]bool, correlationID, ref, headSHA string) *github.WorkflowRun {
+	var match *github.WorkflowRun
+
+	for _, run := range runs {
+		if known[run.GetID()] {
+			continue
+		}
+
+		if correlationID != "" && !strings.Contains(run.GetDisplayTitle(), correlationID) && !strings.Contains(run.GetName(), correlationID) {
+			continue
+		}
+
+		if correlationID == "" && ((ref != "" && run.GetHeadBranch() != ref) || (headSHA != "" && run.GetHeadSHA() != headSHA)) {
+			continue
+		}
+
+		createdAt := run.GetCreatedAt().Time
+		if match == nil || createdAt.Before(match.GetCreatedAt().Time) || (createdAt.Equal(match.GetCreatedAt().Time) && run.GetID() < match.GetID()) {
+			match = run
+		}
+	}
+
+	return match
+}
@@ -0,0 +1,257 @@
+package helpers
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/types"
+	"go.yaml.in/yaml/v3"
+)
+
+// InputsError lists every problem found in workflow_dispatch inputs
+type InputsError struct {
+	Workflow string
+	Unknown  []string
+	Invalid  []string
+	Missing  []*types.WorkflowInput
+
+	// Available are the declared input names
+	Available []string
+}
+
+func (e *InputsError) Error() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Invalid inputs for workflow %s:", e.Workflow)
+
+	for _, name := range e.Unknown {
+		fmt.Fprintf(&sb, "\n   - unknown input %q (available: %s)", name, strings.Join(e.Available, ", "))
+	}
+
+	for _, msg := range e.Invalid {
+		fmt.Fprintf(&sb, "\n   - %s", msg)
+	}
+
+	if len(e.Missing) > 0 {
+		sb.WriteString("\n   Missing required inputs:")
+		for _, input := range e.Missing {
+			if input.Description != "" {
+				fmt.Fprintf(&sb, "\n   - %s: %s", input.Name, input.Description)
+			} else {
+				fmt.Fprintf(&sb, "\n   - %s", input.Name)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// ParseDispatchInputs is a helper function that parses the on.workflow_dispatch.inputs schema of a workflow file.
+//
+// Parameters:
+//   - content: workflow file content
+//
+// Return an error if:
+//   - invalid yaml
+//
+// Example:
+// inputs, hasDispatch, err := helpers.ParseDispatchInputs(content) // [{Name: "environment", Type: "choice", ...}], true, nil
+func ParseDispatchInputs(content string) ([]*types.WorkflowInput, bool, error) {
+	var workflow struct {
+		On yaml.Node `yaml:"on"`
+	}
+
+	if err := yaml.Unmarshal([]byte(content), &workflow); err != nil {
+		return nil, false, fmt.Errorf("<?> Error: Invalid workflow file.\n<?> Error: %w", err)
+	}
+
+	on := &workflow.On
+	switch on.Kind {
+	// on: workflow_dispatch
+	case yaml.ScalarNode:
+		return nil, on.Value == "workflow_dispatch", nil
+	// on: [push, workflow_dispatch]
+	case yaml.SequenceNode:
+		return nil, slices.ContainsFunc(on.Content, func(node *yaml.Node) bool { return node.Value == "workflow_dispatch" }), nil
+	case yaml.MappingNode:
+	default:
+		return nil, false, nil
+	}
+
+	var dispatch *yaml.Node
+	for idx := 0; idx+1 < len(on.Content); idx += 2 {
+		if on.Content[idx].Value == "workflow_dispatch" {
+			dispatch = on.Content[idx+1]
+		}
+	}
+
+	if dispatch == nil {
+		return nil, false, nil
+	}
+
+	var schema struct {
+		Inputs yaml.Node `yaml:"inputs"`
+	}
+
+	// "workflow_dispatch:" alone is a null node
+	if dispatch.Kind != yaml.MappingNode {
+		return nil, true, nil
+	}
+
+	if err := dispatch.Decode(&schema); err != nil {
+		return nil, true, fmt.Errorf("<?> Error: Invalid workflow_dispatch section.\n<?> Error: %w", err)
+	}
+
+	var inputs []*types.WorkflowInput
+	for idx := 0; idx+1 < len(schema.Inputs.Content); idx += 2 {
+		var declared struct {
+			Description string      `yaml:"description"`
+			Type        string      `yaml:"type"`
+			Required    bool        `yaml:"required"`
+			Default     interface{} `yaml:"default"`
+			Options     []string    `yaml:"options"`
+		}
+
+		if err := schema.Inputs.Content[idx+1].Decode(&declared); err != nil {
+			return nil, true, fmt.Errorf("<?> Error: Invalid input %s.\n<?> Error: %w", schema.Inputs.Content[idx].Value, err)
+		}
+
+		inputType := declared.Type
+		if inputType == "" {
+			inputType = "string"
+		}
+
+		inputs = append(inputs, &types.WorkflowInput{
+			Name:        schema.Inputs.Content[idx].Value,
+			Description: declared.Description,
+			Type:        inputType,
+			Required:    declared.Required,
+			Default:     declared.Default,
+			Options:     declared.Options,
+		})
+	}
+
+	return inputs, true, nil
+}
+
+// ValidateDispatchInputs is a helper function that validates inputs against the workflow schema.
+// NOTE: values are coerced to the input type and defaults are filled in
+//
+// Parameters:
+//   - workflow: workflow name (for errors)
+//   - schema: declared inputs
+//   - values: given inputs
+//
+// Return an error (*InputsError) if:
+//   - unknown inputs
+//   - values not matching the input type (or choice options)
+//   - missing required inputs
+//
+// Example:
+// inputs, err := helpers.ValidateDispatchInputs("deploy.yml", schema, map[string]interface{}{"dry_run": "true"}) // {"dry_run": true, ...}
+func ValidateDispatchInputs(workflow string, schema []*types.WorkflowInput, values map[string]interface{}) (map[string]interface{}, error) {
+	inputsErr := &InputsError{Workflow: workflow}
+	declared := make(map[string]*types.WorkflowInput, len(schema))
+
+	for _, input := range schema {
+		declared[input.Name] = input
+		inputsErr.Available = append(inputsErr.Available, input.Name)
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resolved := make(map[string]interface{}, len(schema))
+	for _, name := range names {
+		input, ok := declared[name]
+		if !ok {
+			inputsErr.Unknown = append(inputsErr.Unknown, name)
+			continue
+		}
+
+		value, err := CoerceInput(input, values[name])
+		if err != nil {
+			inputsErr.Invalid = append(inputsErr.Invalid, err.Error())
+			continue
+		}
+
+		resolved[name] = value
+	}
+
+	for _, input := range schema {
+		if _, ok := values[input.Name]; ok {
+			continue
+		}
+
+		if input.Default != nil {
+			value, err := CoerceInput(input, input.Default)
+			if err != nil {
+				inputsErr.Invalid = append(inputsErr.Invalid, "default of "+err.Error())
+				continue
+			}
+
+			resolved[input.Name] = value
+			continue
+		}
+
+		if input.Required {
+			inputsErr.Missing = append(inputsErr.Missing, input)
+		}
+	}
+
+	if len(inputsErr.Unknown) > 0 || len(inputsErr.Invalid) > 0 || len(inputsErr.Missing) > 0 {
+		return nil, inputsErr
+	}
+
+	return resolved, nil
+}
+
+// CoerceInput is a helper function that converts a value into the type of an input.
+//
+// Parameters:
+//   - input: declared input
+//   - value: raw value (string from the cli, typed from the workflow defaults)
+//
+// Return an error if:
+//   - the value doesn't match the input type (or choice options)
+//
+// Example:
+// val, err := helpers.CoerceInput(&types.WorkflowInput{Name: "replicas", Type: "number"}, "3") // 3
+func CoerceInput(input *types.WorkflowInput, value interface{}) (interface{}, error) {
+	str := fmt.Sprint(value)
+
+	switch input.Type {
+	case "boolean":
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return nil, fmt.Errorf("input %q must be a boolean (true/false), got %q", input.Name, str)
+		}
+
+		return b, nil
+	case "number":
+		if i, err := strconv.ParseInt(str, 10, 64); err == nil {
+			return i, nil
+		}
+
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return nil, fmt.Errorf("input %q must be a number, got %q", input.Name, str)
+		}
+
+		return f, nil
+	case "choice":
+		if !slices.Contains(input.Options, str) {
+			return nil, fmt.Errorf("input %q must be one of: %s, got %q", input.Name, strings.Join(input.Options, ", "), str)
+		}
+
+		return str, nil
+	default:
+		return str, nil
+	}
+}
@@ -0,0 +1,288 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v57/github"
+	internalHelpers "github.com/ignorant05/Uniflow/internal/helpers"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// LogCommand is a log line content with it's workflow command parsed
+type LogCommand struct {
+	// Content is the displayed content (the command itself stripped)
+	Content string
+
+	// Level is the level given by the command (empty if none)
+	Level string
+
+	// Marker is the group boundary (types.LOG_GROUP_START, types.LOG_GROUP_END or empty)
+	Marker string
+
+	// Annotation is set for error, warning and notice commands
+	Annotation *types.LogAnnotation
+}
+
+// ReadLogsFrom is a helper function that downloads the logs written after an offset.
+// NOTE: only the new bytes are requested (Range), servers ignoring it send the whole logs and the known prefix is skipped.
+// Logs shorter than the offset were replaced (eg: re-run job) and are read from the start.
+// At most maxSize bytes are read, truncated reports the logs go on (the rest is read from the returned start + content length)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: http client
+//   - logsURL: logs download url
+//   - offset: bytes already read
+//   - maxSize: maximum bytes read at once
+//
+// Return an error if:
+//   - the download fails
+//
+// Example:
+// content, start, truncated, err := helpers.ReadLogsFrom(ctx, http.DefaultClient, url, 1024, 10<<20) // "new lines\n", 1024, false, nil
+func ReadLogsFrom(ctx context.Context, client *http.Client, logsURL string, offset, maxSize int64) (string, int64, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logsURL, nil)
+	if err != nil {
+		return "", 0, false, fmt.Errorf("<?> Error: Failed to create logs request.\n<?> Error: %w", err)
+	}
+
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", 0, false, fmt.Errorf("<?> Error: Failed to download logs.\n<?> Error: %w", err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	start := offset
+
+	switch resp.StatusCode {
+	// nothing new since the offset
+	case http.StatusRequestedRangeNotSatisfiable:
+		return "", offset, false, nil
+	case http.StatusPartialContent:
+		if rangeStart, ok := contentRangeStart(resp.Header.Get("Content-Range")); ok {
+			start = rangeStart
+		}
+	case http.StatusOK:
+		skipped, err := io.CopyN(io.Discard, resp.Body, offset)
+		if err == io.EOF && skipped < offset {
+			return ReadLogsFrom(ctx, client, logsURL, 0, maxSize)
+		}
+
+		if err != nil {
+			return "", 0, false, fmt.Errorf("<?> Error: Failed to read logs.\n<?> Error: %w", err)
+		}
+	default:
+		return "", 0, false, fmt.Errorf("<?> Error: Failed to download logs data.\n<?> Error: Status Code: %d", resp.StatusCode)
+	}
+
+	// one more byte tells whether the logs go on
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return "", 0, false, fmt.Errorf("<?> Error: Failed to read logs.\n<?> Error: %w", err)
+	}
+
+	if int64(len(body)) > maxSize {
+		return string(body[:maxSize]), start, true, nil
+	}
+
+	return string(body), start, false, nil
+}
+
+// contentRangeStart parses the first byte of a Content-Range header (eg: "bytes 100-199/200")
+func contentRangeStart(header string) (int64, bool) {
+	rangeSpec, found := strings.CutPrefix(header, "bytes ")
+	if !found {
+		return 0, false
+	}
+
+	first, _, found := strings.Cut(rangeSpec, "-")
+	if !found {
+		return 0, false
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return start, true
+}
+
+// ParseLogCommand is a helper function that parses the workflow commands of a log line content.
+// NOTE: both the runner format ("##[group]Build") and the workflow commands format ("::group::Build") are parsed,
+// contents without commands are returned as is
+//
+// Parameters:
+//   - content: log line content (without timestamp)
+//
+// Example:
+// cmd := helpers.ParseLogCommand("::error file=main.go,line=3::undefined: foo") // {Content: "undefined: foo", Level: "error", Annotation: {File: "main.go", Line: 3}}
+func ParseLogCommand(content string) *LogCommand {
+	var name, props, message string
+
+	switch {
+	case strings.HasPrefix(content, "##["):
+		end := strings.Index(content, "]")
+		if end < 0 {
+			return &LogCommand{Content: content}
+		}
+
+		name, message = content[3:end], content[end+1:]
+	case strings.HasPrefix(content, "::"):
+		command, rest, found := strings.Cut(content[2:], "::")
+		if !found {
+			return &LogCommand{Content: content}
+		}
+
+		name, props, _ = strings.Cut(command, " ")
+		message = rest
+	default:
+		return &LogCommand{Content: content}
+	}
+
+	parsed := &LogCommand{Content: unescapeCommand(message)}
+
+	switch name {
+	case "group":
+		parsed.Marker = types.LOG_GROUP_START
+	case "endgroup":
+		parsed.Marker = types.LOG_GROUP_END
+	case "error", "warning", "notice":
+		parsed.Level = name
+		if name == "notice" {
+			parsed.Level = "info"
+		}
+
+		parsed.Annotation = parseAnnotation(name, props)
+	case "debug":
+		parsed.Level = "debug"
+	case "command":
+		parsed.Level = "info"
+	default:
+		// other commands (add-mask, set-output, ...) aren't displayable
+		return &LogCommand{Content: content}
+	}
+
+	return parsed
+}
+
+// parseAnnotation parses the properties of an annotation command (eg: "file=main.go,line=3,col=5,title=Build")
+func parseAnnotation(level, props string) *types.LogAnnotation {
+	annotation := &types.LogAnnotation{Level: level}
+
+	for _, prop := range strings.Split(props, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(prop), "=")
+		if !found {
+			continue
+		}
+
+		value = unescapeCommand(value)
+
+		switch key {
+		case "file":
+			annotation.File = value
+		case "line":
+			annotation.Line, _ = strconv.Atoi(value)
+		case "col":
+			annotation.Column, _ = strconv.Atoi(value)
+		case "title":
+			annotation.Title = value
+		}
+	}
+
+	return annotation
+}
+
+// unescapeCommand reverts the escaping of workflow commands
+func unescapeCommand(value string) string {
+	return strings.NewReplacer("%0D", "\r", "%0A", "\n", "%3A", ":", "%2C", ",", "%25", "%").Replace(value)
+}
+
+// StepAt is a helper function that finds the step running at a given time.
+// NOTE: steps timestamps only have a second precision, lines of consecutive steps logged within the same second are attributed to the later one
+//
+// Parameters:
+//   - steps: job steps (in execution order)
+//   - at: log line timestamp
+//
+// Example:
+// step := helpers.StepAt(job.Steps, line.Timestamp) // "Run tests"
+func StepAt(steps []*github.TaskStep, at time.Time) string {
+	name := ""
+	for _, step := range steps {
+		if step.StartedAt == nil || at.Before(step.StartedAt.Truncate(time.Second)) {
+			continue
+		}
+
+		name = step.GetName()
+	}
+
+	return name
+}
+
+// LogLineParser turns the raw lines of a job log into structured lines (keeping track of the open group)
+type LogLineParser struct {
+	JobName string
+
+	// JobID is the ID of the job (optional)
+	JobID int64
+
+	// Steps are the job steps, lines are attributed to them by timestamp (optional)
+	Steps []*github.TaskStep
+
+	group string
+}
+
+// Parse parses a raw job log line ("<RFC3339 timestamp> <content>").
+//
+// Parameters:
+//   - raw: raw log line
+//
+// Example:
+// parser := &helpers.LogLineParser{JobName: "build"}
+// line := parser.Parse("2024-01-01T10:00:00.0000000Z ##[group]Run tests")
+func (p *LogLineParser) Parse(raw string) *types.LogLine {
+	timestamp, content := internalHelpers.SplitLogLine(strings.TrimPrefix(strings.TrimSuffix(raw, "\r"), "\ufeff"))
+	command := ParseLogCommand(content)
+
+	line := &types.LogLine{
+		Content:    command.Content,
+		Timestamp:  timestamp,
+		JobName:    p.JobName,
+		JobID:      p.JobID,
+		Level:      command.Level,
+		Step:       StepAt(p.Steps, timestamp),
+		Group:      p.group,
+		Marker:     command.Marker,
+		Annotation: command.Annotation,
+	}
+
+	if line.Level == "" {
+		line.Level = internalHelpers.DetectLevel(command.Content)
+	}
+
+	switch command.Marker {
+	case types.LOG_GROUP_START:
+		line.Group = command.Content
+		p.group = command.Content
+	case types.LOG_GROUP_END:
+		p.group = ""
+	}
+
+	return line
+}
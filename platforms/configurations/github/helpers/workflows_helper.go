@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-github/v57/github"
+)
+
+// MatchWorkflow is a helper function that finds the workflow designated by its path or its file name.
+// NOTE: a file name shared by several workflows is ambiguous, nil is returned when nothing matches
+//
+// Parameters:
+//   - workflows: workflows of the repository
+//   - name: workflow path (eg: ".github/workflows/deploy.yml") or file name (eg: "deploy.yml")
+//
+// Errors possible causes:
+//   - several workflows have this file name
+//
+// Example:
+// workflow, err := helpers.MatchWorkflow(workflows, "deploy.yml")
+func MatchWorkflow(workflows []*github.Workflow, name string) (*github.Workflow, error) {
+	var matches []*github.Workflow
+
+	for _, wf := range workflows {
+		if wf.GetPath() == name {
+			return wf, nil
+		}
+
+		if path.Base(wf.GetPath()) == name {
+			matches = append(matches, wf)
+		}
+	}
+
+	if len(matches) > 1 {
+		paths := make([]string, len(matches))
+		for idx, wf := range matches {
+			paths[idx] = wf.GetPath()
+		}
+
+		return nil, fmt.Errorf("<?> Error: Workflow %s is ambiguous.\n</> Info: Use one of: %s", name, strings.Join(paths, ", "))
+	}
+
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	return matches[0], nil
+}
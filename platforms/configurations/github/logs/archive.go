@@ -0,0 +1,412 @@
+package github
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// stepFilePattern matches the "<number>_<name>.txt" files of a logs archive
+var stepFilePattern = regexp.MustCompile(`^(\d+)_(.*)\.txt$`)
+
+// ArchiveIndex lists the jobs and steps of a logs archive (index.json of an extracted archive)
+type ArchiveIndex struct {
+	Jobs []*ArchiveJob `json:"jobs"`
+}
+
+// ArchiveJob is a job of a logs archive
+type ArchiveJob struct {
+	Name  string         `json:"name"`
+	Steps []*ArchiveStep `json:"steps"`
+}
+
+// ArchiveStep is a step of a logs archive
+// NOTE: a job without step files has a single step, with it's whole log and no name
+type ArchiveStep struct {
+	Number int    `json:"number"`
+	Name   string `json:"name"`
+	Lines  int    `json:"lines"`
+
+	// Path is the step file (inside the zip, or relative to the extracted directory)
+	Path string `json:"path"`
+}
+
+// Archive is an opened logs archive (a zip downloaded from GitHub, or an extracted directory)
+type Archive struct {
+	Index *ArchiveIndex
+
+	zip *zip.ReadCloser
+	dir string
+}
+
+// OpenArchive opens a logs archive.
+//
+// Parameters:
+//   - archivePath: logs zip, or directory extracted with ExtractArchive
+//
+// Errors possible causes:
+//   - archive not found
+//   - not a zip nor an extracted directory (no index.json)
+//
+// Example:
+// archive, err := OpenArchive("~/.uniflow/logs/deploy.zip")
+func OpenArchive(archivePath string) (*Archive, error) {
+	archivePath = ExpandHome(archivePath)
+
+	info, err := os.Stat(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to open logs archive.\n<?> Error: %w", err)
+	}
+
+	if info.IsDir() {
+		data, err := os.ReadFile(filepath.Join(archivePath, constants.ARCHIVE_INDEX_FILE_NAME))
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Not an extracted logs archive (no %s).\n<?> Error: %w", constants.ARCHIVE_INDEX_FILE_NAME, err)
+		}
+
+		var index ArchiveIndex
+		if err := json.Unmarshal(data, &index); err != nil {
+			return nil, fmt.Errorf("<?> Error: Invalid logs archive index.\n<?> Error: %w", err)
+		}
+
+		return &Archive{Index: &index, dir: archivePath}, nil
+	}
+
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to parse logs data.\n<?> Error: %w", err)
+	}
+
+	archive := &Archive{zip: reader}
+	if archive.Index, err = archive.indexZip(); err != nil {
+		_ = reader.Close()
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// indexZip lists the jobs and steps of a GitHub logs zip ("<job>/<number>_<step>.txt", "<number>_<job>.txt" for whole jobs)
+func (a *Archive) indexZip() (*ArchiveIndex, error) {
+	jobs := make(map[string]*ArchiveJob)
+	var order []string
+
+	job := func(name string) *ArchiveJob {
+		if _, ok := jobs[name]; !ok {
+			jobs[name] = &ArchiveJob{Name: name}
+			order = append(order, name)
+		}
+
+		return jobs[name]
+	}
+
+	// whole job logs, only used for jobs without step files
+	whole := make(map[string]*ArchiveStep)
+
+	// the whole job logs are numbered in the jobs order
+	numbers := make(map[string]int)
+
+	for _, file := range a.zip.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		dir, base := path.Split(file.Name)
+		match := stepFilePattern.FindStringSubmatch(base)
+		if match == nil {
+			continue
+		}
+
+		number, _ := strconv.Atoi(match[1])
+		lines, err := a.countLines(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if dir == "" {
+			job(match[2])
+			whole[match[2]] = &ArchiveStep{Number: 0, Lines: lines, Path: file.Name}
+			numbers[match[2]] = number
+			continue
+		}
+
+		j := job(strings.TrimSuffix(dir, "/"))
+		j.Steps = append(j.Steps, &ArchiveStep{Number: number, Name: match[2], Lines: lines, Path: file.Name})
+	}
+
+	// the zip entries aren't sorted, jobs without a whole log stay last
+	sort.SliceStable(order, func(i, k int) bool {
+		ni, iok := numbers[order[i]]
+		nk, kok := numbers[order[k]]
+		if iok != kok {
+			return iok
+		}
+
+		return ni < nk
+	})
+
+	index := &ArchiveIndex{}
+	for _, name := range order {
+		j := jobs[name]
+		if len(j.Steps) == 0 {
+			j.Steps = []*ArchiveStep{whole[name]}
+		}
+
+		sort.Slice(j.Steps, func(i, k int) bool { return j.Steps[i].Number < j.Steps[k].Number })
+		index.Jobs = append(index.Jobs, j)
+	}
+
+	return index, nil
+}
+
+// countLines counts the lines of a zipped file
+func (a *Archive) countLines(file *zip.File) (int, error) {
+	content, err := a.readZipped(file)
+	if err != nil {
+		return 0, err
+	}
+
+	return strings.Count(strings.TrimSuffix(content, "\n"), "\n") + 1, nil
+}
+
+// readZipped reads a zipped file (at most DATA_LOGS_MAX_SIZE)
+func (a *Archive) readZipped(file *zip.File) (string, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to read %s.\n<?> Error: %w", file.Name, err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close %s: %v", file.Name, err)
+		}
+	}()
+
+	content, err := io.ReadAll(io.LimitReader(reader, constants.DATA_LOGS_MAX_SIZE))
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to read %s.\n<?> Error: %w", file.Name, err)
+	}
+
+	return string(content), nil
+}
+
+// Close closes the archive
+func (a *Archive) Close() error {
+	if a.zip == nil {
+		return nil
+	}
+
+	return a.zip.Close()
+}
+
+// Find finds a job and a step by name (case insensitive, a step name may be partial).
+//
+// Parameters:
+//   - job: job name
+//   - step: step name (optional, the first step if empty)
+//
+// Errors possible causes:
+//   - no such job or step
+//
+// Example:
+// job, step, err := archive.Find("build", "Run tests")
+func (a *Archive) Find(job, step string) (*ArchiveJob, *ArchiveStep, error) {
+	for _, j := range a.Index.Jobs {
+		if !strings.EqualFold(j.Name, job) {
+			continue
+		}
+
+		// exact names win over partial ones
+		for _, s := range j.Steps {
+			if step == "" || strings.EqualFold(s.Name, step) {
+				return j, s, nil
+			}
+		}
+
+		for _, s := range j.Steps {
+			if strings.Contains(strings.ToLower(s.Name), strings.ToLower(step)) {
+				return j, s, nil
+			}
+		}
+
+		return nil, nil, fmt.Errorf("<?> Error: No step matching %q in job %s", step, j.Name)
+	}
+
+	return nil, nil, fmt.Errorf("<?> Error: No job named %q in the archive", job)
+}
+
+// Read reads the raw log of a step.
+//
+// Parameters:
+//   - step: archive step
+//
+// Errors possible causes:
+//   - step file missing or unreadable
+//   - step path outside the extracted directory (edited index.json)
+//
+// Example:
+// content, err := archive.Read(step)
+func (a *Archive) Read(step *ArchiveStep) (string, error) {
+	if a.zip == nil {
+		root, err := filepath.Abs(a.dir)
+		if err != nil {
+			return "", err
+		}
+
+		target := filepath.Join(root, filepath.FromSlash(step.Path))
+		if !strings.HasPrefix(target, root+string(os.PathSeparator)) {
+			return "", fmt.Errorf("<?> Error: Invalid step path: %s", step.Path)
+		}
+
+		content, err := os.ReadFile(target)
+		if err != nil {
+			return "", fmt.Errorf("<?> Error: Failed to read %s.\n<?> Error: %w", step.Path, err)
+		}
+
+		return string(content), nil
+	}
+
+	for _, file := range a.zip.File {
+		if file.Name == step.Path {
+			return a.readZipped(file)
+		}
+	}
+
+	return "", fmt.Errorf("<?> Error: No file %s in the archive", step.Path)
+}
+
+// Lines reads the structured log lines of a step (timestamps, levels, groups and annotations parsed).
+//
+// Parameters:
+//   - job: archive job
+//   - step: archive step (of the job)
+//
+// Example:
+// lines, err := archive.Lines(job, step)
+func (a *Archive) Lines(job *ArchiveJob, step *ArchiveStep) ([]*types.LogLine, error) {
+	content, err := a.Read(step)
+	if err != nil {
+		return nil, err
+	}
+
+	if content == "" {
+		return nil, nil
+	}
+
+	parser := &helpers.LogLineParser{JobName: job.Name}
+	var lines []*types.LogLine
+	for _, raw := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+		line := parser.Parse(raw)
+		line.Step = step.Name
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// ExtractArchive unpacks a logs zip into a directory, one file per step ("<job>/<number>_<step>.log"), with an index.json.
+//
+// Parameters:
+//   - zipPath: logs zip
+//   - dir: destination directory
+//
+// Errors possible causes:
+//   - invalid zip
+//   - destination not writable
+//
+// Example:
+// index, err := ExtractArchive("deploy.zip", "deploy")
+func ExtractArchive(zipPath, dir string) (*ArchiveIndex, error) {
+	archive, err := OpenArchive(zipPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := archive.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close logs archive: %v", err)
+		}
+	}()
+
+	index := &ArchiveIndex{}
+	for _, job := range archive.Index.Jobs {
+		extracted := &ArchiveJob{Name: job.Name}
+		jobDir := safeFileName(job.Name)
+
+		if err := os.MkdirAll(filepath.Join(dir, jobDir), 0755); err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to create logs directory.\n<?> Error: %w", err)
+		}
+
+		for _, step := range job.Steps {
+			content, err := archive.Read(step)
+			if err != nil {
+				return nil, err
+			}
+
+			name := "job.log"
+			if step.Name != "" {
+				name = fmt.Sprintf("%02d_%s.log", step.Number, safeFileName(step.Name))
+			}
+
+			if err := os.WriteFile(filepath.Join(dir, jobDir, name), []byte(content), 0644); err != nil {
+				return nil, fmt.Errorf("<?> Error: Failed to write logs data: %w", err)
+			}
+
+			extracted.Steps = append(extracted.Steps, &ArchiveStep{Number: step.Number, Name: step.Name, Lines: step.Lines, Path: jobDir + "/" + name})
+		}
+
+		index.Jobs = append(index.Jobs, extracted)
+	}
+
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, constants.ARCHIVE_INDEX_FILE_NAME), data, 0644); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to write logs index: %w", err)
+	}
+
+	return index, nil
+}
+
+// safeFileName replaces the characters that can't be part of a file name
+func safeFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '-'
+		}
+		return r
+	}, name)
+
+	if name == "." || name == ".." || name == "" {
+		return "_"
+	}
+
+	return name
+}
+
+// ExpandHome expands a leading "~/" to the home directory
+func ExpandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, path[2:])
+}
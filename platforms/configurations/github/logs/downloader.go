@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -22,66 +23,30 @@ var DefaultClient = &http.Client{
 	Timeout: 30 * time.Second,
 }
 
-// readLogs reads and downloads logs
+// DownloadLogs downloads the logs archive of a run
+// NOTE: relative file names are saved under ~/.uniflow/logs
 //
 // Parameters :
 //   - logsUrl: logs url
+//   - downloadFileName: archive file name or path (default: logs.zip)
 //
-// Errors possible causes:
-//   - invalid url
-//   - internal error
-//
-// Example:
-// body, err := s.readLogs(logsUrl)
-func (s *Streamer) readLogs(logsUrl string) (string, error) {
-	if logsUrl == "" {
-		return "", fmt.Errorf("<?> Error: Invalid URL")
-	}
-
-	resp, err := http.Get(logsUrl)
-	if err != nil {
-		return "", fmt.Errorf("<?> Error: Failed to download logs from urls: %s\n<?> Error: %w", logsUrl, err)
-	}
-
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			fmt.Printf("<!> warning: Failed to close response body: %v", err)
-		}
-	}()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("<?> Error: Failed to download logs data.\n<?> Error: Status Code: %d", resp.StatusCode)
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("<?> Error: Failed to read logs.\n<?> Error: %w\b", err)
-	}
-
-	return string(body), nil
-}
-
-// DownloadLogs reads and downloads logs
-//
-// Parameters :
-//   - logsUrl: logs url
+// Returns the path of the archive and its size.
 //
 // Errors possible causes:
 //   - invalid url
 //   - internal error
 //
 // Example:
-// body, err := DownloadLogs(logsUrl)
-func DownloadLogs(logsUrl, downloadFileName string) error {
+// path, size, err := DownloadLogs(logsUrl, "deploy")
+func DownloadLogs(logsUrl, downloadFileName string) (string, int64, error) {
 	if logsUrl == "" {
-		return fmt.Errorf("<?> Error: Invalid URL")
+		return "", 0, fmt.Errorf("<?> Error: Invalid URL")
 	}
 
 	path := constants.DEFAULT_DOWNLOAD_DIR_PATH + "/" + constants.DEFAULT_DOWNLOAD_FILE_NAME
 
 	if downloadFileName != "" {
-		if strings.HasPrefix(downloadFileName, "~/") ||
-			strings.HasPrefix(downloadFileName, "/home/") {
+		if strings.HasPrefix(downloadFileName, "~/") || filepath.IsAbs(downloadFileName) {
 			path = downloadFileName
 		} else {
 			path = constants.DEFAULT_DOWNLOAD_DIR_PATH + "/" + downloadFileName
@@ -91,19 +56,65 @@ func DownloadLogs(logsUrl, downloadFileName string) error {
 	if !strings.HasSuffix(path, ".zip") {
 		path += ".zip"
 	}
+	path = ExpandHome(path)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", 0, fmt.Errorf("<?> Error: Failed to create logs directory.\n<?> Error: %w", err)
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, "GET", logsUrl, nil)
+	bytesWritten, err := DownloadZip(ctx, DefaultClient, logsUrl, path, constants.DATA_LOGS_MAX_SIZE, nil)
 	if err != nil {
-		return fmt.Errorf("<?> Error: Create request: %w", err)
+		return "", 0, err
+	}
+
+	return path, bytesWritten, nil
+}
+
+// progressWriter reports the bytes written so far
+type progressWriter struct {
+	written  int64
+	progress func(written int64)
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.written += int64(len(p))
+	w.progress(w.written)
+
+	return len(p), nil
+}
+
+// DownloadZip downloads a zip archive (from a signed url, once the api redirect is resolved) and verifies it
+//
+// Parameters :
+//   - ctx: the context variable (cancelling it stops the download)
+//   - client: http client
+//   - zipUrl: archive url
+//   - path: archive file
+//   - maxSize: maximum archive size, in bytes
+//   - progress: reports the downloaded bytes (optional)
+//
+// Returns the size of the archive.
+//
+// Errors possible causes:
+//   - invalid url
+//   - archive bigger than maxSize
+//   - not a valid zip
+//
+// Example:
+// size, err := DownloadZip(ctx, DefaultClient, zipUrl, "dist.zip", constants.ARTIFACT_MAX_SIZE, nil)
+func DownloadZip(ctx context.Context, client *http.Client, zipUrl, path string, maxSize int64, progress func(written int64)) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", zipUrl, nil)
+	if err != nil {
+		return 0, fmt.Errorf("<?> Error: Create request: %w", err)
 	}
 	req.Header.Set("User-Agent", "Uniflow-CLI")
 
-	resp, err := DefaultClient.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("<?> Error: Download: %w", err)
+		return 0, fmt.Errorf("<?> Error: Download: %w", err)
 	}
 
 	defer func() {
@@ -113,12 +124,12 @@ func DownloadLogs(logsUrl, downloadFileName string) error {
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("<?> Error: Failed to download logs data.\n<?> Error: Status Code: %d", resp.StatusCode)
+		return 0, fmt.Errorf("<?> Error: Failed to download data.\n<?> Error: Status Code: %d", resp.StatusCode)
 	}
 
 	file, err := os.Create(path)
 	if err != nil {
-		return fmt.Errorf("<?> Error: Create output file: %w", err)
+		return 0, fmt.Errorf("<?> Error: Create output file: %w", err)
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
@@ -126,24 +137,109 @@ func DownloadLogs(logsUrl, downloadFileName string) error {
 		}
 	}()
 
-	limitedReader := io.LimitReader(resp.Body, constants.DATA_LOGS_MAX_SIZE)
+	var dst io.Writer = file
+	if progress != nil {
+		dst = io.MultiWriter(file, &progressWriter{progress: progress})
+	}
+
+	limitedReader := io.LimitReader(resp.Body, maxSize)
 
-	bytesWritten, err := io.Copy(file, limitedReader)
+	bytesWritten, err := io.Copy(dst, limitedReader)
 	if err != nil {
-		return fmt.Errorf("<?> Error: Failed to write logs data: %w", err)
+		return 0, fmt.Errorf("<?> Error: Failed to write data: %w", err)
 	}
 
 	// Verify that the zip file is valid
 	_, err = file.Seek(0, 0)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	_, err = zip.NewReader(file, bytesWritten)
 	if err != nil {
-		return fmt.Errorf("<?> Error: Failed to parse logs data.\n<?> Error: %w", err)
+		return 0, fmt.Errorf("<?> Error: Failed to parse zip data.\n<?> Error: %w", err)
+	}
+
+	return bytesWritten, nil
+}
+
+// ExtractZip unpacks a zip archive into a directory
+// NOTE: entries escaping the directory (eg: "../x") are rejected
+//
+// Parameters :
+//   - zipPath: zip archive
+//   - dir: destination directory
+//
+// Returns the number of extracted files.
+//
+// Errors possible causes:
+//   - invalid zip
+//   - destination not writable
+//
+// Example:
+// files, err := ExtractZip("dist.zip", "dist")
+func ExtractZip(zipPath, dir string) (int, error) {
+	reader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return 0, fmt.Errorf("<?> Error: Failed to parse zip data.\n<?> Error: %w", err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close zip archive: %v", err)
+		}
+	}()
+
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return 0, err
+	}
+
+	files := 0
+	for _, entry := range reader.File {
+		target := filepath.Join(root, filepath.FromSlash(entry.Name))
+		if target != root && !strings.HasPrefix(target, root+string(os.PathSeparator)) {
+			return files, fmt.Errorf("<?> Error: Invalid zip entry: %s", entry.Name)
+		}
+
+		if entry.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return files, fmt.Errorf("<?> Error: Failed to create directory.\n<?> Error: %w", err)
+			}
+			continue
+		}
+
+		if err := extractZipFile(entry, target); err != nil {
+			return files, err
+		}
+		files++
+	}
+
+	return files, nil
+}
+
+// extractZipFile writes a zip entry to target
+func extractZipFile(entry *zip.File, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("<?> Error: Failed to create directory.\n<?> Error: %w", err)
+	}
+
+	src, err := entry.Open()
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to read zip entry %s.\n<?> Error: %w", entry.Name, err)
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, entry.Mode().Perm()|0600)
+	if err != nil {
+		return fmt.Errorf("<?> Error: Create output file: %w", err)
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return fmt.Errorf("<?> Error: Failed to write %s: %w", target, err)
 	}
 
-	fmt.Printf("✓ Downloaded %d KB of logs to %s\n\n", bytesWritten/1024, downloadFileName)
-	return nil
+	return dst.Close()
 }
@@ -0,0 +1,45 @@
+package constants
+
+import "time"
+
+// Default values
+const (
+	// GITLAB_TOKEN_ENV_VAR_NAME represents the gitlab token name in env
+	GITLAB_TOKEN_ENV_VAR_NAME = "GITLAB_TOKEN"
+
+	// DEFAULT_BASE_URL is gitlab.com api base url
+	DEFAULT_BASE_URL = "https://gitlab.com"
+
+	// API_PATH is the api prefix appended to the base url
+	API_PATH = "api/v4/"
+
+	// TOKEN_HEADER is the header holding the personal access token
+	TOKEN_HEADER = "PRIVATE-TOKEN"
+
+	// DEFAULT_CI_CONFIG_PATH is the default pipeline definition file
+	DEFAULT_CI_CONFIG_PATH = ".gitlab-ci.yml"
+
+	// DEFAULT_TIMEOUT is the http client timeout
+	DEFAULT_TIMEOUT = 30 * time.Second
+
+	// DEBUG_TRACE_VARIABLE is the variable enabling the debug logging of a pipeline
+	DEBUG_TRACE_VARIABLE = "CI_DEBUG_TRACE"
+)
+
+// Default rate limiting configuration.
+const (
+	// Default rate limiting
+	DEFAULT_PER_PAGE = 100
+)
+
+// Polling configuration
+const (
+	// TracePollInterval is the interval between two job trace requests
+	TracePollInterval = 3 * time.Second
+)
+
+// Repository detection
+var (
+	// DETECTION_PATHS are the files/directories (suffixed with "/") identifying a gitlab ci repository
+	DETECTION_PATHS = []string{".gitlab-ci.yml"}
+)
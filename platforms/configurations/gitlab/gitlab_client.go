@@ -0,0 +1,227 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab/constants"
+	platformConstants "github.com/ignorant05/Uniflow/platforms/constants"
+)
+
+type Client struct {
+	HTTPClient *http.Client
+	BaseURL    *url.URL
+	Ctx        context.Context
+	Config     *Config
+}
+
+// NewClient creates new client from configuration.
+//
+// Parameters:
+//   - ctx: context
+//   - cfg: user's gitlab configuration
+//
+// Returns an error if:
+//   - no token configured (nor in env)
+//   - invalid base url (self-managed instances)
+//
+// Example:
+//
+//	client, err := NewClient(context.Background(), cfg)
+func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
+	if cfg.Token == "" {
+		cfg.Token = os.Getenv(constants.GITLAB_TOKEN_ENV_VAR_NAME)
+		if cfg.Token == "" {
+			return nil, fmt.Errorf("<?> Error: No environment variable named %s found.\n<.> Please verify your ~/.zshrc (or ~/.bashrc) file", constants.GITLAB_TOKEN_ENV_VAR_NAME)
+		}
+	}
+
+	rawURL := cfg.BaseURL
+	if rawURL == "" {
+		rawURL = constants.DEFAULT_BASE_URL
+	}
+
+	// both "https://gitlab.company.com" and "https://gitlab.company.com/api/v4" are accepted
+	rawURL = strings.TrimSuffix(strings.TrimSuffix(rawURL, "/"), "/"+strings.TrimSuffix(constants.API_PATH, "/"))
+
+	baseURL, err := url.Parse(rawURL + "/" + constants.API_PATH)
+	if err != nil || baseURL.Host == "" {
+		return nil, fmt.Errorf("<?> Error: Invalid gitlab base URL: %s", cfg.BaseURL)
+	}
+
+	return &Client{
+		HTTPClient: &http.Client{Timeout: constants.DEFAULT_TIMEOUT},
+		BaseURL:    baseURL,
+		Ctx:        ctx,
+		Config:     cfg,
+	}, nil
+}
+
+// NewClientFromProfile creates new client from profile configuration.
+//
+// Parameters:
+//   - ctx: context
+//   - profile: user's profile configuration
+//
+// Returns an error if:
+//   - gitlab isn't configured for this profile
+//   - gitlab client creation failure
+//
+// Example:
+//
+//	client, err := NewClientFromProfile(context.Background(), profile)
+func NewClientFromProfile(ctx context.Context, profile *config.Profile) (*Client, error) {
+	section, err := config.DecodeSection[Config](profile.RawSection(platformConstants.GITLAB_PLATFORM))
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid gitlab configuration.\n<?> Error: %w", err)
+	}
+
+	if section == nil {
+		return nil, fmt.Errorf("<?> Error: Gitlab isn't configured for this profile")
+	}
+
+	return NewClient(ctx, section.(*Config))
+}
+
+// GetDefaultProject retrieves the project (path or ID) configured for the current profile.
+//
+// Parameters:
+//   - None
+//
+// Returns an error if:
+//   - no project is configured
+//
+// Example:
+//
+//	project, err := client.GetDefaultProject()
+func (c *Client) GetDefaultProject() (string, error) {
+	if c.Config.Project == "" {
+		return "", fmt.Errorf("<?> Error: No default project configured")
+	}
+
+	return c.Config.Project, nil
+}
+
+// newRequest creates an authenticated request, the body (if any) is json encoded
+func (c *Client) newRequest(method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid path: %s.\n<?> Error: %w", path, err)
+	}
+
+	u := c.BaseURL.ResolveReference(ref)
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to encode request body.\n<?> Error: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(c.Ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "Uniflow-CLI")
+	req.Header.Set(constants.TOKEN_HEADER, c.Config.Token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// do sends the request and converts non 2xx responses into errors
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Request to %s failed.\n<?> Error: %w", req.URL.Path, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Printf("<!> warning: Failed to close response body: %v", err)
+			}
+		}()
+
+		var apiErr struct {
+			Message interface{} `json:"message"`
+			Error   string      `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+
+		message := apiErr.Error
+		if apiErr.Message != nil {
+			message = fmt.Sprint(apiErr.Message)
+		}
+
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Method:     req.Method,
+			Path:       req.URL.Path,
+			Message:    message,
+		}
+	}
+
+	return resp, nil
+}
+
+// doJSON sends the request and decodes the json response into out (if not nil)
+func (c *Client) doJSON(method, path string, query url.Values, body, out interface{}) error {
+	req, err := c.newRequest(method, path, query, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("<?> Error: Failed to decode response of %s.\n<?> Error: %w", path, err)
+	}
+
+	return nil
+}
+
+// APIError represents a non successful gitlab api response
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("<?> Error: %s %s returned status code: %d (%s)", e.Method, e.Path, e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("<?> Error: %s %s returned status code: %d", e.Method, e.Path, e.StatusCode)
+}
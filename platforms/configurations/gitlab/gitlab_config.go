@@ -0,0 +1,59 @@
+package gitlab
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+)
+
+// Config is the gitlab section of a profile
+type Config struct {
+	Token   string `yaml:"token" mapstructure:"token"`
+	BaseURL string `yaml:"base_url,omitempty" mapstructure:"base_url"`
+
+	// Project path ("group/subgroup/project") or numeric ID
+	Project string `yaml:"project,omitempty" mapstructure:"project"`
+}
+
+// ValidateConfig validates gitlab conf
+//
+// Parameters:
+//   - prefix: prefix string
+//   - cfg: gitlab configuration struct
+//
+// Error possible causes:
+//   - gitlab token is not sat
+//   - invalid url (self-managed instances)
+//   - project is not sat
+//
+// Examples:
+// errs := ValidateConfig(prefix, cfg)
+func ValidateConfig(prefix string, cfg *Config) []error {
+	var errors []error
+
+	if cfg.Token == "" || strings.HasPrefix(cfg.Token, "${") {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".token",
+			Message: "<?> Error: Token is required (set via environment variable or directly)",
+		})
+	}
+
+	if cfg.BaseURL != "" {
+		if u, err := url.Parse(cfg.BaseURL); err != nil || u.Scheme == "" || u.Host == "" {
+			errors = append(errors, &config.ValidationError{
+				Field:   prefix + ".base_url",
+				Message: "<?> Error: Must be a valid URL",
+			})
+		}
+	}
+
+	if cfg.Project == "" {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".project",
+			Message: "<?> Error: Must be a project path ('group/project') or a numeric ID",
+		})
+	}
+
+	return errors
+}
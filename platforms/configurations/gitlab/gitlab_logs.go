@@ -0,0 +1,194 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab/helpers"
+)
+
+// GetJobTrace retrieves the full trace (log) of a job.
+//
+// Parameters:
+//   - project: project path or ID
+//   - jobID: job ID
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	trace, err := client.GetJobTrace("group/project", 6789)
+func (c *Client) GetJobTrace(project string, jobID int64) (string, error) {
+	req, err := c.newRequest(http.MethodGet, helpers.ProjectPath(project)+"jobs/"+strconv.FormatInt(jobID, 10)+"/trace", nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to get trace of job %d.\n<?> Error: %w", jobID, err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to read job trace.\n<?> Error: %w", err)
+	}
+
+	return string(body), nil
+}
+
+// GetJobTraceFrom retrieves the trace of a job written after an offset.
+// NOTE: only the new bytes are requested (Range), when the range is ignored the known prefix is skipped.
+// Traces shorter than the offset were replaced (eg: retried job) and are read from the start.
+//
+// Parameters:
+//   - project: project path or ID
+//   - jobID: job ID
+//   - offset: bytes already read
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	trace, start, err := client.GetJobTraceFrom("group/project", 6789, 1024) // "new lines\n", 1024, nil
+func (c *Client) GetJobTraceFrom(project string, jobID int64, offset int64) (string, int64, error) {
+	req, err := c.newRequest(http.MethodGet, helpers.ProjectPath(project)+"jobs/"+strconv.FormatInt(jobID, 10)+"/trace", nil, nil)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		// nothing new since the offset
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			return "", offset, nil
+		}
+
+		return "", 0, fmt.Errorf("<?> Error: Failed to get trace of job %d.\n<?> Error: %w", jobID, err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	start := offset
+	if resp.StatusCode == http.StatusPartialContent {
+		if rangeStart, ok := contentRangeStart(resp.Header.Get("Content-Range")); ok {
+			start = rangeStart
+		}
+	} else if offset > 0 {
+		skipped, err := io.CopyN(io.Discard, resp.Body, offset)
+		if err == io.EOF && skipped < offset {
+			return c.GetJobTraceFrom(project, jobID, 0)
+		}
+
+		if err != nil {
+			return "", 0, fmt.Errorf("<?> Error: Failed to read job trace.\n<?> Error: %w", err)
+		}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("<?> Error: Failed to read job trace.\n<?> Error: %w", err)
+	}
+
+	return string(body), start, nil
+}
+
+// contentRangeStart parses the first byte of a Content-Range header (eg: "bytes 100-199/200")
+func contentRangeStart(header string) (int64, bool) {
+	rangeSpec, found := strings.CutPrefix(header, "bytes ")
+	if !found {
+		return 0, false
+	}
+
+	first, _, found := strings.Cut(rangeSpec, "-")
+	if !found {
+		return 0, false
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return start, true
+}
+
+// DownloadJobTrace downloads the full trace (log) of a job into a file.
+//
+// Parameters:
+//   - project: project path or ID
+//   - jobID: job ID
+//   - path: destination file path
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The file can't be written
+//   - The API request fails
+//
+// Example:
+//
+//	written, err := client.DownloadJobTrace("group/project", 6789, "/tmp/job-6789.log")
+func (c *Client) DownloadJobTrace(project string, jobID int64, path string) (int64, error) {
+	req, err := c.newRequest(http.MethodGet, helpers.ProjectPath(project)+"jobs/"+strconv.FormatInt(jobID, 10)+"/trace", nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return 0, fmt.Errorf("<?> Error: Failed to download trace of job %d.\n<?> Error: %w", jobID, err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, fmt.Errorf("<?> Error: Failed to create logs directory.\n<?> Error: %w", err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("<?> Error: Create output file: %w", err)
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close file: %v", err)
+		}
+	}()
+
+	written, err := io.Copy(file, resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("<?> Error: Failed to write logs data: %w", err)
+	}
+
+	return written, nil
+}
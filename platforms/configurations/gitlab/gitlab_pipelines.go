@@ -0,0 +1,264 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab/helpers"
+)
+
+// GetProject retrieves a project by it's path or ID.
+//
+// Parameters:
+//   - project: project path or ID
+//
+// Returns an error if:
+//   - The project doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	project, err := client.GetProject("group/project")
+func (c *Client) GetProject(project string) (*Project, error) {
+	var p Project
+
+	if err := c.doJSON(http.MethodGet, strings.TrimSuffix(helpers.ProjectPath(project), "/"), nil, nil, &p); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get project %s.\n<?> Error: %w", project, err)
+	}
+
+	return &p, nil
+}
+
+// CreatePipeline creates a new pipeline on a ref, variables are passed as pipeline variables.
+//
+// Parameters:
+//   - project: project path or ID
+//   - ref: Git reference (branch or tag)
+//   - variables: pipeline variables as key-value pairs
+//
+// Returns an error if:
+//   - The ref doesn't exist
+//   - The pipeline definition is invalid
+//   - The API request fails
+//
+// Example:
+//
+//	pipeline, err := client.CreatePipeline("group/project", "main", map[string]string{
+//	  "ENVIRONMENT": "production",
+//	})
+func (c *Client) CreatePipeline(project, ref string, variables map[string]string) (*Pipeline, error) {
+	body := CreatePipelineRequest{Ref: ref}
+
+	keys := make([]string, 0, len(variables))
+	for key := range variables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		body.Variables = append(body.Variables, &PipelineVariable{
+			Key:          key,
+			Value:        variables[key],
+			VariableType: "env_var",
+		})
+	}
+
+	var pipeline Pipeline
+	if err := c.doJSON(http.MethodPost, helpers.ProjectPath(project)+"pipeline", nil, body, &pipeline); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to create pipeline.\n<?> Error: %w", err)
+	}
+
+	return &pipeline, nil
+}
+
+// GetPipeline retrieves a pipeline by it's ID.
+//
+// Parameters:
+//   - project: project path or ID
+//   - pipelineID: pipeline ID
+//
+// Returns an error if:
+//   - The pipeline doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	pipeline, err := client.GetPipeline("group/project", 12345)
+func (c *Client) GetPipeline(project string, pipelineID int64) (*Pipeline, error) {
+	var pipeline Pipeline
+
+	path := helpers.ProjectPath(project) + "pipelines/" + strconv.FormatInt(pipelineID, 10)
+	if err := c.doJSON(http.MethodGet, path, nil, nil, &pipeline); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get pipeline by ID: %d.\n<?> Error: %w", pipelineID, err)
+	}
+
+	return &pipeline, nil
+}
+
+// ListPipelines lists the most recent pipelines of a project.
+//
+// Parameters:
+//   - project: project path or ID
+//   - ref: filters by git reference (optional)
+//   - status: filters by gitlab status (optional)
+//   - limit: maximum number of pipelines (0 = default)
+//
+// Returns an error if:
+//   - The project doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	pipelines, err := client.ListPipelines("group/project", "main", "", 10)
+func (c *Client) ListPipelines(project, ref, status string, limit int) ([]*Pipeline, error) {
+	if limit <= 0 || limit > constants.DEFAULT_PER_PAGE {
+		limit = constants.DEFAULT_PER_PAGE
+	}
+
+	query := url.Values{"per_page": {strconv.Itoa(limit)}}
+	if ref != "" {
+		query.Set("ref", ref)
+	}
+	if status != "" {
+		query.Set("status", status)
+	}
+
+	var pipelines []*Pipeline
+	if err := c.doJSON(http.MethodGet, helpers.ProjectPath(project)+"pipelines", query, nil, &pipelines); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list pipelines.\n<?> Error: %w", err)
+	}
+
+	return pipelines, nil
+}
+
+// ListPipelineJobs lists the jobs of a pipeline.
+//
+// Parameters:
+//   - project: project path or ID
+//   - pipelineID: pipeline ID
+//
+// Returns an error if:
+//   - The pipeline doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	jobs, err := client.ListPipelineJobs("group/project", 12345)
+func (c *Client) ListPipelineJobs(project string, pipelineID int64) ([]*Job, error) {
+	query := url.Values{"per_page": {strconv.Itoa(constants.DEFAULT_PER_PAGE)}}
+
+	var jobs []*Job
+	path := helpers.ProjectPath(project) + "pipelines/" + strconv.FormatInt(pipelineID, 10) + "/jobs"
+	if err := c.doJSON(http.MethodGet, path, query, nil, &jobs); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list jobs of pipeline %d.\n<?> Error: %w", pipelineID, err)
+	}
+
+	// the api returns the most recent jobs first, pipelines read top to bottom
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+
+	return jobs, nil
+}
+
+// CancelPipeline cancels a running pipeline.
+//
+// Parameters:
+//   - project: project path or ID
+//   - pipelineID: pipeline ID
+//
+// Returns an error if:
+//   - The pipeline doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	err := client.CancelPipeline("group/project", 12345)
+func (c *Client) CancelPipeline(project string, pipelineID int64) error {
+	path := helpers.ProjectPath(project) + "pipelines/" + strconv.FormatInt(pipelineID, 10) + "/cancel"
+	if err := c.doJSON(http.MethodPost, path, nil, nil, nil); err != nil {
+		return fmt.Errorf("<?> Error: Failed to cancel pipeline with ID: %d.\n<?> Error: %w", pipelineID, err)
+	}
+
+	return nil
+}
+
+// RetryPipeline retries the failed (and cancelled) jobs of a pipeline.
+//
+// Parameters:
+//   - project: project path or ID
+//   - pipelineID: pipeline ID
+//
+// Returns an error if:
+//   - The pipeline doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	pipeline, err := client.RetryPipeline("group/project", 12345)
+func (c *Client) RetryPipeline(project string, pipelineID int64) (*Pipeline, error) {
+	var pipeline Pipeline
+
+	path := helpers.ProjectPath(project) + "pipelines/" + strconv.FormatInt(pipelineID, 10) + "/retry"
+	if err := c.doJSON(http.MethodPost, path, nil, nil, &pipeline); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to retry pipeline with ID: %d.\n<?> Error: %w", pipelineID, err)
+	}
+
+	return &pipeline, nil
+}
+
+// ListPipelineVariables lists the variables a pipeline was created with.
+//
+// Parameters:
+//   - project: project path or ID
+//   - pipelineID: pipeline ID
+//
+// Returns an error if:
+//   - The pipeline doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	variables, err := client.ListPipelineVariables("group/project", 12345)
+func (c *Client) ListPipelineVariables(project string, pipelineID int64) ([]*PipelineVariable, error) {
+	var variables []*PipelineVariable
+
+	path := helpers.ProjectPath(project) + "pipelines/" + strconv.FormatInt(pipelineID, 10) + "/variables"
+	if err := c.doJSON(http.MethodGet, path, nil, nil, &variables); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list variables of pipeline %d.\n<?> Error: %w", pipelineID, err)
+	}
+
+	return variables, nil
+}
+
+// IsProtectedBranch checks whether a branch is protected.
+//
+// Parameters:
+//   - project: project path or ID
+//   - branch: branch name
+//
+// Returns an error if:
+//   - The API request fails
+//
+// Example:
+//
+//	protected, err := client.IsProtectedBranch("group/project", "main")
+func (c *Client) IsProtectedBranch(project, branch string) (bool, error) {
+	err := c.doJSON(http.MethodGet, helpers.ProjectPath(project)+"protected_branches/"+url.PathEscape(branch), nil, nil, nil)
+
+	// unprotected branches aren't found
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("<?> Error: Failed to get protected branch %s.\n<?> Error: %w", branch, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,79 @@
+package gitlab
+
+import "time"
+
+// NOTE: Only the fields used by uniflow are decoded from the gitlab api
+
+// Project represents a gitlab project
+type Project struct {
+	ID                int64  `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	Description       string `json:"description"`
+	DefaultBranch     string `json:"default_branch"`
+	Visibility        string `json:"visibility"`
+	WebURL            string `json:"web_url"`
+	CIConfigPath      string `json:"ci_config_path"`
+}
+
+// User represents a gitlab user
+type User struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+// Pipeline represents a gitlab pipeline
+type Pipeline struct {
+	ID         int64      `json:"id"`
+	IID        int64      `json:"iid"`
+	ProjectID  int64      `json:"project_id"`
+	Status     string     `json:"status"`
+	Source     string     `json:"source"`
+	Ref        string     `json:"ref"`
+	SHA        string     `json:"sha"`
+	WebURL     string     `json:"web_url"`
+	User       *User      `json:"user"`
+	CreatedAt  *time.Time `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+	StartedAt  *time.Time `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	Duration   float64    `json:"duration"`
+}
+
+// Job represents a gitlab pipeline job
+type Job struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Stage      string     `json:"stage"`
+	Status     string     `json:"status"`
+	Ref        string     `json:"ref"`
+	WebURL     string     `json:"web_url"`
+	Pipeline   *Pipeline  `json:"pipeline"`
+	CreatedAt  *time.Time `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at"`
+	Duration   float64    `json:"duration"`
+}
+
+// PipelineVariable represents a variable passed when creating a pipeline
+type PipelineVariable struct {
+	Key          string `json:"key"`
+	Value        string `json:"value"`
+	VariableType string `json:"variable_type,omitempty"`
+}
+
+// CreatePipelineRequest represents the body of a pipeline creation
+type CreatePipelineRequest struct {
+	Ref       string              `json:"ref"`
+	Variables []*PipelineVariable `json:"variables,omitempty"`
+}
+
+// TimeOrZero returns the dereferenced time or the zero time
+func TimeOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
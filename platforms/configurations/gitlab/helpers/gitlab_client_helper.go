@@ -0,0 +1,84 @@
+package helpers
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// ansiRegex matches ANSI escape sequences (colors and erase-line codes)
+var ansiRegex = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// sectionRegex matches collapsible section markers emitted by gitlab runners
+var sectionRegex = regexp.MustCompile(`section_(start|end):\d+:[^\r\n]*?\r`)
+
+// ProjectPath is a helper function that encodes a project path (or numeric ID) for the api.
+//
+// Parameters:
+//   - project: project path or ID (eg: "group/sub/project", "42")
+//
+// Example:
+// path := helpers.ProjectPath("group/project") // "projects/group%2Fproject/"
+func ProjectPath(project string) string {
+	return "projects/" + url.PathEscape(strings.Trim(project, "/")) + "/"
+}
+
+// MapPipelineStatus is a helper function that maps a gitlab pipeline (or job) status onto uniflow's status and conclusion.
+//
+// Parameters:
+//   - status: gitlab status (created, pending, running, success, failed, canceled, skipped, manual, scheduled...)
+//
+// Example:
+// status, conclusion := helpers.MapPipelineStatus("failed") // "completed", "failure"
+func MapPipelineStatus(status string) (string, string) {
+	switch strings.ToLower(status) {
+	case "running", "canceling":
+		return "in_progress", ""
+	case "manual":
+		return "waiting", ""
+	case "success":
+		return "completed", "success"
+	case "failed":
+		return "completed", "failure"
+	case "canceled":
+		return "completed", "cancelled"
+	case "skipped":
+		return "completed", "skipped"
+	default:
+		return "queued", ""
+	}
+}
+
+// ToGitlabStatus is a helper function that maps uniflow's status filter onto the gitlab one.
+//
+// Parameters:
+//   - status: uniflow status ("queued", "in_progress", "completed")
+//
+// Example:
+// status := helpers.ToGitlabStatus("in_progress") // "running"
+func ToGitlabStatus(status string) string {
+	switch status {
+	case "queued":
+		return "pending"
+	case "in_progress":
+		return "running"
+	case "waiting":
+		return "manual"
+	default:
+		return ""
+	}
+}
+
+// CleanTraceLine is a helper function that strips ANSI codes and section markers from a trace line.
+//
+// Parameters:
+//   - line: raw trace line
+//
+// Example:
+// line := helpers.CleanTraceLine("\x1b[32;1mJob succeeded\x1b[0;m") // "Job succeeded"
+func CleanTraceLine(line string) string {
+	line = sectionRegex.ReplaceAllString(line, "")
+	line = ansiRegex.ReplaceAllString(line, "")
+
+	return strings.TrimRight(line, "\r")
+}
@@ -0,0 +1,75 @@
+package constants
+
+import "time"
+
+// Default values
+const (
+	// JENKINS_TOKEN_ENV_VAR_NAME represents the jenkins api token name in env
+	JENKINS_TOKEN_ENV_VAR_NAME = "JENKINS_API_TOKEN"
+
+	// DEFAULT_TIMEOUT_SECONDS is the http client timeout used when none is configured
+	DEFAULT_TIMEOUT_SECONDS = 30
+
+	// DEFAULT_BUILDS_LIMIT is the number of builds fetched when no limit is provided
+	DEFAULT_BUILDS_LIMIT = 100
+)
+
+// Jenkins REST API paths
+const (
+	// API_JSON_PATH is the json api suffix of every jenkins resource
+	API_JSON_PATH = "api/json"
+
+	// CRUMB_ISSUER_PATH is the CSRF crumb issuer path
+	CRUMB_ISSUER_PATH = "crumbIssuer/api/json"
+
+	// BUILD_PATH triggers a build without parameters
+	BUILD_PATH = "build"
+
+	// BUILD_WITH_PARAMETERS_PATH triggers a build with parameters
+	BUILD_WITH_PARAMETERS_PATH = "buildWithParameters"
+
+	// PROGRESSIVE_TEXT_PATH is the progressive console text path
+	PROGRESSIVE_TEXT_PATH = "logText/progressiveText"
+
+	// CONSOLE_TEXT_PATH is the full console text path
+	CONSOLE_TEXT_PATH = "consoleText"
+
+	// STOP_PATH aborts a running build
+	STOP_PATH = "stop"
+
+	// WFAPI_DESCRIBE_PATH describes pipeline stages (Pipeline Stage View plugin)
+	WFAPI_DESCRIBE_PATH = "wfapi/describe"
+)
+
+// Jenkins response headers
+const (
+	// TEXT_SIZE_HEADER holds the offset to use for the next progressive text request
+	TEXT_SIZE_HEADER = "X-Text-Size"
+
+	// MORE_DATA_HEADER is set to "true" while the build is still producing output
+	MORE_DATA_HEADER = "X-More-Data"
+)
+
+// Jenkins job classes
+const (
+	// MULTIBRANCH_CLASS_SUFFIX identifies multibranch pipeline projects
+	MULTIBRANCH_CLASS_SUFFIX = "WorkflowMultiBranchProject"
+)
+
+// Polling configuration
+const (
+	// QueuePollInterval is the interval between two queue item checks
+	QueuePollInterval = 2 * time.Second
+
+	// QueueMaxWait is the maximum time to wait for a queue item to become a build
+	QueueMaxWait = 5 * time.Minute
+
+	// ConsolePollInterval is the interval between two progressive console requests
+	ConsolePollInterval = 2 * time.Second
+)
+
+// Repository detection
+var (
+	// DETECTION_PATHS are the files/directories (suffixed with "/") identifying a jenkins pipeline repository
+	DETECTION_PATHS = []string{"Jenkinsfile", "Jenkinsfile.groovy", "Jenkinsfile.jenkins"}
+)
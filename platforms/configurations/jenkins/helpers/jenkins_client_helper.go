@@ -0,0 +1,134 @@
+package helpers
+
+import (
+	"hash/fnv"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// JobPath is a helper function that converts a (possibly nested) job name into its URL path.
+//
+// Parameters:
+//   - job: full job name (eg: "folder/release")
+//
+// Example:
+// path := helpers.JobPath("folder/release") // "job/folder/job/release/"
+func JobPath(job string) string {
+	var b strings.Builder
+
+	for _, segment := range strings.Split(job, "/") {
+		if segment == "" {
+			continue
+		}
+
+		b.WriteString("job/")
+		b.WriteString(url.PathEscape(segment))
+		b.WriteString("/")
+	}
+
+	return b.String()
+}
+
+// JobID is a helper function that derives a stable identifier from a job full name (jenkins jobs have no numeric identifier).
+//
+// Parameters:
+//   - job: full job name (eg: "folder/release")
+//
+// Example:
+// id := helpers.JobID("folder/release")
+func JobID(job string) int64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(job))
+
+	// keep it positive
+	return int64(hash.Sum64() >> 1)
+}
+
+// MapBuildStatus is a helper function that maps a jenkins build state onto uniflow's status and conclusion.
+//
+// Parameters:
+//   - building: whether the build is still running
+//   - result: jenkins build result (SUCCESS, FAILURE, ABORTED, UNSTABLE, NOT_BUILT)
+//
+// Example:
+// status, conclusion := helpers.MapBuildStatus(false, "SUCCESS") // "completed", "success"
+func MapBuildStatus(building bool, result string) (string, string) {
+	if building {
+		return "in_progress", ""
+	}
+
+	switch strings.ToUpper(result) {
+	case "SUCCESS":
+		return "completed", "success"
+	case "FAILURE", "UNSTABLE":
+		return "completed", "failure"
+	case "ABORTED":
+		return "completed", "cancelled"
+	case "NOT_BUILT":
+		return "completed", "skipped"
+	case "":
+		return "queued", ""
+	default:
+		return "completed", strings.ToLower(result)
+	}
+}
+
+// MapStageStatus is a helper function that maps a pipeline stage status onto uniflow's status and conclusion.
+//
+// Parameters:
+//   - status: stage status (SUCCESS, FAILED, IN_PROGRESS, NOT_EXECUTED, ABORTED, UNSTABLE, PAUSED_PENDING_INPUT)
+//
+// Example:
+// status, conclusion := helpers.MapStageStatus("FAILED") // "completed", "failure"
+func MapStageStatus(status string) (string, string) {
+	switch strings.ToUpper(status) {
+	case "IN_PROGRESS":
+		return "in_progress", ""
+	case "PAUSED_PENDING_INPUT":
+		return "waiting", ""
+	case "SUCCESS":
+		return "completed", "success"
+	case "FAILED", "UNSTABLE":
+		return "completed", "failure"
+	case "ABORTED":
+		return "completed", "cancelled"
+	case "NOT_EXECUTED":
+		return "completed", "skipped"
+	default:
+		return "queued", ""
+	}
+}
+
+// BranchName is a helper function that strips the remote prefix of a git branch reported by jenkins.
+//
+// Parameters:
+//   - ref: branch reference (eg: "refs/remotes/origin/main")
+//
+// Example:
+// branch := helpers.BranchName("origin/main") // "main"
+func BranchName(ref string) string {
+	ref = strings.TrimPrefix(ref, "refs/remotes/")
+	ref = strings.TrimPrefix(ref, "refs/heads/")
+
+	if _, after, found := strings.Cut(ref, "/"); found && strings.HasPrefix(ref, "origin/") {
+		return after
+	}
+
+	return ref
+}
+
+// MillisToTime is a helper function that converts jenkins epoch milliseconds into time.Time.
+//
+// Parameters:
+//   - ms: epoch milliseconds
+//
+// Example:
+// t := helpers.MillisToTime(1700000000000)
+func MillisToTime(ms int64) time.Time {
+	if ms <= 0 {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(ms)
+}
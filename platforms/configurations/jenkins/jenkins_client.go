@@ -0,0 +1,298 @@
+package jenkins
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins/constants"
+	platformConstants "github.com/ignorant05/Uniflow/platforms/constants"
+)
+
+type Client struct {
+	HTTPClient *http.Client
+	BaseURL    *url.URL
+	Ctx        context.Context
+	Config     *Config
+
+	crumb *Crumb
+}
+
+// NewClient creates new client from configuration.
+//
+// Parameters:
+//   - ctx: context
+//   - cfg: user's jenkins configuration
+//
+// Returns an error if:
+//   - missing or invalid base url
+//   - unreadable CA certificate
+//
+// Example:
+//
+//	client, err := NewClient(context.Background(), cfg)
+func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("<?> Error: No jenkins base URL configured")
+	}
+
+	baseURL, err := url.Parse(strings.TrimSuffix(cfg.BaseURL, "/") + "/")
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid jenkins base URL: %s.\n<?> Error: %w", cfg.BaseURL, err)
+	}
+
+	if cfg.APIToken == "" && cfg.Password == "" {
+		cfg.APIToken = os.Getenv(constants.JENKINS_TOKEN_ENV_VAR_NAME)
+	}
+
+	timeout := cfg.TimeoutSeconds
+	if timeout <= 0 {
+		timeout = constants.DEFAULT_TIMEOUT_SECONDS
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+
+	if cfg.CACertPath != "" {
+		pem, err := os.ReadFile(cfg.CACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to read CA certificate %s.\n<?> Error: %w", cfg.CACertPath, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("<?> Error: No valid certificate found in %s", cfg.CACertPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	// crumbs are bound to the web session when authenticating with a password
+	jar, _ := cookiejar.New(nil)
+
+	return &Client{
+		HTTPClient: &http.Client{
+			Transport: transport,
+			Timeout:   time.Duration(timeout) * time.Second,
+			Jar:       jar,
+		},
+		BaseURL: baseURL,
+		Ctx:     ctx,
+		Config:  cfg,
+	}, nil
+}
+
+// NewClientFromProfile creates new client from profile configuration.
+//
+// Parameters:
+//   - ctx: context
+//   - profile: user's profile configuration
+//
+// Returns an error if:
+//   - jenkins isn't configured for this profile
+//   - jenkins client creation failure
+//
+// Example:
+//
+//	client, err := NewClientFromProfile(context.Background(), profile)
+func NewClientFromProfile(ctx context.Context, profile *config.Profile) (*Client, error) {
+	section, err := config.DecodeSection[Config](profile.RawSection(platformConstants.JENKINS_PLATFORM))
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Invalid jenkins configuration.\n<?> Error: %w", err)
+	}
+
+	if section == nil {
+		return nil, fmt.Errorf("<?> Error: Jenkins isn't configured for this profile")
+	}
+
+	return NewClient(ctx, section.(*Config))
+}
+
+// GetDefaultJob retrieves the job configured for the current profile.
+//
+// Parameters:
+//   - None
+//
+// Returns an error if:
+//   - no default job is configured
+//
+// Example:
+//
+//	job, err := client.GetDefaultJob()
+func (c *Client) GetDefaultJob() (string, error) {
+	if c.Config.JobName == "" {
+		return "", fmt.Errorf("<?> Error: No default job configured")
+	}
+
+	return c.Config.JobName, nil
+}
+
+// TestConnection tests the connection after client creation.
+//
+// Parameters:
+//   - None
+//
+// Returns an error if:
+//   - jenkins is unreachable
+//   - authentication failure
+//
+// Example:
+//
+//	err := client.TestConnection()
+func (c *Client) TestConnection() error {
+	var whoAmI struct {
+		Name string `json:"name"`
+	}
+
+	if err := c.getJSON("me/"+constants.API_JSON_PATH, nil, &whoAmI); err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	fmt.Printf("✓ Successfully authenticated as: %s\n", whoAmI.Name)
+	return nil
+}
+
+// resolve resolves a path relative to the jenkins base url.
+// Absolute urls returned by jenkins (queue items, build urls) are kept as is.
+func (c *Client) resolve(path string, query url.Values) (string, error) {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Invalid path: %s.\n<?> Error: %w", path, err)
+	}
+
+	u := c.BaseURL.ResolveReference(ref)
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String(), nil
+}
+
+// newRequest creates an authenticated request
+func (c *Client) newRequest(method, path string, query url.Values, body io.Reader) (*http.Request, error) {
+	target, err := c.resolve(path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(c.Ctx, method, target, body)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "Uniflow-CLI")
+
+	secret := c.Config.APIToken
+	if secret == "" {
+		secret = c.Config.Password
+	}
+
+	if c.Config.Username != "" && secret != "" {
+		req.SetBasicAuth(c.Config.Username, secret)
+	}
+
+	if method == http.MethodPost {
+		if crumb := c.getCrumb(); crumb != nil {
+			req.Header.Set(crumb.CrumbRequestField, crumb.Crumb)
+		}
+	}
+
+	return req, nil
+}
+
+// do sends the request and converts non 2xx responses into errors
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Request to %s failed.\n<?> Error: %w", req.URL.Path, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				fmt.Printf("<!> warning: Failed to close response body: %v", err)
+			}
+		}()
+
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Method:     req.Method,
+			Path:       req.URL.Path,
+		}
+	}
+
+	return resp, nil
+}
+
+// getJSON fetches path and decodes the json response into out
+func (c *Client) getJSON(path string, query url.Values, out interface{}) error {
+	return c.getJSONContext(c.Ctx, path, query, out)
+}
+
+// getJSONContext fetches path within ctx and decodes the json response into out
+func (c *Client) getJSONContext(ctx context.Context, path string, query url.Values, out interface{}) error {
+	req, err := c.newRequest(http.MethodGet, path, query, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("<?> Error: Failed to decode response of %s.\n<?> Error: %w", path, err)
+	}
+
+	return nil
+}
+
+// getCrumb fetches (once) the CSRF crumb.
+// Jenkins instances with CSRF protection disabled answer 404, in which case no crumb is sent.
+func (c *Client) getCrumb() *Crumb {
+	if c.crumb != nil {
+		if c.crumb.Crumb == "" {
+			return nil
+		}
+		return c.crumb
+	}
+
+	c.crumb = &Crumb{}
+
+	var crumb Crumb
+	if err := c.getJSON(constants.CRUMB_ISSUER_PATH, nil, &crumb); err == nil && crumb.Crumb != "" {
+		c.crumb = &crumb
+		return c.crumb
+	}
+
+	return nil
+}
+
+// APIError represents a non successful jenkins api response
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("<?> Error: %s %s returned status code: %d", e.Method, e.Path, e.StatusCode)
+}
@@ -0,0 +1,70 @@
+package jenkins
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+)
+
+// Config is the jenkins section of a profile
+type Config struct {
+	BaseURL  string `yaml:"base_url" mapstructure:"base_url"`
+	Username string `yaml:"username,omitempty" mapstructure:"username"`
+	APIToken string `yaml:"api_token,omitempty" mapstructure:"api_token"`
+	Password string `yaml:"password,omitempty" mapstructure:"password"`
+
+	// Jenkins-specific fields
+	JobName  string `yaml:"job_name,omitempty" mapstructure:"job_name"`
+	ViewName string `yaml:"view_name,omitempty" mapstructure:"view_name"`
+
+	// Optional settings
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty" mapstructure:"insecure_skip_verify"`
+	TimeoutSeconds     int    `yaml:"timeout_seconds,omitempty" mapstructure:"timeout_seconds"`
+	CACertPath         string `yaml:"ca_cert_path,omitempty" mapstructure:"ca_cert_path"`
+}
+
+// ValidateConfig validates jenkins conf
+//
+// Parameters:
+//   - prefix: prefix string
+//   - cfg: jenkins configuration struct
+//
+// Error possible causes:
+//   - invalid url
+//   - credentials are not sat
+//   - negative timeout
+//
+// Examples:
+// errs := ValidateConfig(prefix, cfg)
+func ValidateConfig(prefix string, cfg *Config) []error {
+	var errors []error
+
+	if u, err := url.Parse(cfg.BaseURL); cfg.BaseURL == "" || err != nil || u.Scheme == "" || u.Host == "" {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".base_url",
+			Message: "<?> Error: Must be a valid URL",
+		})
+	}
+
+	secret := cfg.APIToken
+	if secret == "" {
+		secret = cfg.Password
+	}
+
+	if cfg.Username != "" && (secret == "" || strings.HasPrefix(secret, "${")) {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".api_token",
+			Message: "<?> Error: API token (or password) is required when a username is set",
+		})
+	}
+
+	if cfg.TimeoutSeconds < 0 {
+		errors = append(errors, &config.ValidationError{
+			Field:   prefix + ".timeout_seconds",
+			Message: "<?> Error: Must be a positive number of seconds",
+		})
+	}
+
+	return errors
+}
@@ -0,0 +1,349 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins/helpers"
+)
+
+// jobTree limits the fields returned when fetching a job
+const jobTree = "_class,name,fullName,displayName,description,url,color,buildable,lastBuild[number,url],property[parameterDefinitions[name,type,description,choices]]"
+
+// buildTree limits the fields returned when fetching a build
+const buildTree = "number,url,result,building,timestamp,duration,fullDisplayName,queueId,actions[_class,causes[shortDescription,userId,userName],lastBuiltRevision[SHA1,branch[SHA1,name]],parameters[name,value]]"
+
+// GetJob retrieves a job by it's full name.
+//
+// Parameters:
+//   - jobName: full job name (eg: "folder/release")
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	job, err := client.GetJob("release")
+func (c *Client) GetJob(jobName string) (*Job, error) {
+	var job Job
+
+	query := url.Values{"tree": {jobTree}}
+	if err := c.getJSON(helpers.JobPath(jobName)+constants.API_JSON_PATH, query, &job); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get job %s.\n<?> Error: %w", jobName, err)
+	}
+
+	return &job, nil
+}
+
+// ListJobs lists the jobs of the configured view (or of the whole instance when no view is configured).
+//
+// Parameters:
+//   - None
+//
+// Returns an error if:
+//   - The view doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	jobs, err := client.ListJobs()
+func (c *Client) ListJobs() ([]*Job, error) {
+	var container Job
+
+	path := constants.API_JSON_PATH
+	if c.Config.ViewName != "" {
+		path = "view/" + url.PathEscape(c.Config.ViewName) + "/" + constants.API_JSON_PATH
+	}
+
+	query := url.Values{"tree": {"jobs[_class,name,fullName,displayName,description,url,color,buildable]"}}
+	if err := c.getJSON(path, query, &container); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list jobs.\n<?> Error: %w", err)
+	}
+
+	return container.Jobs, nil
+}
+
+// ResolveJob appends the branch to the job name when the job is a multibranch pipeline.
+//
+// Parameters:
+//   - jobName: full job name
+//   - branch: git branch
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	job, err := client.ResolveJob("app", "feature/login") // "app/feature%2Flogin"
+func (c *Client) ResolveJob(jobName, branch string) (string, error) {
+	if branch == "" {
+		return jobName, nil
+	}
+
+	job, err := c.GetJob(jobName)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.HasSuffix(job.Class, constants.MULTIBRANCH_CLASS_SUFFIX) {
+		// multibranch projects name their branch jobs with the url encoded branch
+		return jobName + "/" + url.PathEscape(branch), nil
+	}
+
+	return jobName, nil
+}
+
+// TriggerBuild queues a new build, using buildWithParameters for parameterized jobs.
+//
+// Parameters:
+//   - jobName: full job name
+//   - params: build parameters as key-value pairs
+//
+// Returns the queue item url, or an error if:
+//   - The job doesn't exist
+//   - The job isn't buildable
+//   - The API request fails
+//
+// Example:
+//
+//	queueURL, err := client.TriggerBuild("release", map[string]string{
+//	  "VERSION": "v1.2.3",
+//	})
+func (c *Client) TriggerBuild(jobName string, params map[string]string) (string, error) {
+	job, err := c.GetJob(jobName)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := constants.BUILD_PATH
+	query := url.Values{}
+	if len(params) > 0 || job.IsParameterized() {
+		endpoint = constants.BUILD_WITH_PARAMETERS_PATH
+		for key, val := range params {
+			query.Set(key, val)
+		}
+	}
+
+	req, err := c.newRequest(http.MethodPost, helpers.JobPath(jobName)+endpoint, query, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return "", fmt.Errorf("<?> Error: Failed to trigger job %s.\n<?> Error: %w", jobName, err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("<?> Error: Jenkins didn't return a queue item for job %s", jobName)
+	}
+
+	return location, nil
+}
+
+// WaitForQueueItem follows a queue item until jenkins assigns it a build number.
+//
+// Parameters:
+//   - ctx: the context variable
+//   - queueURL: queue item url returned by TriggerBuild
+//   - timeout: maximum waiting time (0 = default)
+//
+// Returns an error if:
+//   - The queue item was cancelled
+//   - The build didn't start before timeout (or ctx is done)
+//   - The API request fails
+//
+// Example:
+//
+//	build, err := client.WaitForQueueItem(ctx, queueURL, time.Minute)
+func (c *Client) WaitForQueueItem(ctx context.Context, queueURL string, timeout time.Duration) (*BuildRef, error) {
+	if timeout <= 0 {
+		timeout = constants.QueueMaxWait
+	}
+
+	deadline := time.Now().Add(timeout)
+	path := strings.TrimSuffix(queueURL, "/") + "/" + constants.API_JSON_PATH
+
+	for {
+		var item QueueItem
+		if err := c.getJSONContext(ctx, path, nil, &item); err != nil {
+			return nil, fmt.Errorf("<?> Error: Failed to get queue item.\n<?> Error: %w", err)
+		}
+
+		if item.Cancelled {
+			return nil, fmt.Errorf("<?> Error: Queue item %d was cancelled", item.ID)
+		}
+
+		if item.Executable != nil && item.Executable.Number > 0 {
+			return item.Executable, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("<?> Error: Build didn't leave the queue within %s.\n</> Info: %s", timeout, item.Why)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(constants.QueuePollInterval):
+		}
+	}
+}
+
+// GetBuild retrieves a build by it's number (0 = last build).
+//
+// Parameters:
+//   - jobName: full job name
+//   - number: build number
+//
+// Returns an error if:
+//   - The build doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	build, err := client.GetBuild("release", 42)
+func (c *Client) GetBuild(jobName string, number int64) (*Build, error) {
+	var build Build
+
+	query := url.Values{"tree": {buildTree}}
+	if err := c.getJSON(buildPath(jobName, number)+constants.API_JSON_PATH, query, &build); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get build #%d of %s.\n<?> Error: %w", number, jobName, err)
+	}
+
+	return &build, nil
+}
+
+// ListBuilds lists the most recent builds of a job.
+//
+// Parameters:
+//   - jobName: full job name
+//   - limit: maximum number of builds (0 = default)
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	builds, err := client.ListBuilds("release", 10)
+func (c *Client) ListBuilds(jobName string, limit int) ([]*Build, error) {
+	if limit <= 0 {
+		limit = constants.DEFAULT_BUILDS_LIMIT
+	}
+
+	return c.ListBuildsRange(jobName, 0, limit)
+}
+
+// ListBuildsRange lists the builds of a job from the most recent one, between two positions.
+// NOTE: jenkins only exposes the first builds in "builds", older ones are read from "allBuilds"
+//
+// Parameters:
+//   - jobName: full job name
+//   - from: position of the first build (inclusive)
+//   - to: position of the last build (exclusive)
+//
+// Returns an error if:
+//   - The job doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	builds, err := client.ListBuildsRange("release", 100, 200)
+func (c *Client) ListBuildsRange(jobName string, from, to int) ([]*Build, error) {
+	field := "builds"
+	if to > constants.DEFAULT_BUILDS_LIMIT {
+		field = "allBuilds"
+	}
+
+	var job Job
+
+	query := url.Values{"tree": {fmt.Sprintf("%s[%s]{%d,%d}", field, buildTree, from, to)}}
+	if err := c.getJSON(helpers.JobPath(jobName)+constants.API_JSON_PATH, query, &job); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to list builds of %s.\n<?> Error: %w", jobName, err)
+	}
+
+	if field == "allBuilds" {
+		return job.AllBuilds, nil
+	}
+
+	return job.Builds, nil
+}
+
+// StopBuild aborts a running build.
+//
+// Parameters:
+//   - jobName: full job name
+//   - number: build number
+//
+// Returns an error if:
+//   - The build doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	err := client.StopBuild("release", 42)
+func (c *Client) StopBuild(jobName string, number int64) error {
+	req, err := c.newRequest(http.MethodPost, buildPath(jobName, number)+constants.STOP_PATH, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("<?> Error: Failed to stop build #%d of %s.\n<?> Error: %w", number, jobName, err)
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		fmt.Printf("<!> warning: Failed to close response body: %v", err)
+	}
+
+	return nil
+}
+
+// DescribeBuild retrieves the pipeline stages of a build (requires the Pipeline Stage View plugin).
+//
+// Parameters:
+//   - jobName: full job name
+//   - number: build number
+//
+// Returns an error if:
+//   - The build isn't a pipeline (or the plugin isn't installed)
+//   - The API request fails
+//
+// Example:
+//
+//	run, err := client.DescribeBuild("release", 42)
+func (c *Client) DescribeBuild(jobName string, number int64) (*PipelineRun, error) {
+	var run PipelineRun
+
+	if err := c.getJSON(buildPath(jobName, number)+constants.WFAPI_DESCRIBE_PATH, nil, &run); err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to describe build #%d of %s.\n<?> Error: %w", number, jobName, err)
+	}
+
+	return &run, nil
+}
+
+// buildPath returns the url path of a build (0 = last build)
+func buildPath(jobName string, number int64) string {
+	if number <= 0 {
+		return helpers.JobPath(jobName) + "lastBuild/"
+	}
+
+	return helpers.JobPath(jobName) + strconv.FormatInt(number, 10) + "/"
+}
@@ -0,0 +1,119 @@
+package jenkins
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins/constants"
+)
+
+// GetProgressiveConsoleText retrieves the console output of a build starting at a byte offset.
+//
+// Parameters:
+//   - jobName: full job name
+//   - number: build number
+//   - start: byte offset to start from (0 = beginning)
+//
+// Returns an error if:
+//   - The build doesn't exist
+//   - The API request fails
+//
+// Example:
+//
+//	chunk, err := client.GetProgressiveConsoleText("release", 42, 0)
+func (c *Client) GetProgressiveConsoleText(jobName string, number, start int64) (*ConsoleChunk, error) {
+	query := url.Values{"start": {strconv.FormatInt(start, 10)}}
+
+	req, err := c.newRequest(http.MethodGet, buildPath(jobName, number)+constants.PROGRESSIVE_TEXT_PATH, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to get console output of build #%d.\n<?> Error: %w", number, err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: Failed to read console output.\n<?> Error: %w", err)
+	}
+
+	next := start + int64(len(body))
+	if size, err := strconv.ParseInt(resp.Header.Get(constants.TEXT_SIZE_HEADER), 10, 64); err == nil {
+		next = size
+	}
+
+	return &ConsoleChunk{
+		Text:      string(body),
+		NextStart: next,
+		MoreData:  strings.EqualFold(resp.Header.Get(constants.MORE_DATA_HEADER), "true"),
+	}, nil
+}
+
+// DownloadConsoleText downloads the full console output of a build into a file.
+//
+// Parameters:
+//   - jobName: full job name
+//   - number: build number
+//   - path: destination file path
+//
+// Returns an error if:
+//   - The build doesn't exist
+//   - The file can't be written
+//   - The API request fails
+//
+// Example:
+//
+//	written, err := client.DownloadConsoleText("release", 42, "/tmp/release-42.log")
+func (c *Client) DownloadConsoleText(jobName string, number int64, path string) (int64, error) {
+	req, err := c.newRequest(http.MethodGet, buildPath(jobName, number)+constants.CONSOLE_TEXT_PATH, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return 0, fmt.Errorf("<?> Error: Failed to download console output of build #%d.\n<?> Error: %w", number, err)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close response body: %v", err)
+		}
+	}()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, fmt.Errorf("<?> Error: Failed to create logs directory.\n<?> Error: %w", err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("<?> Error: Create output file: %w", err)
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			fmt.Printf("<!> warning: Failed to close file: %v", err)
+		}
+	}()
+
+	written, err := io.Copy(file, resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("<?> Error: Failed to write logs data: %w", err)
+	}
+
+	return written, nil
+}
@@ -0,0 +1,205 @@
+package jenkins
+
+import "fmt"
+
+// NOTE: Only the fields used by uniflow are decoded from the jenkins json api
+
+// Job represents a jenkins job (freestyle, pipeline, folder or multibranch project)
+type Job struct {
+	Class       string      `json:"_class"`
+	Name        string      `json:"name"`
+	FullName    string      `json:"fullName"`
+	DisplayName string      `json:"displayName"`
+	Description string      `json:"description"`
+	URL         string      `json:"url"`
+	Color       string      `json:"color"`
+	Buildable   bool        `json:"buildable"`
+	Builds      []*Build    `json:"builds"`
+	AllBuilds   []*Build    `json:"allBuilds"`
+	LastBuild   *BuildRef   `json:"lastBuild"`
+	Jobs        []*Job      `json:"jobs"`
+	Property    []*Property `json:"property"`
+}
+
+// Property represents a job property, only parameter definitions are decoded
+type Property struct {
+	ParameterDefinitions []*ParameterDefinition `json:"parameterDefinitions"`
+}
+
+// ParameterDefinition represents a build parameter declared by a job
+type ParameterDefinition struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Choices     []string `json:"choices"`
+}
+
+// BuildRef is a light reference to a build
+type BuildRef struct {
+	Number int64  `json:"number"`
+	URL    string `json:"url"`
+}
+
+// Build represents a single jenkins build
+type Build struct {
+	Number          int64          `json:"number"`
+	URL             string         `json:"url"`
+	Result          string         `json:"result"`
+	Building        bool           `json:"building"`
+	Timestamp       int64          `json:"timestamp"`
+	Duration        int64          `json:"duration"`
+	FullDisplayName string         `json:"fullDisplayName"`
+	QueueID         int64          `json:"queueId"`
+	Actions         []*BuildAction `json:"actions"`
+}
+
+// BuildAction holds the build actions uniflow cares about (causes, git revision and parameters)
+type BuildAction struct {
+	Class             string            `json:"_class"`
+	Causes            []*Cause          `json:"causes"`
+	LastBuiltRevision *Revision         `json:"lastBuiltRevision"`
+	Parameters        []*ParameterValue `json:"parameters"`
+}
+
+// Cause represents why a build was started
+type Cause struct {
+	ShortDescription string `json:"shortDescription"`
+	UserID           string `json:"userId"`
+	UserName         string `json:"userName"`
+}
+
+// Revision represents the git revision a build was built from
+type Revision struct {
+	SHA1   string    `json:"SHA1"`
+	Branch []*Branch `json:"branch"`
+}
+
+// Branch represents a git branch
+type Branch struct {
+	SHA1 string `json:"SHA1"`
+	Name string `json:"name"`
+}
+
+// ParameterValue represents the value of a build parameter
+type ParameterValue struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
+
+// QueueItem represents an item waiting in the jenkins build queue
+type QueueItem struct {
+	ID           int64     `json:"id"`
+	Why          string    `json:"why"`
+	Cancelled    bool      `json:"cancelled"`
+	InQueueSince int64     `json:"inQueueSince"`
+	Executable   *BuildRef `json:"executable"`
+}
+
+// PipelineRun represents a pipeline build described by the wfapi
+type PipelineRun struct {
+	ID     string           `json:"id"`
+	Name   string           `json:"name"`
+	Status string           `json:"status"`
+	Stages []*PipelineStage `json:"stages"`
+}
+
+// PipelineStage represents a single stage of a pipeline build
+type PipelineStage struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	StartTimeMillis int64  `json:"startTimeMillis"`
+	DurationMillis  int64  `json:"durationMillis"`
+}
+
+// Crumb represents a CSRF protection crumb
+type Crumb struct {
+	Crumb             string `json:"crumb"`
+	CrumbRequestField string `json:"crumbRequestField"`
+}
+
+// ConsoleChunk represents a slice of progressive console output
+type ConsoleChunk struct {
+	// Text is the console output starting at the requested offset
+	Text string
+
+	// NextStart is the offset to request next
+	NextStart int64
+
+	// MoreData indicates whether the build is still producing output
+	MoreData bool
+}
+
+// Author returns the user that started the build (if any)
+func (b *Build) Author() string {
+	for _, action := range b.Actions {
+		if action == nil {
+			continue
+		}
+
+		for _, cause := range action.Causes {
+			if cause.UserID != "" {
+				return cause.UserID
+			}
+		}
+	}
+
+	return ""
+}
+
+// Cause returns the short description of the first build cause
+func (b *Build) Cause() string {
+	for _, action := range b.Actions {
+		if action == nil {
+			continue
+		}
+
+		for _, cause := range action.Causes {
+			if cause.ShortDescription != "" {
+				return cause.ShortDescription
+			}
+		}
+	}
+
+	return ""
+}
+
+// Revision returns the git revision the build was built from (if any)
+func (b *Build) Revision() *Revision {
+	for _, action := range b.Actions {
+		if action != nil && action.LastBuiltRevision != nil {
+			return action.LastBuiltRevision
+		}
+	}
+
+	return nil
+}
+
+// ParameterValues returns the parameters the build was started with
+func (b *Build) ParameterValues() map[string]string {
+	params := make(map[string]string)
+	for _, action := range b.Actions {
+		if action == nil {
+			continue
+		}
+
+		for _, param := range action.Parameters {
+			if param != nil && param.Value != nil {
+				params[param.Name] = fmt.Sprint(param.Value)
+			}
+		}
+	}
+
+	return params
+}
+
+// IsParameterized checks whether a job declares build parameters
+func (j *Job) IsParameterized() bool {
+	for _, property := range j.Property {
+		if property != nil && len(property.ParameterDefinitions) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
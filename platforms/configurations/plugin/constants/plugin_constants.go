@@ -0,0 +1,36 @@
+package constants
+
+import "time"
+
+// Default values
+const (
+	// EXECUTABLE_PREFIX is the prefix of plugin executables (uniflow-platform-<name>)
+	EXECUTABLE_PREFIX = "uniflow-platform-"
+
+	// JSONRPC_VERSION is the json-rpc protocol version spoken with plugins
+	JSONRPC_VERSION = "2.0"
+
+	// PROTOCOL_VERSION is the uniflow plugin protocol version (sent on initialize)
+	PROTOCOL_VERSION = 1
+
+	// SHUTDOWN_TIMEOUT is the time a plugin gets to exit after shutdown
+	SHUTDOWN_TIMEOUT = 5 * time.Second
+)
+
+// Protocol methods (the other methods mirror platforms.PlatformClient, eg: "TriggerWorkflow")
+const (
+	// METHOD_INITIALIZE is the first request sent to a plugin
+	METHOD_INITIALIZE = "initialize"
+
+	// METHOD_SHUTDOWN is the last request sent to a plugin
+	METHOD_SHUTDOWN = "shutdown"
+
+	// METHOD_LOG_LINE is the notification a plugin sends for each log line while streaming
+	METHOD_LOG_LINE = "LogLine"
+)
+
+// Json-rpc error codes
+const (
+	// ERROR_METHOD_NOT_FOUND is returned by plugins for methods they don't implement
+	ERROR_METHOD_NOT_FOUND = -32601
+)
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/plugin/constants"
+)
+
+// ExecutableName is a helper function that builds the executable name of a plugin.
+//
+// Parameters:
+//   - name: platform name
+//
+// Example:
+// executable := helpers.ExecutableName("buildkite") // "uniflow-platform-buildkite"
+func ExecutableName(name string) string {
+	return constants.EXECUTABLE_PREFIX + name
+}
+
+// LookupExecutable is a helper function that finds the executable of a plugin on PATH.
+//
+// Parameters:
+//   - name: platform name
+//
+// Example:
+// path, err := helpers.LookupExecutable("buildkite") // "/usr/local/bin/uniflow-platform-buildkite"
+func LookupExecutable(name string) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return "", exec.ErrNotFound
+	}
+
+	return exec.LookPath(ExecutableName(name))
+}
+
+// DiscoverPlugins is a helper function that lists the names of the plugins found on PATH (sorted).
+//
+// Parameters:
+//   - None
+//
+// Example:
+// names := helpers.DiscoverPlugins() // ["buildkite", "drone"]
+func DiscoverPlugins() []string {
+	seen := map[string]bool{}
+	var names []string
+
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, ok := strings.CutPrefix(entry.Name(), constants.EXECUTABLE_PREFIX)
+			if !ok || name == "" || entry.IsDir() || seen[name] {
+				continue
+			}
+
+			// windows executables come with an extension
+			name = strings.TrimSuffix(name, filepath.Ext(name))
+
+			if _, err := LookupExecutable(name); err != nil {
+				continue
+			}
+
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,387 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/plugin/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/plugin/helpers"
+)
+
+// NotificationHandler is called for each notification received while a request is pending
+type NotificationHandler func(method string, params json.RawMessage) error
+
+type Client struct {
+	Name   string
+	Path   string
+	Ctx    context.Context
+	Config config.PluginConfig
+
+	// Info is the plugin description (set once started)
+	Info *InitializeResult
+
+	mu       sync.Mutex
+	cmd      *exec.Cmd
+	nextID   int64
+	pending  map[int64]chan *Message
+	notify   NotificationHandler
+	broken   error
+	readDone chan struct{}
+
+	// writeMu keeps the requests whole, streamMu gives the notifications to a single call
+	writeMu  sync.Mutex
+	stdin    io.WriteCloser
+	streamMu sync.Mutex
+}
+
+// NewClient creates new client for the plugin executable of a platform.
+// NOTE: the plugin isn't started until Start is called
+//
+// Parameters:
+//   - ctx: context
+//   - name: platform name (the executable is uniflow-platform-<name>)
+//   - cfg: plugin section of the profile (optional)
+//
+// Returns an error if:
+//   - no uniflow-platform-<name> executable on PATH
+//
+// Example:
+//
+//	client, err := NewClient(context.Background(), "buildkite", cfg)
+func NewClient(ctx context.Context, name string, cfg config.PluginConfig) (*Client, error) {
+	path, err := helpers.LookupExecutable(name)
+	if err != nil {
+		return nil, fmt.Errorf("<?> Error: No %s executable found on PATH.\n<?> Error: %w", helpers.ExecutableName(name), err)
+	}
+
+	if cfg == nil {
+		cfg = config.PluginConfig{}
+	}
+
+	return &Client{
+		Name:   name,
+		Path:   path,
+		Ctx:    ctx,
+		Config: cfg,
+	}, nil
+}
+
+// NewClientFromProfile creates new client from profile configuration.
+//
+// Parameters:
+//   - ctx: context
+//   - name: platform name
+//   - profile: user's profile configuration
+//
+// Returns an error if:
+//   - plugin client creation failure
+//
+// Example:
+//
+//	client, err := NewClientFromProfile(context.Background(), "buildkite", profile)
+func NewClientFromProfile(ctx context.Context, name string, profile *config.Profile) (*Client, error) {
+	return NewClient(ctx, name, profile.Plugins[name])
+}
+
+// Start starts the plugin process and initializes it.
+// NOTE: plugins must exit once their stdin is closed
+//
+// Parameters:
+//   - None
+//
+// Returns an error if:
+//   - the plugin can't be started
+//   - the plugin fails to initialize
+//
+// Example:
+//
+//	err := client.Start()
+func (c *Client) Start() error {
+	c.mu.Lock()
+
+	if c.cmd != nil {
+		c.mu.Unlock()
+		return nil
+	}
+
+	cmd := exec.Command(c.Path)
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		c.mu.Unlock()
+		return fmt.Errorf("<?> Error: Failed to open plugin stdin.\n<?> Error: %w", err)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		c.mu.Unlock()
+		return fmt.Errorf("<?> Error: Failed to open plugin stdout.\n<?> Error: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		c.mu.Unlock()
+		return fmt.Errorf("<?> Error: Failed to start plugin %s.\n<?> Error: %w", c.Path, err)
+	}
+
+	c.cmd = cmd
+	c.stdin = stdin
+	c.pending = make(map[int64]chan *Message)
+	c.readDone = make(chan struct{})
+	c.mu.Unlock()
+
+	go c.readMessages(stdout)
+
+	params := &InitializeParams{
+		Platform:        c.Name,
+		ProtocolVersion: constants.PROTOCOL_VERSION,
+		Config:          c.Config,
+	}
+
+	var info InitializeResult
+	if err := c.Call(c.Ctx, constants.METHOD_INITIALIZE, params, &info, nil); err != nil {
+		c.Close()
+		return fmt.Errorf("<?> Error: Failed to initialize plugin %s.\n<?> Error: %w", c.Name, err)
+	}
+
+	c.Info = &info
+
+	return nil
+}
+
+// Call sends a request to the plugin and waits for its response.
+// NOTE: calls run concurrently (responses are dispatched by ID), but notifications don't name their request,
+// so the calls with a notification handler run one at a time and receive the notifications sent meanwhile
+//
+// Parameters:
+//   - ctx: the context variable (a call with a notification handler kills the plugin if it's cancelled)
+//   - method: method name (eg: "GetStatus")
+//   - params: request params (json encoded)
+//   - result: response result destination (optional)
+//   - notify: notification handler (optional)
+//
+// Returns an error if:
+//   - the plugin isn't started (or died)
+//   - the plugin returned an error (*RPCError)
+//   - the notification handler failed
+//
+// Example:
+//
+//	err := client.Call(ctx, "GetStatus", req, &status, nil)
+func (c *Client) Call(ctx context.Context, method string, params, result interface{}, notify NotificationHandler) error {
+	if notify != nil {
+		c.streamMu.Lock()
+		defer c.streamMu.Unlock()
+	}
+
+	c.mu.Lock()
+
+	if c.cmd == nil {
+		c.mu.Unlock()
+		return fmt.Errorf("<?> Error: Plugin %s isn't started", c.Name)
+	}
+
+	if c.broken != nil {
+		err := c.broken
+		c.mu.Unlock()
+		return err
+	}
+
+	c.nextID++
+	id := c.nextID
+
+	data, err := json.Marshal(&Request{JSONRPC: constants.JSONRPC_VERSION, ID: &id, Method: method, Params: params})
+	if err != nil {
+		c.mu.Unlock()
+		return fmt.Errorf("<?> Error: Failed to encode %s request.\n<?> Error: %w", method, err)
+	}
+
+	response := make(chan *Message, 1)
+	c.pending[id] = response
+	if notify != nil {
+		c.notify = notify
+	}
+
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.pending, id)
+		if notify != nil {
+			c.notify = nil
+		}
+	}()
+
+	c.writeMu.Lock()
+	_, err = c.stdin.Write(append(data, '\n'))
+	c.writeMu.Unlock()
+
+	if err != nil {
+		return c.fail(ctx, fmt.Errorf("<?> Error: Failed to send %s request.\n<?> Error: %w", method, err))
+	}
+
+	var msg *Message
+	select {
+	case msg = <-response:
+	case <-ctx.Done():
+		// the plugin would keep streaming for an abandoned call
+		if notify != nil {
+			return c.fail(ctx, ctx.Err())
+		}
+
+		return ctx.Err()
+	}
+
+	// the plugin died
+	if msg == nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		return c.broken
+	}
+
+	if msg.Error != nil {
+		return msg.Error
+	}
+
+	if result == nil || len(msg.Result) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(msg.Result, result); err != nil {
+		return fmt.Errorf("<?> Error: Failed to decode %s response.\n<?> Error: %w", method, err)
+	}
+
+	return nil
+}
+
+// readMessages dispatches the responses to their pending call, and the notifications to the streaming call
+func (c *Client) readMessages(stdout io.Reader) {
+	defer close(c.readDone)
+
+	reader := bufio.NewReader(stdout)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			c.fail(context.Background(), fmt.Errorf("<?> Error: Failed to read plugin %s output.\n<?> Error: %w", c.Name, err))
+			return
+		}
+
+		var msg Message
+		if err := json.Unmarshal(line, &msg); err != nil {
+			c.fail(context.Background(), fmt.Errorf("<?> Error: Invalid message from plugin %s.\n<?> Error: %w", c.Name, err))
+			return
+		}
+
+		// notification
+		if msg.ID == nil {
+			c.mu.Lock()
+			notify := c.notify
+			c.mu.Unlock()
+
+			if notify != nil && msg.Method != "" {
+				if err := notify(msg.Method, msg.Params); err != nil {
+					c.fail(context.Background(), err)
+					return
+				}
+			}
+			continue
+		}
+
+		c.mu.Lock()
+		response, ok := c.pending[*msg.ID]
+		delete(c.pending, *msg.ID)
+		c.mu.Unlock()
+
+		// responses of abandoned requests are dropped
+		if ok {
+			response <- &msg
+		}
+	}
+}
+
+// fail marks the plugin as unusable and releases the pending calls
+func (c *Client) fail(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		err = ctx.Err()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.broken == nil {
+		c.broken = err
+	}
+
+	for id, response := range c.pending {
+		close(response)
+		delete(c.pending, id)
+	}
+
+	_ = c.cmd.Process.Kill()
+
+	return err
+}
+
+// Close shuts the plugin down.
+//
+// Parameters:
+//   - None
+//
+// Example:
+//
+//	client.Close()
+func (c *Client) Close() {
+	c.mu.Lock()
+	started := c.cmd != nil && c.broken == nil
+	c.mu.Unlock()
+
+	if !started {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.SHUTDOWN_TIMEOUT)
+	defer cancel()
+
+	_ = c.Call(ctx, constants.METHOD_SHUTDOWN, nil, nil, nil)
+
+	c.writeMu.Lock()
+	if err := c.stdin.Close(); err != nil {
+		fmt.Printf("<!> warning: Failed to close plugin stdin: %v", err)
+	}
+	c.writeMu.Unlock()
+
+	// the output is read before waiting for the process
+	exited := make(chan struct{})
+	go func() {
+		<-c.readDone
+		_ = c.cmd.Wait()
+		close(exited)
+	}()
+
+	select {
+	case <-exited:
+	case <-time.After(constants.SHUTDOWN_TIMEOUT):
+		_ = c.cmd.Process.Kill()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.broken = fmt.Errorf("<?> Error: Plugin %s is closed", c.Name)
+}
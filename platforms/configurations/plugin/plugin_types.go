@@ -0,0 +1,65 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NOTE: messages are json-rpc 2.0 objects, one per line, the payloads are the types package structs
+
+// Request represents a json-rpc request (a notification when it has no ID)
+type Request struct {
+	JSONRPC string      `json:"jsonrpc"`
+	ID      *int64      `json:"id,omitempty"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+// Message represents any json-rpc message received from a plugin (response or notification)
+type Message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *int64          `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+// RPCError represents a json-rpc error returned by a plugin
+// NOTE: Data may hold a types.PlatformError (Code, Message, StatusCode, Details)
+type RPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("<?> Error: Plugin returned error %d: %s", e.Code, e.Message)
+}
+
+// InitializeParams is sent with the initialize request
+type InitializeParams struct {
+	// Platform is the name the plugin was invoked as
+	Platform string
+
+	// ProtocolVersion is the uniflow plugin protocol version
+	ProtocolVersion int
+
+	// Config is the plugin section of the profile (may be empty)
+	Config map[string]interface{}
+}
+
+// InitializeResult is returned by the plugin on initialize
+type InitializeResult struct {
+	// Name is the plugin display name
+	Name string
+
+	// Version is the plugin version
+	Version string
+}
+
+// RepositoryResult is returned by the plugin on GetRepository
+type RepositoryResult struct {
+	Owner string
+	Repo  string
+}
@@ -1,16 +1,30 @@
 package constants
 
+import "time"
+
 const (
 	DEFAULT_PLATFORM   = "github"
 	GITHUB_PLATFORM    = "github"
 	JENKINS_PLATFORM   = "jenkins"
 	GITLAB_PLATFORM    = "gitlab"
 	CIRCLE_CI_PLATFORM = "circleci"
+	GITEA_PLATFORM     = "gitea"
 )
 
-var (
-	GITHUB_PATHS    = []string{".github/workflows/", ".github/workflows/*.yaml", ".github/workflows/*.yml"}
-	JENKINS_PATHS   = []string{"Jenkinsfile", "Jenkinsfile.groovy", "Jenkinsfile.jenkins"}
-	GITLAB_PATHS    = []string{".gitlab-ci.yml"}
-	CIRCLE_CI_PATHS = []string{".circleci/config.yml", ".circleci/config.yaml"}
+// Run waiting (polling backoff)
+const (
+	// WAIT_INITIAL_INTERVAL is the interval before the first status poll
+	WAIT_INITIAL_INTERVAL = 2 * time.Second
+
+	// WAIT_MAX_INTERVAL is the maximum interval between two status polls
+	WAIT_MAX_INTERVAL = 30 * time.Second
+
+	// WAIT_BACKOFF_FACTOR is the growth factor of the polling interval
+	WAIT_BACKOFF_FACTOR = 1.5
+)
+
+// Logs search
+const (
+	// SEARCH_WORKERS is the number of runs whose logs are searched concurrently
+	SEARCH_WORKERS = 4
 )
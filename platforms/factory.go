@@ -9,8 +9,6 @@ import (
 	"strings"
 
 	"github.com/ignorant05/Uniflow/internal/config"
-	platforms "github.com/ignorant05/Uniflow/platforms/adapters"
-	"github.com/ignorant05/Uniflow/platforms/configurations/github"
 	"github.com/ignorant05/Uniflow/platforms/constants"
 	"github.com/ignorant05/Uniflow/types"
 )
@@ -60,15 +58,28 @@ func (f *Factory) CreateClientForProfile(ctx context.Context, platform, profileN
 		return nil, err
 	}
 
-	switch platform {
-	case constants.GITHUB_PLATFORM:
-		return f.CreateGithubClient(ctx, profile)
-	default:
+	registered, ok := Lookup(platform)
+	if !ok {
 		return nil, &types.PlatformError{
 			Code:    "unsupported_platform",
 			Message: fmt.Sprintf("Platform %s is not supported.", platform),
 		}
 	}
+
+	section, err := profile.Section(&registered.PlatformSection)
+	if err != nil {
+		return nil, err
+	}
+
+	if section == nil {
+		return nil, &types.PlatformError{
+			Code:     "not_configured",
+			Message:  fmt.Sprintf("%s is not configured for this profile", registered.Name),
+			Platform: registered.Name,
+		}
+	}
+
+	return registered.New(ctx, profile)
 }
 
 // CreateClientAutoDetectPlatform creates a client with/or without the profile name, but it scans the dir to generate a corresponding config
@@ -77,11 +88,10 @@ func (f *Factory) CreateClientForProfile(ctx context.Context, platform, profileN
 // This allows commands to work without --platform flag.
 //
 // Detection strategy:
-//  1. Check for .github/workflows/ directory -> GitHub Actions
-//  2. Check for Jenkinsfile -> Jenkins
-//  3. Check for .gitlab-ci.yml -> GitLab CI
-//  4. Check for .circleci/config.yml -> CircleCI
-//  5. Fall back to default platform from config
+//  1. Check the detection paths of every registered platform (highest confidence first)
+//  2. Fall back to default platform from config
+//
+// NOTE: some platforms share a layout (eg: gitea also runs .github/workflows), the profile decides which backend those talk to
 //
 // Parameters:
 //   - ctx: the context variable
@@ -90,21 +100,96 @@ func (f *Factory) CreateClientForProfile(ctx context.Context, platform, profileN
 // Example:
 // client, err := f.CreateClientAutoDetectPlatform(ctx, "mine")
 func (f *Factory) CreateClientAutoDetectPlatform(ctx context.Context, profileName string) (PlatformClient, error) {
-	cwd, err := os.Getwd()
+	platform, err := f.DetectPlatform(profileName)
 	if err != nil {
 		return nil, err
 	}
 
+	return f.CreateClientForProfile(ctx, platform, profileName)
+}
+
+// DetectPlatform picks the platform of the current working dir (see CreateClientAutoDetectPlatform)
+//
+// Parameters:
+//   - profileName: user selected profile name (default: "default")
+//
+// Example:
+// platform, err := f.DetectPlatform("mine")
+func (f *Factory) DetectPlatform(profileName string) (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
 	platformInfo, err := f.detectPlatformDirectory(cwd)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	if platformInfo.Confidence > 0 {
-		return f.CreateClientForProfile(ctx, platformInfo.Platform, profileName)
+	// nothing detected, falling back to the default platform
+	if platformInfo.Confidence == 0 {
+		return f.Config.DefaultPlatform, nil
 	}
 
-	return f.CreateClientAutoDetectPlatform(ctx, profileName)
+	return f.resolveDetectedPlatform(platformInfo.Platform, profileName)
+}
+
+// resolveDetectedPlatform picks the backend of a detected workflows layout using the profile
+// NOTE: platforms sharing a layout (eg: github and gitea) can't be told apart by the directory alone
+//
+// Priority:
+//  1. the default platform, if it shares the layout and is configured for the profile
+//  2. the only configured platform sharing the layout
+//  3. the detected platform
+//
+// Parameters:
+//   - detected: detected platform name
+//   - profileName: user selected profile name (default: "default")
+//
+// Example:
+// platform, err := f.resolveDetectedPlatform("github", "mine")
+func (f *Factory) resolveDetectedPlatform(detected, profileName string) (string, error) {
+	candidates := []string{detected}
+	for _, platform := range List() {
+		if slices.Contains(platform.SharesLayoutWith, detected) {
+			candidates = append(candidates, platform.Name)
+		}
+	}
+
+	if len(candidates) == 1 {
+		return detected, nil
+	}
+
+	if profileName == "" {
+		profileName = "default"
+	}
+
+	profile, err := f.Config.GetProfile(profileName)
+	if err != nil {
+		return "", err
+	}
+
+	var configured []string
+	for _, candidate := range candidates {
+		platform, ok := Lookup(candidate)
+		if !ok {
+			continue
+		}
+
+		if section, err := profile.Section(&platform.PlatformSection); err == nil && section != nil {
+			configured = append(configured, candidate)
+		}
+	}
+
+	if slices.Contains(configured, f.Config.DefaultPlatform) {
+		return f.Config.DefaultPlatform, nil
+	}
+
+	if len(configured) == 1 {
+		return configured[0], nil
+	}
+
+	return detected, nil
 }
 
 // detectPlatformDirectory detects platforms directory and returns it's information if existed
@@ -115,39 +200,14 @@ func (f *Factory) CreateClientAutoDetectPlatform(ctx context.Context, profileNam
 // Example:
 // info, err := f.detectPlatformDirectory("~/Uniflow")
 func (f *Factory) detectPlatformDirectory(dir string) (*PlatformInfo, error) {
-	detectors := []struct {
-		Platform   string
-		Paths      []string
-		Confidence int
-	}{
-		{
-			Platform:   constants.GITHUB_PLATFORM,
-			Paths:      constants.GITHUB_PATHS,
-			Confidence: 100,
-		},
-		{
-			Platform:   constants.JENKINS_PLATFORM,
-			Paths:      constants.JENKINS_PATHS,
-			Confidence: 100,
-		}, {
-			Platform:   constants.GITLAB_PLATFORM,
-			Paths:      constants.GITLAB_PATHS,
-			Confidence: 100,
-		}, {
-			Platform:   constants.CIRCLE_CI_PLATFORM,
-			Paths:      constants.CIRCLE_CI_PATHS,
-			Confidence: 100,
-		},
-	}
-
-	for _, detector := range detectors {
-		for _, path := range detector.Paths {
+	for _, detector := range detectionOrder() {
+		for _, path := range detector.DetectionPaths {
 			fullFilePattern := filepath.Join(dir, string(path))
 
 			if strings.HasSuffix(fullFilePattern, "/") {
 				if info, err := os.Stat(fullFilePattern); err == nil && info.IsDir() {
 					return &PlatformInfo{
-						Platform:   detector.Platform,
+						Platform:   detector.Name,
 						ConfigPath: fullFilePattern,
 						Confidence: detector.Confidence,
 					}, nil
@@ -155,7 +215,7 @@ func (f *Factory) detectPlatformDirectory(dir string) (*PlatformInfo, error) {
 			} else {
 				if matches, err := filepath.Glob(fullFilePattern); err == nil && len(matches) > 0 {
 					return &PlatformInfo{
-						Platform:   detector.Platform,
+						Platform:   detector.Name,
 						ConfigPath: matches[0],
 						Confidence: detector.Confidence,
 					}, nil
@@ -171,32 +231,7 @@ func (f *Factory) detectPlatformDirectory(dir string) (*PlatformInfo, error) {
 	}, nil
 }
 
-// CreateClientForProfile Creates a client from a config profile
-//
-// Parameters:
-//   - ctx: the context variable
-//   - profile: profile configuration
-//
-// Example:
-// client, err := f.CreateGithubClient(ctx, profile)
-func (f *Factory) CreateGithubClient(ctx context.Context, profile *config.Profile) (PlatformClient, error) {
-	if profile.Github == nil {
-		return nil, &types.PlatformError{
-			Code:     "not_configured",
-			Message:  "Github is not configured for this profile",
-			Platform: "github",
-		}
-	}
-
-	client, err := github.NewClientFromProfile(ctx, profile)
-	if err != nil {
-		return nil, err
-	}
-
-	return platforms.NewGithubAdapter(client)
-}
-
-// ListSupportedPlatforms list all platforms supported by uniflow
+// ListSupportedPlatforms list all platforms supported by uniflow (registered ones, then plugins found on PATH)
 //
 // Parameters:
 //   - None
@@ -204,12 +239,15 @@ func (f *Factory) CreateGithubClient(ctx context.Context, profile *config.Profil
 // Example:
 // supportedPlatforms := ListSupportedPlatforms()
 func ListSupportedPlatforms() []string {
-	return []string{
-		constants.GITHUB_PLATFORM,
-		constants.JENKINS_PLATFORM,
-		constants.GITLAB_PLATFORM,
-		constants.CIRCLE_CI_PLATFORM,
+	supportedPlatforms := config.PlatformNames()
+
+	for _, name := range resolvableNames() {
+		if !slices.Contains(supportedPlatforms, name) {
+			supportedPlatforms = append(supportedPlatforms, name)
+		}
 	}
+
+	return supportedPlatforms
 }
 
 // IsPlatformSupported checks if the platform is supported or not
@@ -220,8 +258,7 @@ func ListSupportedPlatforms() []string {
 // Example:
 // supported := IsPlatformSupported("github")
 func IsPlatformSupported(platform string) bool {
-	platform = strings.ToLower(platform)
-	supportedPlatforms := ListSupportedPlatforms()
+	_, ok := Lookup(platform)
 
-	return slices.Contains(supportedPlatforms, platform)
+	return ok
 }
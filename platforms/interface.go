@@ -32,9 +32,24 @@ type PlatformClient interface {
 	// List all workflow run logs (for a specific workflow)
 	ListWorkflowRunLogs(ctx context.Context, req *types.LogsRequest) (*types.LogsResponse, error)
 
-	// Cancels streaming
+	// Lists the artifacts of a run
+	ListArtifacts(ctx context.Context, req *types.ListArtifactsRequest) ([]*types.Artifact, error)
+
+	// Downloads an artifact of a run
+	DownloadArtifact(ctx context.Context, req *types.DownloadArtifactRequest) (*types.DownloadArtifactResponse, error)
+
+	// Lists the runs waiting for an approval
+	ListPendingApprovals(ctx context.Context, req *types.ListApprovalsRequest) ([]*types.PendingApproval, error)
+
+	// Approves or rejects the pending approvals of a run
+	ReviewApproval(ctx context.Context, req *types.ReviewApprovalRequest) error
+
+	// Cancels a run
 	Cancel(ctx context.Context, req *types.Run) error
 
+	// Reruns a run (or only it's failed jobs)
+	Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error)
+
 	// Retrieves the repository corresponding to the current working dir if exists (format: owner/repo)
 	GetRepository(ctx context.Context) (string, string)
 
@@ -47,3 +62,21 @@ type PlatformClient interface {
 	// Verifies if the current client is a github client
 	IsGithub() bool
 }
+
+// BranchLister is implemented by platforms able to list the repository branches (optional)
+type BranchLister interface {
+	// Lists the repository branches (default branch first)
+	ListBranches(ctx context.Context) ([]string, error)
+}
+
+// InputsDescriber is implemented by platforms able to describe the inputs of a workflow (optional)
+type InputsDescriber interface {
+	// Describes the inputs declared by a workflow on a branch
+	DescribeInputs(ctx context.Context, workflow, branch string) ([]*types.WorkflowInput, error)
+}
+
+// BranchProtectionChecker is implemented by platforms able to tell protected branches (optional)
+type BranchProtectionChecker interface {
+	// Checks whether a branch is protected
+	IsProtectedBranch(ctx context.Context, branch string) (bool, error)
+}
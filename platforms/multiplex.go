@@ -0,0 +1,89 @@
+package platforms
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// RunStream is a run whose logs are streamed along with other runs
+type RunStream struct {
+	Run *types.Run
+
+	// Name labels the lines of the run (eg: workflow name)
+	Name string
+}
+
+// StreamResult is the outcome of a run stream
+type StreamResult struct {
+	Stream *RunStream
+
+	// Status is the status of the run when it's stream ended (nil if it couldn't be retrieved)
+	Status *types.Status
+
+	Err error
+}
+
+// MultiplexCallback receives the log lines of the streamed runs (never concurrently)
+type MultiplexCallback func(stream *RunStream, line *types.LogLine) error
+
+// MultiplexLogs streams the logs of several runs at once, lines are interleaved as they come
+// NOTE: a stream failing doesn't stop the others, done reports every stream as soon as it ends
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - streams: runs to stream
+//   - req: stream request (RunID and WorkflowName are set per run)
+//   - callback: receives the lines
+//   - done: receives the result of each stream (optional)
+//
+// Example:
+// results := platforms.MultiplexLogs(ctx, client, streams, types.LogsStreamRequest{Follow: true}, callback, nil)
+func MultiplexLogs(ctx context.Context, client PlatformClient, streams []*RunStream, req types.LogsStreamRequest, callback MultiplexCallback, done func(result *StreamResult)) []*StreamResult {
+	results := make([]*StreamResult, len(streams))
+
+	// callback and done are serialized
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for idx, stream := range streams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			streamReq := req
+			streamReq.RunID = stream.Run.RunID
+			streamReq.WorkflowName = stream.Run.WorkflowName
+
+			var lineCallback types.LogCallback = func(line *types.LogLine) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				return callback(stream, line)
+			}
+
+			result := &StreamResult{Stream: stream}
+			result.Err = client.StreamLogs(ctx, &streamReq, &lineCallback)
+
+			if ctx.Err() == nil {
+				if status, err := client.GetStatus(ctx, &types.StatusRequest{RunID: stream.Run.RunID}); err == nil {
+					result.Status = status
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			results[idx] = result
+			if done != nil {
+				done(result)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
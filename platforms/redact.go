@@ -0,0 +1,73 @@
+package platforms
+
+import (
+	"context"
+
+	"github.com/ignorant05/Uniflow/internal/redact"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// RedactingClient masks secrets in the streamed and downloaded logs of a client
+type RedactingClient struct {
+	PlatformClient
+
+	Redactor *redact.Redactor
+}
+
+// NewRedactingClient wraps a client with a redactor
+//
+// Parameters:
+//   - client: platform client
+//   - redactor: secrets redactor
+//
+// Example:
+// client = platforms.NewRedactingClient(client, redactor)
+func NewRedactingClient(client PlatformClient, redactor *redact.Redactor) *RedactingClient {
+	return &RedactingClient{PlatformClient: client, Redactor: redactor}
+}
+
+// StreamLogs streams logs with their secrets masked
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//   - callback: logs callback
+//
+// Example:
+// err := c.StreamLogs(ctx, &types.LogsStreamRequest{RunID: 42}, &callback)
+func (c *RedactingClient) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	if callback == nil || *callback == nil {
+		return c.PlatformClient.StreamLogs(ctx, req, callback)
+	}
+
+	next := *callback
+	var redacted types.LogCallback = func(line *types.LogLine) error {
+		return next(c.Redactor.Line(line))
+	}
+
+	return c.PlatformClient.StreamLogs(ctx, req, &redacted)
+}
+
+// ListWorkflowRunLogs downloads logs and masks the secrets of the written files (in place)
+//
+// Parameters:
+//   - ctx: the context variable
+//   - req: the request body
+//
+// Example:
+// logs, err := c.ListWorkflowRunLogs(ctx, &types.LogsRequest{RunID: 42})
+func (c *RedactingClient) ListWorkflowRunLogs(ctx context.Context, req *types.LogsRequest) (*types.LogsResponse, error) {
+	resp, err := c.PlatformClient.ListWorkflowRunLogs(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	// the download may share a directory with other files, only the written ones are redacted
+	for _, path := range resp.Files {
+		if err := c.Redactor.Path(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
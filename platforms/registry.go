@@ -0,0 +1,147 @@
+package platforms
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+)
+
+// Platform describes everything uniflow needs to know about a platform
+// NOTE: the embedded config section is registered along with the platform, so validation, detection and the factory always agree
+type Platform struct {
+	config.PlatformSection
+
+	// DetectionPaths are the files/directories (suffixed with "/") that identify the platform in a repository
+	DetectionPaths []string
+
+	// Confidence of a detection (highest first, then registration order)
+	Confidence int
+
+	// SharesLayoutWith lists the platforms whose layout this platform also runs (eg: gitea runs .github/workflows)
+	SharesLayoutWith []string
+
+	// New creates a platform client from a profile
+	New func(ctx context.Context, profile *config.Profile) (PlatformClient, error)
+}
+
+// Resolver resolves platforms that aren't compiled in (eg: plugins)
+type Resolver interface {
+	// Resolve returns the platform of a name (if it can be resolved)
+	Resolve(name string) (*Platform, bool)
+
+	// Names lists the names of the platforms that can be resolved
+	Names() []string
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   []*Platform
+	resolvers  []Resolver
+)
+
+// Register registers (or replaces) a platform
+// NOTE: meant to be called from an init function
+//
+// Parameters:
+//   - platform: platform description
+//
+// Example:
+// platforms.Register(&platforms.Platform{PlatformSection: config.PlatformSection{Name: "github", ...}, New: newGithub})
+func Register(platform *Platform) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	config.RegisterSection(&platform.PlatformSection)
+
+	for idx, registered := range registry {
+		if registered.Name == platform.Name {
+			registry[idx] = platform
+			return
+		}
+	}
+
+	registry = append(registry, platform)
+}
+
+// RegisterResolver registers a resolver, used when no registered platform matches a name
+// NOTE: meant to be called from an init function
+//
+// Parameters:
+//   - resolver: platform resolver
+//
+// Example:
+// platforms.RegisterResolver(pluginResolver{})
+func RegisterResolver(resolver Resolver) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	resolvers = append(resolvers, resolver)
+}
+
+// Lookup returns a platform by name, falling back to the resolvers for unregistered names
+//
+// Parameters:
+//   - name: platform name
+//
+// Example:
+// platform, ok := platforms.Lookup("github")
+func Lookup(name string) (*Platform, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	name = strings.ToLower(name)
+	for _, platform := range registry {
+		if platform.Name == name {
+			return platform, true
+		}
+	}
+
+	for _, resolver := range resolvers {
+		if platform, ok := resolver.Resolve(name); ok {
+			return platform, true
+		}
+	}
+
+	return nil, false
+}
+
+// List lists the registered platforms (in registration order)
+//
+// Parameters:
+//   - None
+//
+// Example:
+// for _, platform := range platforms.List() {...}
+func List() []*Platform {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	return append([]*Platform(nil), registry...)
+}
+
+// resolvableNames lists the names the resolvers can resolve
+func resolvableNames() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	var names []string
+	for _, resolver := range resolvers {
+		names = append(names, resolver.Names()...)
+	}
+
+	return names
+}
+
+// detectionOrder lists the registered platforms by decreasing detection confidence
+func detectionOrder() []*Platform {
+	ordered := List()
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Confidence > ordered[j].Confidence
+	})
+
+	return ordered
+}
@@ -0,0 +1,157 @@
+package platforms
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	"github.com/ignorant05/Uniflow/platforms/constants"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// LogMatch is a log line matching a search, with it's context
+type LogMatch struct {
+	Run  *types.Run
+	Line *types.LogLine
+
+	// Before and After are the context lines (same job)
+	Before []*types.LogLine
+	After  []*types.LogLine
+}
+
+// SearchOptions are the options of a logs search
+type SearchOptions struct {
+	// Before and After are the number of context lines (grep -B/-A)
+	Before int
+	After  int
+
+	// Workers is the number of runs searched concurrently (default: constants.SEARCH_WORKERS)
+	Workers int
+}
+
+// RunSearchError is the error of a run whose logs couldn't be searched
+type RunSearchError struct {
+	Run *types.Run
+	Err error
+}
+
+// SearchLogs downloads the logs of runs concurrently (bounded worker pool) and greps them
+// NOTE: a run failing doesn't stop the search, it's error is returned along with the matches of the other runs
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - runs: runs to search
+//   - pattern: regular expression matched against the line contents
+//   - opts: search options
+//
+// Example:
+// matches, failures := platforms.SearchLogs(ctx, client, runs, regexp.MustCompile("flaky"), platforms.SearchOptions{After: 2})
+func SearchLogs(ctx context.Context, client PlatformClient, runs []*types.Run, pattern *regexp.Regexp, opts SearchOptions) ([]*LogMatch, []*RunSearchError) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = constants.SEARCH_WORKERS
+	}
+
+	// results are stored per run, to report them in the order of the runs
+	matches := make([][]*LogMatch, len(runs))
+	failures := make([]*RunSearchError, len(runs))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for range min(workers, len(runs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for idx := range indexes {
+				runMatches, err := searchRun(ctx, client, runs[idx], pattern, opts)
+				if err != nil {
+					failures[idx] = &RunSearchError{Run: runs[idx], Err: err}
+				}
+				matches[idx] = runMatches
+			}
+		}()
+	}
+
+	for idx := range runs {
+		if ctx.Err() != nil {
+			break
+		}
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+
+	var allMatches []*LogMatch
+	var allFailures []*RunSearchError
+	for idx := range runs {
+		allMatches = append(allMatches, matches[idx]...)
+		if failures[idx] != nil {
+			allFailures = append(allFailures, failures[idx])
+		}
+	}
+
+	return allMatches, allFailures
+}
+
+// searchRun greps the logs of a run
+func searchRun(ctx context.Context, client PlatformClient, run *types.Run, pattern *regexp.Regexp, opts SearchOptions) ([]*LogMatch, error) {
+	var (
+		matches []*LogMatch
+		job     string
+		before  []*types.LogLine
+		// pending are the matches still waiting for their context after
+		pending []*LogMatch
+	)
+
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		// the context doesn't cross jobs
+		if line.JobName != job {
+			job, before, pending = line.JobName, nil, nil
+		}
+
+		// markers have no content of their own
+		if line.Marker == types.LOG_GROUP_END {
+			return nil
+		}
+
+		kept := pending[:0]
+		for _, match := range pending {
+			match.After = append(match.After, line)
+			if len(match.After) < opts.After {
+				kept = append(kept, match)
+			}
+		}
+		pending = kept
+
+		if pattern.MatchString(line.Content) {
+			match := &LogMatch{
+				Run:    run,
+				Line:   line,
+				Before: append([]*types.LogLine(nil), before...),
+			}
+			matches = append(matches, match)
+
+			if opts.After > 0 {
+				pending = append(pending, match)
+			}
+		}
+
+		if opts.Before > 0 {
+			before = append(before, line)
+			if len(before) > opts.Before {
+				before = before[1:]
+			}
+		}
+
+		return nil
+	}
+
+	if err := client.StreamLogs(ctx, &types.LogsStreamRequest{RunID: run.RunID}, &callback); err != nil {
+		return matches, err
+	}
+
+	return matches, nil
+}
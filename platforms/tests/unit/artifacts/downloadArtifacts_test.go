@@ -0,0 +1,99 @@
+package artifacts_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// artifactsClient is a platform client "downloading" artifacts, it tracks the concurrent downloads
+type artifactsClient struct {
+	platforms.PlatformClient
+
+	mu      sync.Mutex
+	running int
+	peak    int
+}
+
+func (c *artifactsClient) DownloadArtifact(ctx context.Context, req *types.DownloadArtifactRequest) (*types.DownloadArtifactResponse, error) {
+	c.mu.Lock()
+	c.running++
+	c.peak = max(c.peak, c.running)
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.running--
+		c.mu.Unlock()
+	}()
+
+	if req.Artifact.Name == "broken" {
+		return nil, errors.New("download failed")
+	}
+
+	for downloaded := int64(1); downloaded <= req.Artifact.Size; downloaded++ {
+		req.Progress(downloaded)
+	}
+
+	return &types.DownloadArtifactResponse{Path: req.Dest + "/" + req.Artifact.Name + ".zip", Size: req.Artifact.Size}, nil
+}
+
+// Testing every artifact is downloaded, at most parallel at a time
+func TestDownloadArtifacts(t *testing.T) {
+	client := &artifactsClient{}
+
+	var artifacts []*types.Artifact
+	for _, name := range []string{"dist", "coverage", "broken", "docs", "reports"} {
+		artifacts = append(artifacts, &types.Artifact{Name: name, Size: 50})
+	}
+
+	// progress and done aren't called concurrently, the race detector tells otherwise
+	progress := map[string]int64{}
+	var done []string
+
+	results := platforms.DownloadArtifacts(context.Background(), client, types.DownloadArtifactRequest{RunID: 42, Dest: "out"}, artifacts, 2,
+		func(artifact *types.Artifact, downloaded int64) {
+			progress[artifact.Name] = downloaded
+		},
+		func(result *platforms.ArtifactResult) {
+			done = append(done, result.Artifact.Name)
+		},
+	)
+
+	require.Len(t, results, 5)
+	assert.Len(t, done, 5)
+	assert.LessOrEqual(t, client.peak, 2)
+
+	for idx, result := range results {
+		assert.Same(t, artifacts[idx], result.Artifact)
+
+		if result.Artifact.Name == "broken" {
+			assert.Error(t, result.Err)
+			assert.Nil(t, result.Response)
+			continue
+		}
+
+		require.NoError(t, result.Err)
+		assert.Equal(t, "out/"+result.Artifact.Name+".zip", result.Response.Path)
+		assert.Equal(t, int64(50), progress[result.Artifact.Name])
+	}
+}
+
+// Testing pending downloads aren't started once the context is cancelled
+func TestDownloadArtifacts_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := platforms.DownloadArtifacts(ctx, &artifactsClient{}, types.DownloadArtifactRequest{}, []*types.Artifact{{Name: "dist"}}, 0, nil, nil)
+
+	require.Len(t, results, 1)
+	assert.ErrorIs(t, results[0].Err, context.Canceled)
+	assert.Nil(t, results[0].Response)
+}
@@ -0,0 +1,288 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/internal/cache"
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runClient is a platform client serving a single run, counting the logs requests
+type runClient struct {
+	platforms.PlatformClient
+
+	status  string
+	attempt int
+	jobs    []*types.WorkflowJob
+	lines   []*types.LogLine
+	offline bool
+
+	streams [][]int64
+}
+
+func (c *runClient) GetRepository(ctx context.Context) (string, string) {
+	return "ignorant05", "Uniflow"
+}
+
+func (c *runClient) GetStatus(ctx context.Context, req *types.StatusRequest) (*types.Status, error) {
+	if c.offline {
+		return nil, errors.New("offline")
+	}
+
+	return &types.Status{RunID: req.RunID, Status: c.status, Metadata: map[string]interface{}{"attempt": c.attempt}}, nil
+}
+
+func (c *runClient) ListWorkflowJobs(ctx context.Context, req *types.ListWokflowJobsRequest) ([]*types.WorkflowJob, error) {
+	if c.offline {
+		return nil, errors.New("offline")
+	}
+
+	return c.jobs, nil
+}
+
+func (c *runClient) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	if c.offline {
+		return errors.New("offline")
+	}
+
+	c.streams = append(c.streams, req.JobIDs)
+	for _, line := range c.lines {
+		// lines without a job ID belong to the job of their name
+		if line.JobID == 0 {
+			streamed := *line
+			for _, job := range c.jobs {
+				if job.Name == line.JobName {
+					streamed.JobID = job.ID
+				}
+			}
+			line = &streamed
+		}
+
+		if err := (*callback)(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *runClient) Rerun(ctx context.Context, req *types.RerunRequest) (*types.RerunResponse, error) {
+	c.rerun()
+
+	return &types.RerunResponse{RunID: req.RunID}, nil
+}
+
+// rerun starts a new attempt of the run, it comes with new jobs
+func (c *runClient) rerun() {
+	c.attempt++
+	c.jobs = []*types.WorkflowJob{{ID: 3, Name: "build"}, {ID: 4, Name: "test"}}
+}
+
+func newRunClient(status string) *runClient {
+	return &runClient{
+		status:  status,
+		attempt: 1,
+		jobs:    []*types.WorkflowJob{{ID: 1, Name: "build"}, {ID: 2, Name: "test"}},
+		lines: []*types.LogLine{
+			{JobName: "build", Content: "compiling"},
+			{JobName: "test", Content: "ok"},
+			{JobName: "test", Content: "FAIL", Step: "Run tests"},
+		},
+	}
+}
+
+func collect(t *testing.T, client platforms.PlatformClient, req *types.LogsStreamRequest) []string {
+	var contents []string
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		contents = append(contents, line.JobName+": "+line.Content)
+		return nil
+	}
+
+	require.NoError(t, client.StreamLogs(context.Background(), req, &callback))
+	return contents
+}
+
+// Testing the logs of a completed run are served from the cache (also offline)
+func TestCachedClient_CompletedRun(t *testing.T) {
+	inner := newRunClient("completed")
+	client := platforms.NewCachedClient(inner, cache.New(t.TempDir(), 0), "github")
+
+	want := []string{"build: compiling", "test: ok", "test: FAIL"}
+	assert.Equal(t, want, collect(t, client, &types.LogsStreamRequest{RunID: 42}))
+	require.Len(t, inner.streams, 1)
+
+	inner.offline = true
+	assert.Equal(t, want, collect(t, client, &types.LogsStreamRequest{RunID: 42}))
+	assert.Equal(t, []string{"test: FAIL"}, collect(t, client, &types.LogsStreamRequest{RunID: 42, Tail: 1}))
+	assert.Equal(t, []string{"test: ok", "test: FAIL"}, collect(t, client, &types.LogsStreamRequest{RunID: 42, JobIDs: []int64{2}}))
+	assert.Len(t, inner.streams, 1)
+
+	jobs, err := client.ListWorkflowJobs(context.Background(), &types.ListWokflowJobsRequest{RunID: 42})
+	require.NoError(t, err)
+	assert.Len(t, jobs, 2)
+}
+
+// Testing only the jobs missing from the cache are fetched
+func TestCachedClient_MissingJobs(t *testing.T) {
+	inner := newRunClient("completed")
+	client := platforms.NewCachedClient(inner, cache.New(t.TempDir(), 0), "github")
+
+	assert.Equal(t, []string{"test: ok", "test: FAIL"}, collect(t, client, &types.LogsStreamRequest{RunID: 42, JobIDs: []int64{2}}))
+	assert.Equal(t, []string{"build: compiling", "test: ok", "test: FAIL"}, collect(t, client, &types.LogsStreamRequest{RunID: 42}))
+
+	assert.Equal(t, [][]int64{{2}, {1}}, inner.streams)
+}
+
+// Testing the logs of a running run are never cached
+func TestCachedClient_RunningRun(t *testing.T) {
+	inner := newRunClient("in_progress")
+	client := platforms.NewCachedClient(inner, cache.New(t.TempDir(), 0), "github")
+
+	collect(t, client, &types.LogsStreamRequest{RunID: 42})
+	collect(t, client, &types.LogsStreamRequest{RunID: 42})
+
+	assert.Len(t, inner.streams, 2)
+}
+
+// Testing a run rerun in place is fetched again
+func TestCachedClient_Rerun(t *testing.T) {
+	inner := newRunClient("completed")
+	client := platforms.NewCachedClient(inner, cache.New(t.TempDir(), 0), "github")
+
+	collect(t, client, &types.LogsStreamRequest{RunID: 42})
+
+	_, err := client.Rerun(context.Background(), &types.RerunRequest{RunID: 42})
+	require.NoError(t, err)
+
+	jobs, err := client.ListWorkflowJobs(context.Background(), &types.ListWokflowJobsRequest{RunID: 42})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), jobs[0].ID)
+
+	collect(t, client, &types.LogsStreamRequest{RunID: 42})
+	assert.Equal(t, [][]int64{{1, 2}, {3, 4}}, inner.streams)
+}
+
+// Testing a run rerun elsewhere (eg: from the web UI) is fetched again, the last attempt is served offline
+func TestCachedClient_RerunElsewhere(t *testing.T) {
+	inner := newRunClient("completed")
+	client := platforms.NewCachedClient(inner, cache.New(t.TempDir(), 0), "github")
+
+	collect(t, client, &types.LogsStreamRequest{RunID: 42})
+
+	inner.rerun()
+	collect(t, client, &types.LogsStreamRequest{RunID: 42})
+	assert.Equal(t, [][]int64{{1, 2}, {3, 4}}, inner.streams)
+
+	inner.offline = true
+	jobs, err := client.ListWorkflowJobs(context.Background(), &types.ListWokflowJobsRequest{RunID: 42})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), jobs[0].ID)
+}
+
+// Testing jobs sharing a name (eg: retries, matrix jobs) are cached apart
+func TestCachedClient_SameJobNames(t *testing.T) {
+	inner := newRunClient("completed")
+	inner.jobs = []*types.WorkflowJob{{ID: 1, Name: "build"}, {ID: 5, Name: "build"}}
+	inner.lines = []*types.LogLine{
+		{JobName: "build", JobID: 1, Content: "first try"},
+		{JobName: "build", JobID: 5, Content: "retried"},
+	}
+	client := platforms.NewCachedClient(inner, cache.New(t.TempDir(), 0), "gitlab")
+
+	assert.Equal(t, []string{"build: first try", "build: retried"}, collect(t, client, &types.LogsStreamRequest{RunID: 42}))
+
+	inner.offline = true
+	assert.Equal(t, []string{"build: retried"}, collect(t, client, &types.LogsStreamRequest{RunID: 42, JobIDs: []int64{5}}))
+	assert.Equal(t, []string{"build: first try", "build: retried"}, collect(t, client, &types.LogsStreamRequest{RunID: 42}))
+}
+
+// Testing the runs of different workflows sharing a run ID are cached apart (eg: jenkins build numbers)
+func TestCachedClient_Workflows(t *testing.T) {
+	inner := newRunClient("completed")
+	client := platforms.NewCachedClient(inner, cache.New(t.TempDir(), 0), "jenkins")
+
+	assert.Equal(t, []string{"build: compiling", "test: ok", "test: FAIL"}, collect(t, client, &types.LogsStreamRequest{RunID: 42, WorkflowName: "api"}))
+
+	inner.lines = []*types.LogLine{{JobName: "build", Content: "bundling"}, {JobName: "test", Content: "ok"}}
+	assert.Equal(t, []string{"build: bundling", "test: ok"}, collect(t, client, &types.LogsStreamRequest{RunID: 42, WorkflowName: "web"}))
+	assert.Len(t, inner.streams, 2)
+}
+
+// Testing writes only evict once the cache outgrows it's maximum size
+func TestCache_PutEvicts(t *testing.T) {
+	store := cache.New(t.TempDir(), 1000)
+
+	var keys []string
+	for idx := range 4 {
+		key := cache.Key("github", "a", string(rune('1'+idx)))
+		keys = append(keys, key)
+		require.NoError(t, store.Put(key, make([]byte, 300)))
+
+		past := time.Now().Add(time.Duration(idx-10) * time.Minute)
+		require.NoError(t, os.Chtimes(filepath.Join(store.Dir, key[:2], key), past, past))
+
+		stats, err := store.Stats()
+		require.NoError(t, err)
+		if idx < 3 {
+			assert.Equal(t, int64(300*(idx+1)), stats.Size, "no eviction below the maximum size")
+		}
+	}
+
+	// 1200 bytes, evicted down to 900
+	stats, err := store.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, int64(900), stats.Size)
+
+	_, ok := store.Get(keys[0])
+	assert.False(t, ok)
+	_, ok = store.Get(keys[3])
+	assert.True(t, ok)
+
+	// rewriting an entry doesn't grow the cache
+	require.NoError(t, store.Put(keys[3], make([]byte, 300)))
+	stats, err = store.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Entries)
+}
+
+// Testing the cache evicts the least recently used entries
+func TestCache_LRU(t *testing.T) {
+	store := cache.New(t.TempDir(), 0)
+
+	keys := []string{cache.Key("github", "a", "1"), cache.Key("github", "a", "2"), cache.Key("github", "a", "3")}
+	for idx, key := range keys {
+		require.NoError(t, store.Put(key, make([]byte, 100)))
+
+		// older accesses first
+		past := time.Now().Add(time.Duration(idx-10) * time.Minute)
+		require.NoError(t, os.Chtimes(filepath.Join(store.Dir, key[:2], key), past, past))
+	}
+
+	// reading the oldest entry makes it the most recent
+	_, ok := store.Get(keys[0])
+	require.True(t, ok)
+
+	stats, err := store.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Entries)
+	assert.Equal(t, int64(300), stats.Size)
+
+	removed, freed, err := store.Prune(150)
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, int64(200), freed)
+
+	_, ok = store.Get(keys[0])
+	assert.True(t, ok)
+	_, ok = store.Get(keys[1])
+	assert.False(t, ok)
+}
@@ -0,0 +1,52 @@
+package circleci_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing client creation with a valid configuration (circleci.com)
+func TestClientWithConfig(t *testing.T) {
+	cfg := &circleci.Config{
+		Token:       "random-gibbrich-as-token",
+		ProjectSlug: "gh/ignorant05/uniflow",
+	}
+
+	client, err := circleci.NewClient(context.Background(), cfg)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://circleci.com/", client.BaseURL.String())
+}
+
+// Testing client creation with token from env
+func TestClientWithTokenFromEnv(t *testing.T) {
+	t.Setenv("CIRCLECI_TOKEN", "token-from-env")
+
+	client, err := circleci.NewClient(context.Background(), &circleci.Config{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "token-from-env", client.Config.Token)
+}
+
+// Testing client creation without token
+func TestClientWithoutToken(t *testing.T) {
+	t.Setenv("CIRCLECI_TOKEN", "")
+
+	_, err := circleci.NewClient(context.Background(), &circleci.Config{})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "CIRCLECI_TOKEN")
+}
+
+// Testing client creation from profile with invalid circleci field
+func TestClientFromProfile_Failure(t *testing.T) {
+	_, err := circleci.NewClientFromProfile(context.Background(), &config.Profile{})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "CircleCI isn't configured for this profile")
+}
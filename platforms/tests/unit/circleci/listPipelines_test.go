@@ -0,0 +1,99 @@
+package circleci_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/circleci"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing ListWorkflowRuns aggregates workflows into a run status
+func TestListWorkflowRuns_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response string
+
+		switch r.URL.Path {
+		case "/api/v2/project/gh/ignorant05/uniflow/pipeline":
+			assert.Equal(t, "main", r.URL.Query().Get("branch"))
+			response = `{"items":[
+				{"id":"p2","number":2,"state":"created","trigger":{"type":"api","actor":{"login":"ignorant05"}},"vcs":{"branch":"main","revision":"a1b2c3d"}},
+				{"id":"p1","number":1,"state":"created"}
+			]}`
+		case "/api/v2/pipeline/p2/workflow":
+			response = `{"items":[{"id":"w1","name":"build","status":"success"},{"id":"w2","name":"test","status":"failed"}]}`
+		case "/api/v2/pipeline/p1/workflow":
+			response = `{"items":[{"id":"w3","name":"build","status":"running"}]}`
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		_, _ = w.Write([]byte(response))
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewCircleCIAdapter(client)
+	require.NoError(t, err)
+
+	runs, err := adapter.ListWorkflowRuns(client.Ctx, &types.ListWorkflowRunsRequest{Branch: "main", Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, int64(2), runs[0].RunID)
+	assert.Equal(t, "completed", runs[0].Status)
+	assert.Equal(t, "failure", runs[0].Conclusion)
+	assert.Equal(t, "ignorant05", runs[0].Actor)
+	assert.Equal(t, "a1b2c3d", runs[0].CommitSHA)
+	assert.Equal(t, "in_progress", runs[1].Status)
+}
+
+// Testing ListWorkflowJobs flattens the jobs of every workflow
+func TestListWorkflowJobs_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.Path {
+		case "/api/v2/project/gh/ignorant05/uniflow/pipeline/42":
+			response = map[string]interface{}{"id": "p42", "number": 42, "state": "created"}
+		case "/api/v2/pipeline/p42/workflow":
+			response = map[string]interface{}{"items": []map[string]interface{}{
+				{"id": "w1", "name": "build", "status": "on_hold"},
+				{"id": "w2", "name": "deploy", "status": "not_run"},
+			}}
+		case "/api/v2/workflow/w1/job":
+			response = map[string]interface{}{"items": []map[string]interface{}{
+				{"id": "j1", "job_number": 101, "name": "compile", "status": "success"},
+				{"id": "j2", "name": "hold", "type": "approval", "status": "on_hold"},
+			}}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewCircleCIAdapter(client)
+	require.NoError(t, err)
+
+	jobs, err := adapter.ListWorkflowJobs(client.Ctx, &types.ListWokflowJobsRequest{RunID: 42, WorkflowName: "build"})
+
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, int64(101), jobs[0].ID)
+	assert.Equal(t, "build", jobs[0].WorkflowName)
+	assert.Equal(t, "success", jobs[0].Conclusion)
+	assert.Equal(t, "waiting", jobs[1].Status)
+}
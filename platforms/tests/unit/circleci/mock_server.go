@@ -0,0 +1,27 @@
+package circleci
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci"
+	"github.com/stretchr/testify/require"
+)
+
+// Setting up client with mock server
+func SetupTestClientWithMockServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *circleci.Client) {
+	server := httptest.NewServer(handler)
+
+	cfg := &circleci.Config{
+		Token:       "random-gibbrich-as-token",
+		BaseURL:     server.URL,
+		ProjectSlug: "gh/ignorant05/uniflow",
+	}
+
+	client, err := circleci.NewClient(context.Background(), cfg)
+	require.NoError(t, err)
+
+	return server, client
+}
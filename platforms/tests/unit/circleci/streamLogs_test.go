@@ -0,0 +1,72 @@
+package circleci_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/circleci"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing StreamLogs delivers step outputs of finished jobs
+func TestStreamLogs_Success(t *testing.T) {
+	var serverURL string
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response string
+
+		switch r.URL.Path {
+		case "/api/v2/project/gh/ignorant05/uniflow/pipeline/42":
+			response = `{"id":"p42","number":42,"state":"created"}`
+		case "/api/v2/pipeline/p42/workflow":
+			response = `{"items":[{"id":"w1","name":"build","status":"failed"}]}`
+		case "/api/v2/workflow/w1/job":
+			response = `{"items":[
+				{"id":"j1","job_number":101,"name":"test","status":"failed","started_at":"2025-01-01T00:00:00Z"},
+				{"id":"j2","job_number":102,"name":"deploy","status":"not_run"}
+			]}`
+		case "/api/v1.1/project/github/ignorant05/uniflow/101":
+			response = strings.ReplaceAll(`{"build_num":101,"steps":[
+				{"name":"Checkout","actions":[{"index":0,"step":0,"name":"Checkout","status":"success","has_output":true,"output_url":"URL/output/0"}]},
+				{"name":"Test","actions":[{"index":0,"step":1,"name":"Test","status":"failed","has_output":true,"output_url":"URL/output/1"}]}
+			]}`, "URL", serverURL)
+		case "/output/0":
+			assert.Empty(t, r.Header.Get("Circle-Token"))
+			response = `[{"message":"Cloning repository\r\n","type":"out"}]`
+		case "/output/1":
+			response = `[{"message":"running tests\nexit status 1\n","type":"err"}]`
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		_, _ = w.Write([]byte(response))
+	})
+
+	defer server.Close()
+
+	// step outputs are served from another host, the token must not leak there
+	serverURL = strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	adapter, err := adapters.NewCircleCIAdapter(client)
+	require.NoError(t, err)
+
+	var lines []*types.LogLine
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line)
+		return nil
+	}
+
+	err = adapter.StreamLogs(client.Ctx, &types.LogsStreamRequest{RunID: 42, Tail: 2}, &callback)
+
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "running tests", lines[0].Content)
+	assert.Equal(t, "test", lines[0].JobName)
+	assert.Equal(t, "error", lines[1].Level)
+}
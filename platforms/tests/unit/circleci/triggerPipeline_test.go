@@ -0,0 +1,70 @@
+package circleci_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/circleci"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/circleci"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing adapter TriggerWorkflow passes typed parameters on a branch
+func TestTriggerWorkflowWithParameters_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/project/gh/ignorant05/uniflow/pipeline", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "random-gibbrich-as-token", r.Header.Get("Circle-Token"))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "release/1.2", body["branch"])
+		assert.Equal(t, map[string]interface{}{
+			"deploy":   true,
+			"replicas": float64(3),
+			"flavor":   "beta",
+		}, body["parameters"])
+
+		w.WriteHeader(http.StatusCreated)
+		err := json.NewEncoder(w).Encode(circleci.Pipeline{ID: "5034460f", Number: 42, State: "pending"})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewCircleCIAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{
+		Branch: "release/1.2",
+		Inputs: map[string]interface{}{"deploy": "true", "replicas": "3", "flavor": "beta"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), resp.RunID)
+	assert.Equal(t, "queued", resp.Status)
+	assert.Contains(t, resp.URL, "/pipelines/gh/ignorant05/uniflow/42")
+}
+
+// Testing TriggerPipeline, (Failure: undeclared parameter)
+func TestTriggerPipeline_InvalidParameter(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Unexpected argument(s): nope"}`))
+	})
+
+	defer server.Close()
+
+	_, err := client.TriggerPipeline("gh/ignorant05/uniflow", "main", map[string]interface{}{"nope": 1})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Unexpected argument(s): nope")
+}
@@ -0,0 +1,84 @@
+package factory_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupWorkDir creates a working directory containing the given paths and moves into it
+func setupWorkDir(t *testing.T, paths ...string) {
+	dir := t.TempDir()
+
+	for _, path := range paths {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, path), 0755))
+	}
+
+	t.Chdir(dir)
+}
+
+func giteaConfig() *gitea.Config {
+	return &gitea.Config{
+		Token:             "random-gibbrich-as-token",
+		BaseURL:           "https://gitea.company.com",
+		DefaultRepository: "ignorant05/uniflow",
+	}
+}
+
+// Testing a .github/workflows layout talks to gitea when only gitea is configured
+func TestAutoDetect_GithubLayoutWithGiteaProfile(t *testing.T) {
+	setupWorkDir(t, ".github/workflows")
+
+	cfg := &config.Config{
+		DefaultPlatform: "github",
+		Profiles:        map[string]*config.Profile{"default": {Platforms: map[string]interface{}{"gitea": giteaConfig()}}},
+	}
+
+	client, err := platforms.NewFactory(cfg).CreateClientAutoDetectPlatform(context.Background(), "")
+
+	require.NoError(t, err)
+	assert.IsType(t, &adapters.GiteaAdapter{}, client)
+}
+
+// Testing the default platform decides when both backends are configured
+func TestAutoDetect_GithubLayoutWithDefaultPlatform(t *testing.T) {
+	setupWorkDir(t, ".github/workflows")
+
+	cfg := &config.Config{
+		DefaultPlatform: "gitea",
+		Profiles: map[string]*config.Profile{"default": {Platforms: map[string]interface{}{
+			"github": &github.Config{Token: "random-gibbrich-as-token", DefaultRepository: "ignorant05/uniflow"},
+			"gitea":  giteaConfig(),
+		}}},
+	}
+
+	client, err := platforms.NewFactory(cfg).CreateClientAutoDetectPlatform(context.Background(), "")
+
+	require.NoError(t, err)
+	assert.IsType(t, &adapters.GiteaAdapter{}, client)
+}
+
+// Testing nothing detected falls back to the default platform (instead of recursing forever)
+func TestAutoDetect_NothingDetected(t *testing.T) {
+	setupWorkDir(t)
+
+	cfg := &config.Config{
+		DefaultPlatform: "gitea",
+		Profiles:        map[string]*config.Profile{"default": {Platforms: map[string]interface{}{"gitea": giteaConfig()}}},
+	}
+
+	client, err := platforms.NewFactory(cfg).CreateClientAutoDetectPlatform(context.Background(), "")
+
+	require.NoError(t, err)
+	assert.IsType(t, &adapters.GiteaAdapter{}, client)
+}
@@ -0,0 +1,151 @@
+package factory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms"
+	_ "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing the registry, config validation and the factory agree on the supported platforms
+func TestRegistry_SupportedPlatforms(t *testing.T) {
+	for _, name := range []string{"github", "jenkins", "gitlab", "circleci", "gitea"} {
+		_, ok := platforms.Lookup(name)
+		assert.True(t, ok, name)
+	}
+
+	assert.ElementsMatch(t, config.PlatformNames(), platforms.ListSupportedPlatforms())
+	assert.True(t, platforms.IsPlatformSupported("GitHub"))
+	assert.False(t, platforms.IsPlatformSupported("travis"))
+}
+
+// Testing config validation uses the registered validators
+func TestRegistry_Validate(t *testing.T) {
+	cfg := &config.Config{
+		DefaultPlatform: "travis",
+		Profiles: map[string]*config.Profile{
+			"default": {Platforms: map[string]interface{}{
+				"gitea": map[string]interface{}{"token": "random-gibbrich-as-token", "base_url": "https://gitea.company.com", "default_repository": "uniflow"},
+			}},
+			"empty": {},
+		},
+	}
+
+	errs := cfg.Validate()
+
+	var fields []string
+	for _, err := range errs {
+		fields = append(fields, err.(*config.ValidationError).Field)
+	}
+
+	assert.Contains(t, fields, "default_platform")
+	assert.Contains(t, fields, "profiles.empty")
+	assert.Contains(t, fields, "profiles.default.gitea.default_repository")
+}
+
+// Testing a valid github section passes the validation, and unknown fields are reported
+func TestRegistry_ValidateGithub(t *testing.T) {
+	github := map[string]interface{}{"token": "random-gibbrich-as-token", "base_url": "https://api.github.com", "default_repository": "ignorant05/Uniflow"}
+	cfg := &config.Config{
+		DefaultPlatform: "github",
+		Profiles:        map[string]*config.Profile{"default": {Platforms: map[string]interface{}{"github": github}}},
+	}
+
+	assert.Empty(t, cfg.Validate())
+
+	github["default_repositry"] = "ignorant05/Uniflow"
+	delete(github, "default_repository")
+
+	var fields []string
+	for _, err := range cfg.Validate() {
+		fields = append(fields, err.(*config.ValidationError).Field)
+	}
+
+	assert.ElementsMatch(t, []string{"profiles.default.github.default_repository", "profiles.default.github.default_repositry"}, fields)
+}
+
+// Testing the factory errors on unknown and unconfigured platforms
+func TestRegistry_FactoryErrors(t *testing.T) {
+	cfg := &config.Config{
+		DefaultPlatform: "github",
+		Profiles:        map[string]*config.Profile{"default": {Platforms: map[string]interface{}{"gitea": giteaConfig()}}},
+	}
+
+	factory := platforms.NewFactory(cfg)
+
+	_, err := factory.CreateClientForProfile(context.Background(), "travis", "")
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "unsupported_platform", platformErr.Code)
+
+	_, err = factory.CreateClientForProfile(context.Background(), "jenkins", "")
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_configured", platformErr.Code)
+	assert.Equal(t, "jenkins", platformErr.Platform)
+}
+
+// acmeConfig is the section of a platform registered by a test
+type acmeConfig struct {
+	Token   string `yaml:"token"`
+	Project string `yaml:"project,omitempty"`
+	Retries int    `yaml:"retries,omitempty"`
+}
+
+// Testing a platform is configured through it's registration only
+func TestRegistry_SelfContainedPlatform(t *testing.T) {
+	errAcme := errors.New("acme client")
+
+	platforms.Register(&platforms.Platform{
+		PlatformSection: config.PlatformSection{
+			Name:   "acme",
+			Title:  "Acme",
+			Decode: config.DecodeSection[acmeConfig],
+			Validate: func(prefix string, section interface{}) []error {
+				if section.(*acmeConfig).Project == "" {
+					return []error{&config.ValidationError{Field: prefix + ".project", Message: "required"}}
+				}
+
+				return nil
+			},
+			SecretFields: []string{"token"},
+		},
+		New: func(ctx context.Context, profile *config.Profile) (platforms.PlatformClient, error) {
+			return nil, errAcme
+		},
+	})
+
+	cfg := &config.Config{
+		DefaultPlatform: "acme",
+		Profiles: map[string]*config.Profile{
+			"default": {Platforms: map[string]interface{}{"acme": map[string]interface{}{"token": "acme-secret-token"}}},
+		},
+	}
+
+	var fields []string
+	for _, err := range cfg.Validate() {
+		fields = append(fields, err.(*config.ValidationError).Field)
+	}
+	assert.Equal(t, []string{"profiles.default.acme.project"}, fields)
+
+	assert.Contains(t, cfg.Secrets(), "acme-secret-token")
+
+	section, ok := config.LookupSection("acme")
+	require.True(t, ok)
+
+	decoded, err := cfg.Profiles["default"].GetPlatform("acme")
+	require.NoError(t, err)
+	require.NoError(t, section.SetField(decoded, "retries", "3"))
+	assert.Equal(t, 3, decoded.(*acmeConfig).Retries)
+	assert.Error(t, section.SetField(decoded, "retries", "three"))
+	assert.Error(t, section.SetField(decoded, "region", "eu"))
+
+	_, err = platforms.NewFactory(cfg).CreateClientForProfile(context.Background(), "", "")
+	assert.ErrorIs(t, err, errAcme)
+}
@@ -0,0 +1,56 @@
+package gitea_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing client creation with a valid configuration
+func TestClientWithConfig(t *testing.T) {
+	cfg := &gitea.Config{
+		Token:             "random-gibbrich-as-token",
+		BaseURL:           "https://codeberg.org/",
+		DefaultRepository: "ignorant05/uniflow",
+	}
+
+	client, err := gitea.NewClient(context.Background(), cfg)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://codeberg.org/api/v1/", client.BaseURL.String())
+
+	owner, repo, err := client.GetDefaultRepository()
+	require.NoError(t, err)
+	assert.Equal(t, "ignorant05", owner)
+	assert.Equal(t, "uniflow", repo)
+}
+
+// Testing client creation with token from env
+func TestClientWithTokenFromEnv(t *testing.T) {
+	t.Setenv("GITEA_TOKEN", "token-from-env")
+
+	client, err := gitea.NewClient(context.Background(), &gitea.Config{BaseURL: "https://gitea.company.com"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "token-from-env", client.Config.Token)
+}
+
+// Testing client creation without base URL
+func TestClientWithoutBaseURL(t *testing.T) {
+	_, err := gitea.NewClient(context.Background(), &gitea.Config{Token: "random-gibbrich-as-token"})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "No gitea base URL configured")
+}
+
+// Testing client creation from profile with invalid gitea field
+func TestClientFromProfile_Failure(t *testing.T) {
+	_, err := gitea.NewClientFromProfile(context.Background(), &config.Profile{})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Gitea isn't configured for this profile")
+}
@@ -0,0 +1,74 @@
+package gitea_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/gitea"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing adapter TriggerWorkflow dispatches and picks the workflow run
+func TestTriggerWorkflow_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token random-gibbrich-as-token", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/api/v1/repos/ignorant05/uniflow/actions/workflows/deploy.yml/dispatches":
+			assert.Equal(t, "POST", r.Method)
+
+			var body gitea.DispatchWorkflowRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "main", body.Ref)
+			assert.Equal(t, map[string]string{"environment": "production"}, body.Inputs)
+
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v1/repos/ignorant05/uniflow/actions/runs":
+			assert.Equal(t, "main", r.URL.Query().Get("branch"))
+
+			_, _ = w.Write([]byte(`{"workflow_runs":[
+				{"id":8,"run_number":4,"path":"ci.yml@refs/heads/main","status":"queued"},
+				{"id":7,"run_number":3,"path":"deploy.yml@refs/heads/main","status":"waiting","html_url":"https://gitea.company.com/ignorant05/uniflow/actions/runs/3"}
+			],"total_count":2}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGiteaAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{
+		WorkflowName: ".gitea/workflows/deploy.yml",
+		Branch:       "main",
+		Inputs:       map[string]interface{}{"environment": "production"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), resp.RunID)
+	assert.Equal(t, 3, resp.RunNumber)
+	assert.Equal(t, "waiting", resp.Status)
+}
+
+// Testing DispatchWorkflow, (Failure: non existent workflow)
+func TestDispatchWorkflow_NonExistentWorkflow(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"workflow not found"}`))
+	})
+
+	defer server.Close()
+
+	err := client.DispatchWorkflow("ignorant05", "uniflow", "nonexistent.yml", "main", nil)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "workflow not found")
+}
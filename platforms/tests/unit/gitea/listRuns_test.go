@@ -0,0 +1,74 @@
+package gitea_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/gitea"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing ListWorkflowRuns filters by workflow and status
+func TestListWorkflowRuns_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/repos/ignorant05/uniflow/actions/runs", r.URL.Path)
+
+		_, _ = w.Write([]byte(`{"workflow_runs":[
+			{"id":9,"run_number":5,"path":"ci.yml@refs/heads/main","status":"running"},
+			{"id":8,"run_number":4,"path":"ci.yml@refs/heads/main","status":"completed","conclusion":"failure","head_branch":"main","head_sha":"a1b2c3d","event":"push","actor":{"login":"ignorant05"}},
+			{"id":7,"run_number":3,"path":"deploy.yml@refs/heads/main","status":"success"}
+		]}`))
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGiteaAdapter(client)
+	require.NoError(t, err)
+
+	runs, err := adapter.ListWorkflowRuns(client.Ctx, &types.ListWorkflowRunsRequest{WorkflowName: "ci.yml", Status: "completed"})
+
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, int64(8), runs[0].RunID)
+	assert.Equal(t, "failure", runs[0].Conclusion)
+	assert.Equal(t, "a1b2c3d", runs[0].CommitSHA)
+	assert.Equal(t, "ignorant05", runs[0].Actor)
+}
+
+// Testing ListWorkflows with dispatch only
+func TestListWorkflowsWithDispatch_Success(t *testing.T) {
+	dispatchable := base64.StdEncoding.EncodeToString([]byte("on:\n  workflow_dispatch:\n"))
+	pushOnly := base64.StdEncoding.EncodeToString([]byte("on:\n  push:\n"))
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/repos/ignorant05/uniflow/actions/workflows":
+			_, _ = w.Write([]byte(`{"workflows":[
+				{"id":"ci.yml","name":"CI","path":".gitea/workflows/ci.yml","state":"active"},
+				{"id":"deploy.yml","name":"Deploy","path":".gitea/workflows/deploy.yml","state":"active"}
+			]}`))
+		case "/api/v1/repos/ignorant05/uniflow/contents/.gitea/workflows/ci.yml":
+			_, _ = w.Write([]byte(`{"encoding":"base64","content":"` + pushOnly + `"}`))
+		case "/api/v1/repos/ignorant05/uniflow/contents/.gitea/workflows/deploy.yml":
+			_, _ = w.Write([]byte(`{"encoding":"base64","content":"` + dispatchable + `"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGiteaAdapter(client)
+	require.NoError(t, err)
+
+	workflows, err := adapter.ListWorkflows(client.Ctx, &types.ListWorkflowsRequest{WithDispatch: true})
+
+	require.NoError(t, err)
+	require.Len(t, workflows, 1)
+	assert.Equal(t, "Deploy", workflows[0].Name)
+}
@@ -0,0 +1,27 @@
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitea"
+	"github.com/stretchr/testify/require"
+)
+
+// Setting up client with mock server
+func SetupTestClientWithMockServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *gitea.Client) {
+	server := httptest.NewServer(handler)
+
+	cfg := &gitea.Config{
+		Token:             "random-gibbrich-as-token",
+		BaseURL:           server.URL,
+		DefaultRepository: "ignorant05/uniflow",
+	}
+
+	client, err := gitea.NewClient(context.Background(), cfg)
+	require.NoError(t, err)
+
+	return server, client
+}
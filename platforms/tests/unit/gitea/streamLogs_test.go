@@ -0,0 +1,49 @@
+package gitea_test
+
+import (
+	"net/http"
+	"testing"
+
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/gitea"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing StreamLogs splits timestamps and delivers lines job by job
+func TestStreamLogs_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/repos/ignorant05/uniflow/actions/runs/7":
+			_, _ = w.Write([]byte(`{"id":7,"status":"completed","conclusion":"failure"}`))
+		case "/api/v1/repos/ignorant05/uniflow/actions/runs/7/jobs":
+			_, _ = w.Write([]byte(`{"jobs":[{"id":70,"name":"build","status":"completed","conclusion":"failure"},{"id":71,"name":"deploy","status":"queued"}]}`))
+		case "/api/v1/repos/ignorant05/uniflow/actions/jobs/70/logs":
+			_, _ = w.Write([]byte("2025-01-01T00:00:00.0000000Z Building\n2025-01-01T00:00:01.0000000Z Error: build failed\n"))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGiteaAdapter(client)
+	require.NoError(t, err)
+
+	var lines []*types.LogLine
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line)
+		return nil
+	}
+
+	err = adapter.StreamLogs(client.Ctx, &types.LogsStreamRequest{RunID: 7}, &callback)
+
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "Building", lines[0].Content)
+	assert.Equal(t, "build", lines[0].JobName)
+	assert.Equal(t, 2025, lines[0].Timestamp.Year())
+	assert.Equal(t, "error", lines[1].Level)
+}
@@ -0,0 +1,161 @@
+package github_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+	"github.com/ignorant05/Uniflow/types"
+
+	gh "github.com/google/go-github/v57/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing adapter ListArtifacts maps the artifacts of a run
+func TestListArtifacts_Success(t *testing.T) {
+	expiresAt := time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/ignorant05/Uniflow/actions/runs/123456/artifacts", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(gh.ArtifactList{
+			TotalCount: gh.Int64(2),
+			Artifacts: []*gh.Artifact{
+				{ID: gh.Int64(11), Name: gh.String("dist"), SizeInBytes: gh.Int64(2048), ExpiresAt: &gh.Timestamp{Time: expiresAt}},
+				{ID: gh.Int64(12), Name: gh.String("coverage"), SizeInBytes: gh.Int64(512), Expired: gh.Bool(true)},
+			},
+		})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	artifacts, err := adapter.ListArtifacts(client.Ctx, &types.ListArtifactsRequest{RunID: 123456})
+
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+
+	assert.Equal(t, int64(11), artifacts[0].ID)
+	assert.Equal(t, "dist", artifacts[0].Name)
+	assert.Equal(t, int64(2048), artifacts[0].Size)
+	assert.False(t, artifacts[0].Expired)
+	assert.Equal(t, expiresAt, artifacts[0].ExpiresAt.UTC())
+
+	assert.True(t, artifacts[1].Expired)
+}
+
+// Testing adapter DownloadArtifact follows the redirect, verifies the zip and unpacks it
+func TestDownloadArtifact_Extract(t *testing.T) {
+	archive, err := os.ReadFile(writeLogsZip(t, map[string]string{
+		"app":           "binary",
+		"docs/index.md": "# docs",
+	}))
+	require.NoError(t, err)
+
+	var serverURL string
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/ignorant05/Uniflow/actions/artifacts/11/zip":
+			http.Redirect(w, r, serverURL+"/blob/dist.zip", http.StatusFound)
+		case "/blob/dist.zip":
+			_, _ = w.Write(archive)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+	serverURL = server.URL
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	dest := t.TempDir()
+	var downloaded int64
+
+	resp, err := adapter.DownloadArtifact(client.Ctx, &types.DownloadArtifactRequest{
+		RunID:    123456,
+		Artifact: &types.Artifact{ID: 11, Name: "dist", Size: int64(len(archive))},
+		Dest:     dest,
+		Extract:  true,
+		Progress: func(n int64) { downloaded = n },
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dest, "dist"), resp.Path)
+	assert.Equal(t, int64(len(archive)), resp.Size)
+	assert.Equal(t, int64(len(archive)), downloaded)
+	assert.Equal(t, 2, resp.Files)
+
+	content, err := os.ReadFile(filepath.Join(dest, "dist", "docs", "index.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# docs", string(content))
+
+	_, err = os.Stat(filepath.Join(dest, "dist.zip"))
+	assert.True(t, os.IsNotExist(err), "the zip should be removed once extracted")
+}
+
+// Testing adapter DownloadArtifact, (Failure: corrupted zip)
+func TestDownloadArtifact_CorruptedZip(t *testing.T) {
+	var serverURL string
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/ignorant05/Uniflow/actions/artifacts/11/zip" {
+			http.Redirect(w, r, serverURL+"/blob/dist.zip", http.StatusFound)
+			return
+		}
+
+		_, _ = w.Write([]byte("not a zip"))
+	})
+
+	defer server.Close()
+	serverURL = server.URL
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	dest := t.TempDir()
+	_, err = adapter.DownloadArtifact(client.Ctx, &types.DownloadArtifactRequest{
+		RunID:    123456,
+		Artifact: &types.Artifact{ID: 11, Name: "dist"},
+		Dest:     dest,
+	})
+
+	require.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(dest, "dist.zip"))
+	assert.True(t, os.IsNotExist(err), "the corrupted zip should be removed")
+}
+
+// Testing adapter DownloadArtifact, (Failure: expired artifact)
+func TestDownloadArtifact_Expired(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	_, err = adapter.DownloadArtifact(client.Ctx, &types.DownloadArtifactRequest{
+		Artifact: &types.Artifact{ID: 12, Name: "coverage", Expired: true},
+		Dest:     t.TempDir(),
+	})
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_found", platformErr.Code)
+}
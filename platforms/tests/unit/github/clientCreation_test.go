@@ -13,7 +13,7 @@ import (
 
 // Testing client creation with a valid token
 func TestClientWithToken(t *testing.T) {
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token: "random-gibbrich-as-token",
 	}
 
@@ -33,7 +33,7 @@ func TestClientWithTokenFromEnv(t *testing.T) {
 
 	token := os.Getenv("GITHUB_TOKEN")
 
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token: token,
 	}
 
@@ -49,7 +49,7 @@ func TestClientWithEnterpriseURL(t *testing.T) {
 	token := os.Getenv("GITHUB_TOKEN")
 	baseURL := "https://github.enterprise.com"
 
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token:   token,
 		BaseURL: baseURL,
 	}
@@ -64,8 +64,8 @@ func TestClientWithEnterpriseURL(t *testing.T) {
 // Testing client creation from profile
 func TestClientFromProfile_Success(t *testing.T) {
 	profile := &config.Profile{
-		Github: &config.GithubConfig{
-			Token: "random-gibbrich-as-token",
+		Platforms: map[string]interface{}{
+			"github": map[string]interface{}{"token": "random-gibbrich-as-token"},
 		},
 	}
 
@@ -78,9 +78,7 @@ func TestClientFromProfile_Success(t *testing.T) {
 
 // Testing client creatino from profil with invalid github field
 func TestClientFromProfile_Failure(t *testing.T) {
-	profile := &config.Profile{
-		Github: nil,
-	}
+	profile := &config.Profile{}
 
 	_, err := github.NewClientFromProfile(context.Background(), profile)
 
@@ -0,0 +1,194 @@
+package github_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	gh "github.com/google/go-github/v57/github"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const deployWorkflow = `
+name: Deploy
+on:
+  push:
+  workflow_dispatch:
+    inputs:
+      environment:
+        description: Target environment
+        type: choice
+        required: true
+        options: [staging, production]
+      version:
+        description: Version to deploy
+        required: true
+      replicas:
+        type: number
+        default: 2
+      dry_run:
+        type: boolean
+        default: false
+`
+
+// Testing ParseDispatchInputs reads the inputs schema
+func TestParseDispatchInputs_Schema(t *testing.T) {
+	inputs, hasDispatch, err := helpers.ParseDispatchInputs(deployWorkflow)
+
+	require.NoError(t, err)
+	assert.True(t, hasDispatch)
+	require.Len(t, inputs, 4)
+	assert.Equal(t, "environment", inputs[0].Name)
+	assert.Equal(t, []string{"staging", "production"}, inputs[0].Options)
+	assert.Equal(t, "string", inputs[1].Type)
+	assert.Equal(t, 2, inputs[2].Default)
+}
+
+// Testing ParseDispatchInputs with the short trigger forms
+func TestParseDispatchInputs_ShortForms(t *testing.T) {
+	_, hasDispatch, err := helpers.ParseDispatchInputs("on: [push, workflow_dispatch]")
+	require.NoError(t, err)
+	assert.True(t, hasDispatch)
+
+	_, hasDispatch, err = helpers.ParseDispatchInputs("on: push")
+	require.NoError(t, err)
+	assert.False(t, hasDispatch)
+}
+
+// Testing ValidateDispatchInputs coerces values and fills defaults
+func TestValidateDispatchInputs_Success(t *testing.T) {
+	schema, _, err := helpers.ParseDispatchInputs(deployWorkflow)
+	require.NoError(t, err)
+
+	inputs, err := helpers.ValidateDispatchInputs("deploy.yml", schema, map[string]interface{}{
+		"environment": "staging",
+		"version":     "v1.2.3",
+		"dry_run":     "true",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"environment": "staging",
+		"version":     "v1.2.3",
+		"replicas":    int64(2),
+		"dry_run":     true,
+	}, inputs)
+}
+
+// Testing ValidateDispatchInputs reports every problem
+func TestValidateDispatchInputs_Failure(t *testing.T) {
+	schema, _, err := helpers.ParseDispatchInputs(deployWorkflow)
+	require.NoError(t, err)
+
+	_, err = helpers.ValidateDispatchInputs("deploy.yml", schema, map[string]interface{}{
+		"enviroment": "staging",
+		"replicas":   "three",
+		"dry_run":    "maybe",
+	})
+
+	var inputsErr *helpers.InputsError
+	require.ErrorAs(t, err, &inputsErr)
+	assert.Equal(t, []string{"enviroment"}, inputsErr.Unknown)
+	assert.Len(t, inputsErr.Invalid, 2)
+	require.Len(t, inputsErr.Missing, 2)
+	assert.Contains(t, err.Error(), "environment: Target environment")
+	assert.Contains(t, err.Error(), "version: Version to deploy")
+}
+
+// Testing TriggerWorkflow doesn't dispatch invalid inputs
+func TestTriggerWorkflow_InvalidInputs(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.Path {
+		case "/repos/ignorant05/Uniflow/actions/workflows":
+			response = gh.Workflows{Workflows: []*gh.Workflow{{ID: gh.Int64(7), Path: gh.String(".github/workflows/deploy.yml")}}}
+		case "/repos/ignorant05/Uniflow/contents/.github/workflows/deploy.yml":
+			response = gh.RepositoryContent{Type: gh.String("file"), Content: gh.String(deployWorkflow)}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	_, err = adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{
+		WorkflowName: "deploy.yml",
+		Branch:       "main",
+		Inputs:       map[string]interface{}{"environment": "prod", "version": "v1"},
+	})
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "invalid_inputs", platformErr.Code)
+	assert.Contains(t, platformErr.Message, "must be one of: staging, production")
+}
+
+// Testing the inputs schema is read from the dispatched branch
+func TestDispatchInputs_OfBranch(t *testing.T) {
+	featureWorkflow := deployWorkflow + `      region:
+        description: Target region
+        required: true
+`
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.Path {
+		case "/repos/ignorant05/Uniflow/actions/workflows":
+			response = gh.Workflows{Workflows: []*gh.Workflow{{ID: gh.Int64(7), Path: gh.String(".github/workflows/deploy.yml")}}}
+		case "/repos/ignorant05/Uniflow/contents/.github/workflows/deploy.yml":
+			content := deployWorkflow
+			if r.URL.Query().Get("ref") == "feature" {
+				content = featureWorkflow
+			}
+			response = gh.RepositoryContent{Type: gh.String("file"), Content: gh.String(content)}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	inputs, err := adapter.DescribeInputs(client.Ctx, "deploy.yml", "main")
+	require.NoError(t, err)
+	assert.Len(t, inputs, 4)
+
+	inputs, err = adapter.DescribeInputs(client.Ctx, "deploy.yml", "feature")
+	require.NoError(t, err)
+	assert.Len(t, inputs, 5)
+
+	_, err = adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{
+		WorkflowName: "deploy.yml",
+		Branch:       "feature",
+		Inputs:       map[string]interface{}{"environment": "staging", "version": "v1"},
+	})
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "invalid_inputs", platformErr.Code)
+	assert.Contains(t, platformErr.Message, "region: Target region")
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"testing"
 
-	"github.com/ignorant05/Uniflow/internal/config"
 	"github.com/ignorant05/Uniflow/platforms/configurations/github"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,7 +11,7 @@ import (
 
 // Testing default repo configured
 func TestDefaultRepo_Success(t *testing.T) {
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token:             "random-gibbrich-as-token",
 		DefaultRepository: "ignorant05/Uniflow",
 	}
@@ -27,7 +26,7 @@ func TestDefaultRepo_Success(t *testing.T) {
 
 // Testing default repo not configured
 func TestDefaultRepo_Failure(t *testing.T) {
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token:             "random-gibbrich-as-token",
 		DefaultRepository: "",
 	}
@@ -43,7 +42,7 @@ func TestDefaultRepo_Failure(t *testing.T) {
 
 // Testing getting default repository
 func TestGetDefaultRepository_Success(t *testing.T) {
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token:             "random-gibbrich-as-token",
 		DefaultRepository: "ignorant05/Uniflow",
 	}
@@ -59,7 +58,7 @@ func TestGetDefaultRepository_Success(t *testing.T) {
 
 // Testing getting default repository (Failure: not configured)
 func TestGetDefaultRepository_Failure(t *testing.T) {
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token:             "random-gibbrich-as-token",
 		DefaultRepository: "",
 	}
@@ -111,7 +110,7 @@ func TestGetDefaultRepository_TableDriven(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cfg := &config.GithubConfig{
+			cfg := &github.Config{
 				Token:             "random-gibbrich-as-token",
 				DefaultRepository: tt.repository,
 			}
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
 	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+	"github.com/ignorant05/Uniflow/types"
 
 	gh "github.com/google/go-github/v57/github"
 	"github.com/stretchr/testify/assert"
@@ -118,3 +120,66 @@ func TestGetWorkflowRun_Failure(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "failure", *run.Status)
 }
+
+// Testing adapter GetStatus, the URL is the run page
+func TestGetStatus_HTMLURL(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/ignorant05/Uniflow/actions/runs/123456", r.URL.Path)
+
+		response := gh.WorkflowRun{
+			ID:       gh.Int64(123456),
+			Status:   gh.String("in_progress"),
+			HTMLURL:  gh.String("https://github.com/ignorant05/Uniflow/actions/runs/123456"),
+			RerunURL: gh.String("https://api.github.com/repos/ignorant05/Uniflow/actions/runs/123456/rerun"),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(response)
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	status, err := adapter.GetStatus(client.Ctx, &types.StatusRequest{RunID: 123456})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/ignorant05/Uniflow/actions/runs/123456", status.URL)
+}
+
+// Testing adapter ListWorkflowRuns without workflow, lists the runs of every workflow
+func TestListWorkflowRuns_AllWorkflows(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/ignorant05/Uniflow/actions/runs", r.URL.Path)
+
+		response := gh.WorkflowRuns{
+			TotalCount: gh.Int(2),
+			WorkflowRuns: []*gh.WorkflowRun{
+				{ID: gh.Int64(2), Name: gh.String("Deploy"), Status: gh.String("in_progress")},
+				{ID: gh.Int64(1), Name: gh.String("CI"), Status: gh.String("completed")},
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(response)
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	runs, err := adapter.ListWorkflowRuns(client.Ctx, &types.ListWorkflowRunsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "Deploy", runs[0].WorkflowName)
+	assert.Equal(t, "CI", runs[1].WorkflowName)
+}
@@ -8,7 +8,6 @@ import (
 	"testing"
 
 	gh "github.com/google/go-github/v57/github"
-	"github.com/ignorant05/Uniflow/internal/config"
 	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
 	"github.com/ignorant05/Uniflow/platforms/configurations/github"
 	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
@@ -125,7 +124,7 @@ func TestListWorkflows_Empty(t *testing.T) {
 
 // Testing ListWorkflows (Failure: network error)
 func TestListWorkflows_NetworkError(t *testing.T) {
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token:             "random-gibbrich-as-token",
 		DefaultRepository: "ignorant05/Uniflow",
 	}
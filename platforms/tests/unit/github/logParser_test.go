@@ -0,0 +1,105 @@
+package github_test
+
+import (
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v57/github"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Testing the parsing of runner markers and workflow commands
+func TestParseLogCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected *helpers.LogCommand
+	}{
+		{
+			name:     "plain line",
+			content:  "go: downloading github.com/spf13/cobra v1.10.2",
+			expected: &helpers.LogCommand{Content: "go: downloading github.com/spf13/cobra v1.10.2"},
+		},
+		{
+			name:     "runner group",
+			content:  "##[group]Run go test ./...",
+			expected: &helpers.LogCommand{Content: "Run go test ./...", Marker: types.LOG_GROUP_START},
+		},
+		{
+			name:     "runner endgroup",
+			content:  "##[endgroup]",
+			expected: &helpers.LogCommand{Content: "", Marker: types.LOG_GROUP_END},
+		},
+		{
+			name:     "workflow command group",
+			content:  "::group::Install dependencies",
+			expected: &helpers.LogCommand{Content: "Install dependencies", Marker: types.LOG_GROUP_START},
+		},
+		{
+			name:    "runner error",
+			content: "##[error]Process completed with exit code 1.",
+			expected: &helpers.LogCommand{
+				Content:    "Process completed with exit code 1.",
+				Level:      "error",
+				Annotation: &types.LogAnnotation{Level: "error"},
+			},
+		},
+		{
+			name:    "error annotation with location",
+			content: "::error file=cmd/root.go,line=12,col=5,title=Build failed::undefined: foo%0Aexit status 1",
+			expected: &helpers.LogCommand{
+				Content:    "undefined: foo\nexit status 1",
+				Level:      "error",
+				Annotation: &types.LogAnnotation{Level: "error", Title: "Build failed", File: "cmd/root.go", Line: 12, Column: 5},
+			},
+		},
+		{
+			name:    "warning annotation without location",
+			content: "::warning::Node.js 16 actions are deprecated",
+			expected: &helpers.LogCommand{
+				Content:    "Node.js 16 actions are deprecated",
+				Level:      "warning",
+				Annotation: &types.LogAnnotation{Level: "warning"},
+			},
+		},
+		{
+			name:     "debug",
+			content:  "##[debug]Evaluating condition for step",
+			expected: &helpers.LogCommand{Content: "Evaluating condition for step", Level: "debug"},
+		},
+		{
+			name:     "unknown command is kept",
+			content:  "::add-mask::secret",
+			expected: &helpers.LogCommand{Content: "::add-mask::secret"},
+		},
+		{
+			name:     "unterminated marker is kept",
+			content:  "##[group",
+			expected: &helpers.LogCommand{Content: "##[group"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, helpers.ParseLogCommand(tt.content))
+		})
+	}
+}
+
+// Testing that lines are attributed to the step running at their time
+func TestStepAt(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	steps := []*gh.TaskStep{
+		{Name: gh.String("Set up job"), StartedAt: &gh.Timestamp{Time: start}},
+		{Name: gh.String("Run tests"), StartedAt: &gh.Timestamp{Time: start.Add(5 * time.Second)}},
+		{Name: gh.String("Post checkout")},
+	}
+
+	assert.Equal(t, "", helpers.StepAt(steps, start.Add(-time.Second)))
+	assert.Equal(t, "Set up job", helpers.StepAt(steps, start.Add(4*time.Second)))
+	assert.Equal(t, "Run tests", helpers.StepAt(steps, start.Add(5*time.Second+300*time.Millisecond)))
+	assert.Equal(t, "Run tests", helpers.StepAt(steps, start.Add(time.Minute)))
+}
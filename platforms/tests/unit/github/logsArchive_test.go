@@ -0,0 +1,167 @@
+package github_test
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ghlogs "github.com/ignorant05/Uniflow/platforms/configurations/github/logs"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeLogsZip writes a logs archive laid out like GitHub's (the entries order is random)
+func writeLogsZip(t *testing.T, files map[string]string) string {
+	var entries [][2]string
+	for name, content := range files {
+		entries = append(entries, [2]string{name, content})
+	}
+
+	return writeLogsZipEntries(t, entries)
+}
+
+// writeLogsZipEntries writes a logs archive with the entries (name, content) in order
+func writeLogsZipEntries(t *testing.T, entries [][2]string) string {
+	path := filepath.Join(t.TempDir(), "logs.zip")
+
+	file, err := os.Create(path)
+	require.NoError(t, err)
+
+	writer := zip.NewWriter(file)
+	for _, e := range entries {
+		entry, err := writer.Create(e[0])
+		require.NoError(t, err)
+
+		_, err = entry.Write([]byte(e[1]))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.Close())
+	require.NoError(t, file.Close())
+
+	return path
+}
+
+// Testing a logs zip is indexed by job and step, and it's lines parsed
+func TestOpenArchive_Zip(t *testing.T) {
+	path := writeLogsZip(t, map[string]string{
+		"0_build.txt":            "2024-01-01T10:00:00.0000000Z whole build\n",
+		"build/2_Run tests.txt":  "2024-01-01T10:00:02.0000000Z ##[group]go test\n2024-01-01T10:00:03.0000000Z ok\n2024-01-01T10:00:04.0000000Z ##[endgroup]\n2024-01-01T10:00:05.0000000Z ##[error]FAIL\n",
+		"build/1_Set up job.txt": "2024-01-01T10:00:00.0000000Z Current runner version\n",
+		"1_lint.txt":             "2024-01-01T10:00:00.0000000Z no issues\n",
+		"build/system.txt":       "ignored\n",
+	})
+
+	archive, err := ghlogs.OpenArchive(path)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	require.Len(t, archive.Index.Jobs, 2)
+	build := archive.Index.Jobs[0]
+	require.Len(t, build.Steps, 2)
+	assert.Equal(t, "Set up job", build.Steps[0].Name)
+	assert.Equal(t, "Run tests", build.Steps[1].Name)
+	assert.Equal(t, 4, build.Steps[1].Lines)
+
+	// jobs without step files keep their whole log
+	lint := archive.Index.Jobs[1]
+	require.Len(t, lint.Steps, 1)
+	assert.Equal(t, "", lint.Steps[0].Name)
+
+	job, step, err := archive.Find("build", "tests")
+	require.NoError(t, err)
+
+	lines, err := archive.Lines(job, step)
+	require.NoError(t, err)
+	require.Len(t, lines, 4)
+	assert.Equal(t, types.LOG_GROUP_START, lines[0].Marker)
+	assert.Equal(t, "go test", lines[1].Group)
+	assert.Equal(t, "Run tests", lines[1].Step)
+	assert.Equal(t, "error", lines[3].Level)
+
+	_, _, err = archive.Find("deploy", "")
+	assert.Error(t, err)
+}
+
+// Testing the jobs and steps follow their log numbers, whatever the zip entries order
+func TestOpenArchive_ShuffledEntries(t *testing.T) {
+	path := writeLogsZipEntries(t, [][2]string{
+		{"2_deploy.txt", "2024-01-01T10:00:00.0000000Z deploying\n"},
+		{"build/2_Run tests.txt", "2024-01-01T10:00:02.0000000Z ok\n"},
+		{"1_lint.txt", "2024-01-01T10:00:00.0000000Z no issues\n"},
+		{"deploy/1_Set up job.txt", "2024-01-01T10:00:00.0000000Z runner\n"},
+		{"build/1_Set up job.txt", "2024-01-01T10:00:00.0000000Z runner\n"},
+		{"0_build.txt", "2024-01-01T10:00:00.0000000Z whole build\n"},
+	})
+
+	archive, err := ghlogs.OpenArchive(path)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	var names []string
+	for _, job := range archive.Index.Jobs {
+		names = append(names, job.Name)
+	}
+	assert.Equal(t, []string{"build", "lint", "deploy"}, names)
+
+	build := archive.Index.Jobs[0]
+	require.Len(t, build.Steps, 2)
+	assert.Equal(t, "Set up job", build.Steps[0].Name)
+	assert.Equal(t, "Run tests", build.Steps[1].Name)
+}
+
+// Testing an extracted archive has one file per step and an index
+func TestExtractArchive(t *testing.T) {
+	path := writeLogsZip(t, map[string]string{
+		"build/1_Set up job.txt": "2024-01-01T10:00:00.0000000Z hello\n",
+		"build/2_Build: all.txt": "2024-01-01T10:00:01.0000000Z world\n",
+	})
+	dir := filepath.Join(t.TempDir(), "logs")
+
+	index, err := ghlogs.ExtractArchive(path, dir)
+	require.NoError(t, err)
+	require.Len(t, index.Jobs, 1)
+
+	assert.FileExists(t, filepath.Join(dir, "index.json"))
+	assert.FileExists(t, filepath.Join(dir, "build", "01_Set up job.log"))
+	assert.FileExists(t, filepath.Join(dir, "build", "02_Build- all.log"))
+
+	archive, err := ghlogs.OpenArchive(dir)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	job, step, err := archive.Find("build", "Set up job")
+	require.NoError(t, err)
+
+	lines, err := archive.Lines(job, step)
+	require.NoError(t, err)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "hello", lines[0].Content)
+}
+
+// Testing the steps of an extracted archive can't point outside of it
+func TestArchiveRead_PathTraversal(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "logs")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(dir), "secret.txt"), []byte("secret"), 0644))
+
+	index := `{"jobs":[{"name":"build","steps":[{"number":1,"name":"Set up job","path":"../secret.txt"}]}]}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "index.json"), []byte(index), 0644))
+
+	archive, err := ghlogs.OpenArchive(dir)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	job, step, err := archive.Find("build", "Set up job")
+	require.NoError(t, err)
+
+	_, err = archive.Read(step)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid step path")
+
+	_, err = archive.Lines(job, step)
+	assert.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package github_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	gh "github.com/google/go-github/v57/github"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// matchWorkflows share the deploy.yml file name, and contain "deploy.yml" in another name
+func matchWorkflows() []*gh.Workflow {
+	return []*gh.Workflow{
+		{ID: gh.Int64(1), Path: gh.String(".github/workflows/deploy.yml")},
+		{ID: gh.Int64(2), Path: gh.String(".github/workflows/pre-deploy.yml")},
+		{ID: gh.Int64(3), Path: gh.String("dynamic/release/deploy.yml")},
+		{ID: gh.Int64(4), Path: gh.String(".github/workflows/build.yml")},
+	}
+}
+
+// Testing MatchWorkflow matches whole paths or file names only
+func TestMatchWorkflow(t *testing.T) {
+	workflow, err := helpers.MatchWorkflow(matchWorkflows(), ".github/workflows/deploy.yml")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), workflow.GetID())
+
+	workflow, err = helpers.MatchWorkflow(matchWorkflows(), "pre-deploy.yml")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), workflow.GetID())
+
+	workflow, err = helpers.MatchWorkflow(matchWorkflows(), "build")
+	require.NoError(t, err)
+	assert.Nil(t, workflow, "partial names don't match")
+
+	_, err = helpers.MatchWorkflow(matchWorkflows(), "deploy.yml")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), ".github/workflows/deploy.yml, dynamic/release/deploy.yml")
+}
+
+// Testing the adapter reports ambiguous workflow names
+func TestListWorkflowJobs_AmbiguousWorkflow(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		workflows := matchWorkflows()
+		if err := json.NewEncoder(w).Encode(gh.Workflows{TotalCount: gh.Int(len(workflows)), Workflows: workflows}); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	_, err = adapter.ListWorkflowJobs(client.Ctx, &types.ListWokflowJobsRequest{WorkflowName: "deploy.yml"})
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "ambiguous_workflow", platformErr.Code)
+
+	_, err = adapter.ListWorkflowJobs(client.Ctx, &types.ListWokflowJobsRequest{WorkflowName: "ploy.yml"})
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_found", platformErr.Code)
+}
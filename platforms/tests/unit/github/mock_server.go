@@ -7,7 +7,6 @@ import (
 	"net/url"
 	"testing"
 
-	"github.com/ignorant05/Uniflow/internal/config"
 	"github.com/ignorant05/Uniflow/platforms/configurations/github"
 	"github.com/stretchr/testify/require"
 )
@@ -16,7 +15,7 @@ import (
 func SetupTestClientWithMockServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *github.Client) {
 	server := httptest.NewServer(handler)
 
-	cfg := &config.GithubConfig{
+	cfg := &github.Config{
 		Token:             "random-gibbrich-as-token",
 		DefaultRepository: "ignorant05/Uniflow",
 	}
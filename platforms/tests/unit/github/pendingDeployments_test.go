@@ -0,0 +1,146 @@
+package github_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+	"github.com/ignorant05/Uniflow/types"
+
+	gh "github.com/google/go-github/v57/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pendingDeployments is the answer of the pending deployments endpoint (staging and production)
+const pendingDeployments = `[
+	{
+		"environment": {"id": 1, "name": "staging"},
+		"wait_timer": 0,
+		"current_user_can_approve": true,
+		"reviewers": [{"type": "User", "reviewer": {"login": "octocat"}}]
+	},
+	{
+		"environment": {"id": 2, "name": "production"},
+		"wait_timer": 30,
+		"wait_timer_started_at": "2026-01-02T10:00:00Z",
+		"current_user_can_approve": false,
+		"reviewers": [{"type": "Team", "reviewer": {"slug": "release-managers"}}]
+	}
+]`
+
+// Testing adapter ListPendingApprovals lists one approval per waiting run and environment
+func TestListPendingApprovals_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/repos/ignorant05/Uniflow/actions/runs":
+			assert.Equal(t, "waiting", r.URL.Query().Get("status"))
+
+			err := json.NewEncoder(w).Encode(gh.WorkflowRuns{
+				TotalCount: gh.Int(1),
+				WorkflowRuns: []*gh.WorkflowRun{{
+					ID:         gh.Int64(123456),
+					RunNumber:  gh.Int(47),
+					Name:       gh.String("Deploy"),
+					HeadBranch: gh.String("main"),
+					HTMLURL:    gh.String("https://github.com/ignorant05/Uniflow/actions/runs/123456"),
+					UpdatedAt:  &gh.Timestamp{Time: time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)},
+				}},
+			})
+			if err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/repos/ignorant05/Uniflow/actions/runs/123456/pending_deployments":
+			assert.Equal(t, "GET", r.Method)
+			_, _ = w.Write([]byte(pendingDeployments))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	approvals, err := adapter.ListPendingApprovals(client.Ctx, &types.ListApprovalsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, approvals, 2)
+
+	assert.Equal(t, int64(123456), approvals[0].RunID)
+	assert.Equal(t, 47, approvals[0].RunNumber)
+	assert.Equal(t, "Deploy", approvals[0].WorkflowName)
+	assert.Equal(t, "staging", approvals[0].Environment)
+	assert.Equal(t, []string{"octocat"}, approvals[0].Reviewers)
+	assert.True(t, approvals[0].CanApprove)
+	assert.Equal(t, time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC), approvals[0].WaitingSince.UTC())
+
+	assert.Equal(t, "production", approvals[1].Environment)
+	assert.Equal(t, []string{"ignorant05/release-managers"}, approvals[1].Reviewers)
+	assert.False(t, approvals[1].CanApprove)
+	assert.Equal(t, time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC), approvals[1].WaitingSince.UTC())
+}
+
+// Testing adapter ReviewApproval reviews the environments by name
+func TestReviewApproval_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/ignorant05/Uniflow/actions/runs/123456/pending_deployments", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "GET" {
+			_, _ = w.Write([]byte(pendingDeployments))
+			return
+		}
+
+		var body gh.PendingDeploymentsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []int64{2}, body.EnvironmentIDs)
+		assert.Equal(t, "approved", body.State)
+		assert.Equal(t, "release v1.2.0", body.Comment)
+
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	err = adapter.ReviewApproval(client.Ctx, &types.ReviewApprovalRequest{
+		RunID:        123456,
+		Environments: []string{"Production"},
+		Approve:      true,
+		Comment:      "release v1.2.0",
+	})
+
+	require.NoError(t, err)
+}
+
+// Testing adapter ReviewApproval, (Failure: environment isn't pending)
+func TestReviewApproval_UnknownEnvironment(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(pendingDeployments))
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	err = adapter.ReviewApproval(client.Ctx, &types.ReviewApprovalRequest{RunID: 123456, Environments: []string{"qa"}})
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_found", platformErr.Code)
+	assert.Contains(t, platformErr.Message, "staging, production")
+}
@@ -0,0 +1,97 @@
+package github_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/constants"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/helpers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing incremental downloads, with and without range support
+func TestReadLogsFrom(t *testing.T) {
+	const logs = "line 1\nline 2\nline 3\n"
+
+	rangeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(logs))
+	}))
+	defer rangeServer.Close()
+
+	// ignores Range, like some storages
+	fullServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(logs))
+	}))
+	defer fullServer.Close()
+
+	tests := []struct {
+		name          string
+		url           string
+		offset        int64
+		expected      string
+		expectedStart int64
+	}{
+		{name: "whole logs", url: rangeServer.URL, offset: 0, expected: logs, expectedStart: 0},
+		{name: "range request", url: rangeServer.URL, offset: 7, expected: "line 2\nline 3\n", expectedStart: 7},
+		{name: "nothing new", url: rangeServer.URL, offset: int64(len(logs)), expected: "", expectedStart: int64(len(logs))},
+		{name: "range ignored skips the known prefix", url: fullServer.URL, offset: 14, expected: "line 3\n", expectedStart: 14},
+		{name: "replaced logs are read again", url: fullServer.URL, offset: 100, expected: logs, expectedStart: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, start, truncated, err := helpers.ReadLogsFrom(context.Background(), http.DefaultClient, tt.url, tt.offset, 1<<20)
+			require.NoError(t, err)
+			assert.False(t, truncated)
+
+			assert.Equal(t, tt.expected, content)
+			assert.Equal(t, tt.expectedStart, start)
+		})
+	}
+}
+
+// Testing that download failures are reported
+func TestReadLogsFromFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	_, _, _, err := helpers.ReadLogsFrom(context.Background(), http.DefaultClient, server.URL, 0, 1<<20)
+	assert.Error(t, err)
+}
+
+// Testing logs over the size limit are read in chunks, with and without range support
+func TestReadLogsFromOverLimit(t *testing.T) {
+	logs := strings.Repeat("a long log line\n", constants.DATA_LOGS_MAX_SIZE/16+100)
+
+	rangeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(logs))
+	}))
+	defer rangeServer.Close()
+
+	fullServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(logs))
+	}))
+	defer fullServer.Close()
+
+	for _, url := range []string{rangeServer.URL, fullServer.URL} {
+		content, start, truncated, err := helpers.ReadLogsFrom(context.Background(), http.DefaultClient, url, 0, constants.DATA_LOGS_MAX_SIZE)
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, int64(0), start)
+		assert.Len(t, content, constants.DATA_LOGS_MAX_SIZE)
+
+		rest, start, truncated, err := helpers.ReadLogsFrom(context.Background(), http.DefaultClient, url, int64(len(content)), constants.DATA_LOGS_MAX_SIZE)
+		require.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, int64(len(content)), start)
+		assert.Equal(t, logs, content+rest)
+	}
+}
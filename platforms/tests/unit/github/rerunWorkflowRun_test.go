@@ -0,0 +1,77 @@
+package github_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing RerunWorkflowRun, the whole run or only the failed jobs
+func TestRerunWorkflowRun_Success(t *testing.T) {
+	tests := []struct {
+		name       string
+		failedOnly bool
+		debug      bool
+		path       string
+	}{
+		{name: "whole run", path: "/repos/ignorant05/Uniflow/actions/runs/123456/rerun"},
+		{name: "failed jobs with debug", failedOnly: true, debug: true, path: "/repos/ignorant05/Uniflow/actions/runs/123456/rerun-failed-jobs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.path, r.URL.Path)
+				assert.Equal(t, "POST", r.Method)
+
+				var body map[string]bool
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tt.debug, body["enable_debug_logging"])
+
+				w.WriteHeader(http.StatusCreated)
+			})
+
+			defer server.Close()
+
+			err := client.RerunWorkflowRun("ignorant05", "Uniflow", 123456, tt.failedOnly, tt.debug)
+			require.NoError(t, err)
+		})
+	}
+}
+
+// Testing RerunWorkflowRun, (Failure: run still in progress)
+func TestRerunWorkflowRun_InProgress(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"This workflow is already running"}`))
+	})
+
+	defer server.Close()
+
+	err := client.RerunWorkflowRun("ignorant05", "Uniflow", 123456, false, false)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "already running")
+}
+
+// Testing IsProtectedBranch
+func TestIsProtectedBranch(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/ignorant05/Uniflow/branches/main", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"main","protected":true}`))
+	})
+
+	defer server.Close()
+
+	protected, err := client.IsProtectedBranch("ignorant05", "Uniflow", "main")
+
+	require.NoError(t, err)
+	assert.True(t, protected)
+}
@@ -0,0 +1,423 @@
+package github_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v57/github"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/github/constants"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logsServer mocks a run with a build job (logs) and a deploy job (queued, unless it has logs)
+// NOTE: the logs api redirects to a download url, like github does
+type logsServer struct {
+	mu         sync.Mutex
+	runStatus  string
+	logs       string
+	deployLogs string
+	steps      []*gh.TaskStep
+
+	// onPoll is called on every run request, before the jobs are listed (optional)
+	onPoll func(s *logsServer)
+
+	// ranges are the Range headers of the downloads
+	ranges []string
+}
+
+func (s *logsServer) handler(serverURL func() string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		var response interface{}
+
+		switch r.URL.Path {
+		case "/repos/ignorant05/Uniflow/actions/runs/42":
+			if s.onPoll != nil {
+				s.onPoll(s)
+			}
+
+			response = gh.WorkflowRun{ID: gh.Int64(42), Status: gh.String(s.runStatus)}
+		case "/repos/ignorant05/Uniflow/actions/runs/42/jobs":
+			deployStatus := "queued"
+			if s.deployLogs != "" {
+				deployStatus = s.runStatus
+			}
+
+			response = gh.Jobs{
+				TotalCount: gh.Int(2),
+				Jobs: []*gh.WorkflowJob{
+					{ID: gh.Int64(1), Name: gh.String("build"), Status: gh.String(s.runStatus), Steps: s.steps},
+					{ID: gh.Int64(2), Name: gh.String("deploy"), Status: gh.String(deployStatus)},
+				},
+			}
+		case "/repos/ignorant05/Uniflow/actions/jobs/1/logs":
+			http.Redirect(w, r, serverURL()+"/download/jobs/1", http.StatusFound)
+			return
+		case "/download/jobs/1":
+			s.ranges = append(s.ranges, r.Header.Get("Range"))
+			http.ServeContent(w, r, "", time.Time{}, strings.NewReader(s.logs))
+			return
+		case "/repos/ignorant05/Uniflow/actions/jobs/2/logs":
+			http.Redirect(w, r, serverURL()+"/download/jobs/2", http.StatusFound)
+			return
+		case "/download/jobs/2":
+			http.ServeContent(w, r, "", time.Time{}, strings.NewReader(s.deployLogs))
+			return
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	}
+}
+
+// streamLogs streams the logs of run 42 and collects the lines
+func streamLogs(t *testing.T, ctx context.Context, s *logsServer, req *types.LogsStreamRequest, onLine func(line *types.LogLine)) []*types.LogLine {
+	var serverURL string
+	server, client := mock.SetupTestClientWithMockServer(t, s.handler(func() string { return serverURL }))
+	defer server.Close()
+	serverURL = server.URL
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	var lines []*types.LogLine
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line)
+		if onLine != nil {
+			onLine(line)
+		}
+		return nil
+	}
+
+	req.RunID = 42
+	require.NoError(t, adapter.StreamLogs(ctx, req, &callback))
+
+	return lines
+}
+
+// Testing that every line is delivered with it's timestamp, job and level
+func TestStreamLogsStructuredLines(t *testing.T) {
+	s := &logsServer{
+		runStatus: "completed",
+		logs: "\ufeff2025-01-01T10:00:00.0000000Z Run go build\r\n" +
+			"2025-01-01T10:00:01.0000000Z ##[error]build failed\r\n" +
+			"2025-01-01T10:00:02.0000000Z done\r\n",
+	}
+
+	lines := streamLogs(t, context.Background(), s, &types.LogsStreamRequest{}, nil)
+	require.Len(t, lines, 3)
+
+	assert.Equal(t, "Run go build", lines[0].Content)
+	assert.Equal(t, "build", lines[0].JobName)
+	assert.Equal(t, "info", lines[0].Level)
+	assert.Equal(t, 2025, lines[0].Timestamp.Year())
+	assert.Equal(t, 1, lines[1].Timestamp.Second())
+
+	assert.Equal(t, "error", lines[1].Level)
+	assert.Equal(t, "done", lines[2].Content)
+}
+
+// Testing that --tail only delivers the last lines
+func TestStreamLogsTail(t *testing.T) {
+	s := &logsServer{runStatus: "completed"}
+	for i := range 10 {
+		s.logs += fmt.Sprintf("2025-01-01T10:00:%02d.0000000Z line %d\n", i, i)
+	}
+
+	lines := streamLogs(t, context.Background(), s, &types.LogsStreamRequest{Tail: 3}, nil)
+	require.Len(t, lines, 3)
+
+	assert.Equal(t, "line 7", lines[0].Content)
+	assert.Equal(t, "line 9", lines[2].Content)
+}
+
+// Testing --tail with less lines than asked for
+func TestStreamLogsTailShortLogs(t *testing.T) {
+	tests := []struct {
+		name string
+		logs string
+		tail int
+		want []string
+	}{
+		{
+			name: "tail 3 lines from 5",
+			logs: "line1\nline2\nline3\nline4\nline5\n",
+			tail: 3,
+			want: []string{"line3", "line4", "line5"},
+		},
+		{
+			name: "tail more than available",
+			logs: "line1\nline2\n",
+			tail: 10,
+			want: []string{"line1", "line2"},
+		},
+		{
+			name: "empty logs",
+			logs: "",
+			tail: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &logsServer{runStatus: "completed", logs: tt.logs}
+
+			var contents []string
+			for _, line := range streamLogs(t, context.Background(), s, &types.LogsStreamRequest{Tail: tt.tail}, nil) {
+				contents = append(contents, line.Content)
+			}
+
+			assert.Equal(t, tt.want, contents)
+		})
+	}
+}
+
+// Testing that --tail applies to each job
+func TestStreamLogsTailPerJob(t *testing.T) {
+	s := &logsServer{runStatus: "completed"}
+	for i := range 10 {
+		s.logs += fmt.Sprintf("2025-01-01T10:00:%02d.0000000Z build %d\n", i, i)
+		s.deployLogs += fmt.Sprintf("2025-01-01T10:01:%02d.0000000Z deploy %d\n", i, i)
+	}
+
+	lines := streamLogs(t, context.Background(), s, &types.LogsStreamRequest{Tail: 2}, nil)
+
+	var contents []string
+	for _, line := range lines {
+		contents = append(contents, line.JobName+": "+line.Content)
+	}
+	assert.Equal(t, []string{"build: build 8", "build: build 9", "deploy: deploy 8", "deploy: deploy 9"}, contents)
+}
+
+// Testing that logs over the size limit are delivered whole
+func TestStreamLogsOverLimit(t *testing.T) {
+	line := "2025-01-01T10:00:00.0000000Z " + strings.Repeat("x", 100) + "\n"
+	count := constants.DATA_LOGS_MAX_SIZE/len(line) + 1000
+
+	s := &logsServer{runStatus: "completed", logs: strings.Repeat(line, count-1) + "2025-01-01T10:00:01.0000000Z last line\n"}
+
+	lines := streamLogs(t, context.Background(), s, &types.LogsStreamRequest{}, nil)
+	require.Len(t, lines, count)
+
+	// lines across chunks are whole
+	for _, line := range lines[:count-1] {
+		require.Equal(t, strings.Repeat("x", 100), line.Content)
+	}
+	assert.Equal(t, "last line", lines[count-1].Content)
+}
+
+// Testing that following only delivers new complete lines, until the run completes
+func TestStreamLogsFollow(t *testing.T) {
+	s := &logsServer{
+		runStatus: "in_progress",
+		logs:      "2025-01-01T10:00:00.0000000Z first\n2025-01-01T10:00:01.0000000Z sec",
+	}
+
+	lines := streamLogs(t, context.Background(), s, &types.LogsStreamRequest{Follow: true}, func(line *types.LogLine) {
+		// the second line is completed (and the run too) once the first one is read
+		if line.Content == "first" {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			s.logs += "ond\n2025-01-01T10:00:02.0000000Z third\n"
+			s.runStatus = "completed"
+		}
+	})
+
+	var contents []string
+	for _, line := range lines {
+		contents = append(contents, line.Content)
+	}
+
+	assert.Equal(t, "first,second,third", strings.Join(contents, ","))
+
+	// only the bytes after the last complete line are downloaded again
+	require.Len(t, s.ranges, 2)
+	assert.Equal(t, "", s.ranges[0])
+	assert.Equal(t, "bytes=35-", s.ranges[1])
+}
+
+// Testing that following waits for queued jobs, polling until they have logs
+func TestStreamLogsFollowQueuedJob(t *testing.T) {
+	s := &logsServer{
+		runStatus: "in_progress",
+		logs:      "2025-01-01T10:00:00.0000000Z building\n",
+		onPoll: func(s *logsServer) {
+			// the deploy job starts (and the run completes) on the second poll
+			if s.ranges != nil {
+				s.deployLogs = "2025-01-01T10:01:00.0000000Z deploying\n"
+				s.runStatus = "completed"
+			}
+		},
+	}
+
+	var contents []string
+	for _, line := range streamLogs(t, context.Background(), s, &types.LogsStreamRequest{Follow: true}, nil) {
+		contents = append(contents, line.JobName+": "+line.Content)
+	}
+
+	assert.Equal(t, []string{"build: building", "deploy: deploying"}, contents)
+}
+
+// Testing that only the logs of the requested jobs are streamed
+func TestStreamLogsJobFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		jobIDs []int64
+		want   []string
+
+		// buildDownloads is the number of build logs downloads
+		buildDownloads int
+	}{
+		{
+			name:           "all jobs",
+			want:           []string{"build: building", "deploy: deploying"},
+			buildDownloads: 1,
+		},
+		{
+			name:           "build job",
+			jobIDs:         []int64{1},
+			want:           []string{"build: building"},
+			buildDownloads: 1,
+		},
+		{
+			name:   "deploy job",
+			jobIDs: []int64{2},
+			want:   []string{"deploy: deploying"},
+		},
+		{
+			name:   "unknown job",
+			jobIDs: []int64{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &logsServer{
+				runStatus:  "completed",
+				logs:       "2025-01-01T10:00:00.0000000Z building\n",
+				deployLogs: "2025-01-01T10:01:00.0000000Z deploying\n",
+			}
+
+			var contents []string
+			for _, line := range streamLogs(t, context.Background(), s, &types.LogsStreamRequest{JobIDs: tt.jobIDs}, nil) {
+				assert.Contains(t, []int64{1, 2}, line.JobID)
+				contents = append(contents, line.JobName+": "+line.Content)
+			}
+
+			assert.Equal(t, tt.want, contents)
+
+			// filtered out jobs logs aren't downloaded
+			assert.Len(t, s.ranges, tt.buildDownloads)
+		})
+	}
+}
+
+// Testing that every line is delivered with it's level
+func TestStreamLogsLevels(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "error level", content: "ERROR: something went wrong", want: "error"},
+		{name: "error with failed keyword", content: "Build failed with exit code 1", want: "error"},
+		{name: "fatal error", content: "FATAL: cannot continue", want: "error"},
+		{name: "warning level", content: "WARNING: deprecated function", want: "warning"},
+		{name: "warn keyword", content: "Warn: unused variable", want: "warning"},
+		{name: "success level", content: "✓ Build completed successfully", want: "success"},
+		{name: "success keyword", content: "Tests passed", want: "success"},
+		{name: "debug level", content: "DEBUG: checking values", want: "debug"},
+		{name: "info level", content: "Starting deployment process", want: "info"},
+		{name: "case insensitive error", content: "Error: file not found", want: "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &logsServer{runStatus: "completed", logs: "2025-01-01T10:00:00.0000000Z " + tt.content + "\n"}
+
+			lines := streamLogs(t, context.Background(), s, &types.LogsStreamRequest{}, nil)
+			require.Len(t, lines, 1)
+			assert.Equal(t, tt.want, lines[0].Level)
+		})
+	}
+}
+
+// Testing that repeated lines are all delivered
+func TestStreamLogsRepeatedLines(t *testing.T) {
+	s := &logsServer{
+		runStatus: "completed",
+		logs:      "retrying\nretrying\nretrying\n",
+	}
+
+	lines := streamLogs(t, context.Background(), s, &types.LogsStreamRequest{}, nil)
+	assert.Len(t, lines, 3)
+}
+
+// Testing that cancelling the context stops following
+func TestStreamLogsFollowCancelled(t *testing.T) {
+	s := &logsServer{
+		runStatus: "in_progress",
+		logs:      "2025-01-01T10:00:00.0000000Z first\n",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lines := streamLogs(t, ctx, s, &types.LogsStreamRequest{Follow: true}, func(line *types.LogLine) { cancel() })
+	assert.Len(t, lines, 1)
+}
+
+// Testing that lines carry their step, group and annotation
+func TestStreamLogsGroupsAndSteps(t *testing.T) {
+	s := &logsServer{
+		runStatus: "completed",
+		steps: []*gh.TaskStep{
+			{Name: gh.String("Set up job"), StartedAt: &gh.Timestamp{Time: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)}},
+			{Name: gh.String("Run tests"), StartedAt: &gh.Timestamp{Time: time.Date(2025, 1, 1, 10, 0, 2, 0, time.UTC)}},
+		},
+		logs: "2025-01-01T10:00:00.0000000Z Current runner version: '2.320.0'\n" +
+			"2025-01-01T10:00:02.0000000Z ##[group]Run go test ./...\n" +
+			"2025-01-01T10:00:02.1000000Z go test ./...\n" +
+			"2025-01-01T10:00:02.2000000Z ##[endgroup]\n" +
+			"2025-01-01T10:00:03.0000000Z ::error file=main.go,line=3::undefined: foo\n",
+	}
+
+	lines := streamLogs(t, context.Background(), s, &types.LogsStreamRequest{}, nil)
+	require.Len(t, lines, 5)
+
+	assert.Equal(t, "Set up job", lines[0].Step)
+	assert.Equal(t, "", lines[0].Group)
+
+	assert.Equal(t, "Run tests", lines[1].Step)
+	assert.Equal(t, types.LOG_GROUP_START, lines[1].Marker)
+	assert.Equal(t, "Run go test ./...", lines[1].Group)
+	assert.Equal(t, "Run go test ./...", lines[2].Group)
+	assert.Equal(t, types.LOG_GROUP_END, lines[3].Marker)
+	assert.Equal(t, "Run go test ./...", lines[3].Group)
+
+	assert.Equal(t, "", lines[4].Group)
+	assert.Equal(t, "error", lines[4].Level)
+	assert.Equal(t, "undefined: foo", lines[4].Content)
+	require.NotNil(t, lines[4].Annotation)
+	assert.Equal(t, "main.go", lines[4].Annotation.File)
+	assert.Equal(t, 3, lines[4].Annotation.Line)
+}
@@ -0,0 +1,168 @@
+package github_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	gh "github.com/google/go-github/v57/github"
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// correlationServer mocks the apis used to find a dispatched run, runs are returned after the dispatch
+func correlationServer(t *testing.T, before []*gh.WorkflowRun, after func(correlationID string) []*gh.WorkflowRun) http.HandlerFunc {
+	var correlationID string
+	dispatched := false
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.Path {
+		case "/repos/ignorant05/Uniflow/actions/workflows":
+			response = gh.Workflows{Workflows: []*gh.Workflow{{ID: gh.Int64(7), Path: gh.String(".github/workflows/deploy.yml")}}}
+		case "/user":
+			response = gh.User{Login: gh.String("ignorant05")}
+		case "/repos/ignorant05/Uniflow/commits/main":
+			_, _ = w.Write([]byte(headSHA))
+			return
+		case "/repos/ignorant05/Uniflow/contents/.github/workflows/deploy.yml":
+			response = gh.RepositoryContent{
+				Type:    gh.String("file"),
+				Content: gh.String("on:\n  workflow_dispatch:\n    inputs:\n      run_id:\n        required: false\n"),
+			}
+		case "/repos/ignorant05/Uniflow/actions/workflows/deploy.yml/dispatches":
+			var body struct {
+				Inputs map[string]interface{} `json:"inputs"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				errorhandling.HandleError(err)
+			}
+
+			correlationID, _ = body.Inputs["run_id"].(string)
+			dispatched = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case "/repos/ignorant05/Uniflow/actions/workflows/7/runs":
+			assert.Equal(t, "workflow_dispatch", r.URL.Query().Get("event"))
+			assert.Equal(t, "main", r.URL.Query().Get("branch"))
+			assert.Equal(t, "ignorant05", r.URL.Query().Get("actor"))
+			assert.Contains(t, r.URL.Query().Get("created"), ">=")
+
+			runs := before
+			if dispatched {
+				runs = after(correlationID)
+			}
+			response = gh.WorkflowRuns{TotalCount: gh.Int(len(runs)), WorkflowRuns: runs}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	}
+}
+
+// headSHA is the commit main points to
+const headSHA = "3f9a1c2b7d4e8f60a1b2c3d4e5f60718293a4b5c"
+
+func dispatchedRun(id int64, title string, createdAt time.Time) *gh.WorkflowRun {
+	return &gh.WorkflowRun{
+		ID:           gh.Int64(id),
+		HeadBranch:   gh.String("main"),
+		HeadSHA:      gh.String(headSHA),
+		RunNumber:    gh.Int(int(id)),
+		DisplayTitle: gh.String(title),
+		Status:       gh.String("queued"),
+		HTMLURL:      gh.String("https://github.com/ignorant05/Uniflow/actions/runs/" + title),
+		CreatedAt:    &gh.Timestamp{Time: createdAt},
+	}
+}
+
+// Testing TriggerWorkflow finds the run carrying the correlation ID (not a concurrent one)
+func TestTriggerWorkflow_CorrelationID(t *testing.T) {
+	now := time.Now()
+	existing := dispatchedRun(1, "existing", now.Add(-5*time.Second))
+
+	server, client := mock.SetupTestClientWithMockServer(t, correlationServer(t, []*gh.WorkflowRun{existing}, func(correlationID string) []*gh.WorkflowRun {
+		return []*gh.WorkflowRun{
+			dispatchedRun(3, "deploy uniflow-someone-else", now.Add(time.Second)),
+			dispatchedRun(2, "deploy "+correlationID, now.Add(2*time.Second)),
+			existing,
+		}
+	}))
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{WorkflowName: "deploy.yml", Branch: "main", CorrelationInput: "run_id"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.RunID)
+	assert.NotEmpty(t, resp.CorrelationID)
+	assert.Contains(t, resp.URL, resp.CorrelationID)
+}
+
+// Testing TriggerWorkflow ignores existing runs and picks the earliest new one
+func TestTriggerWorkflow_EarliestNewRun(t *testing.T) {
+	now := time.Now()
+	existing := dispatchedRun(1, "existing", now.Add(-5*time.Second))
+
+	server, client := mock.SetupTestClientWithMockServer(t, correlationServer(t, []*gh.WorkflowRun{existing}, func(string) []*gh.WorkflowRun {
+		return []*gh.WorkflowRun{
+			dispatchedRun(5, "later", now.Add(3*time.Second)),
+			dispatchedRun(4, "ours", now.Add(time.Second)),
+			existing,
+		}
+	}))
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{WorkflowName: "deploy.yml", Branch: "refs/heads/main"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), resp.RunID)
+	assert.Empty(t, resp.CorrelationID)
+}
+
+// Testing TriggerWorkflow without correlation ID skips the new runs of other refs or commits
+func TestTriggerWorkflow_EarliestNewRunOfRef(t *testing.T) {
+	now := time.Now()
+
+	otherCommit := dispatchedRun(3, "other commit", now.Add(time.Second))
+	otherCommit.HeadSHA = gh.String("0000000000000000000000000000000000000000")
+
+	otherRef := dispatchedRun(4, "other ref", now.Add(2*time.Second))
+	otherRef.HeadBranch = gh.String("main-backport")
+
+	server, client := mock.SetupTestClientWithMockServer(t, correlationServer(t, nil, func(string) []*gh.WorkflowRun {
+		return []*gh.WorkflowRun{
+			dispatchedRun(5, "ours", now.Add(3*time.Second)),
+			otherRef,
+			otherCommit,
+		}
+	}))
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{WorkflowName: "deploy.yml", Branch: "main"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), resp.RunID)
+}
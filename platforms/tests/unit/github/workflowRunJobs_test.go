@@ -7,7 +7,9 @@ import (
 
 	gh "github.com/google/go-github/v57/github"
 	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
 	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/github"
+	"github.com/ignorant05/Uniflow/types"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -85,3 +87,45 @@ func TestListWorkflowRunJobs_Failure(t *testing.T) {
 
 	assert.Error(t, err)
 }
+
+// Testing the adapter ListWorkflowJobs, jobs of a run with their steps
+func TestAdapterListWorkflowJobs_Steps(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/ignorant05/Uniflow/actions/runs/42/jobs", r.URL.Path)
+
+		response := gh.Jobs{
+			TotalCount: gh.Int(1),
+			Jobs: []*gh.WorkflowJob{
+				{
+					ID:         gh.Int64(7),
+					Name:       gh.String("test"),
+					Status:     gh.String("completed"),
+					Conclusion: gh.String("failure"),
+					RunID:      gh.Int64(42),
+					Steps: []*gh.TaskStep{
+						{Number: gh.Int64(1), Name: gh.String("Set up job"), Status: gh.String("completed"), Conclusion: gh.String("success")},
+						{Number: gh.Int64(2), Name: gh.String("Run tests"), Status: gh.String("completed"), Conclusion: gh.String("failure")},
+					},
+				},
+			},
+		}
+
+		err := json.NewEncoder(w).Encode(response)
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGithubAdapter(client)
+	require.NoError(t, err)
+
+	jobs, err := adapter.ListWorkflowJobs(client.Ctx, &types.ListWokflowJobsRequest{RunID: 42})
+	require.NoError(t, err)
+
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "failure", jobs[0].Conclusion)
+	require.Len(t, jobs[0].Steps, 2)
+	assert.Equal(t, &types.WorkflowStep{Number: 2, Name: "Run tests", Status: "completed", Conclusion: "failure"}, jobs[0].Steps[1])
+}
@@ -0,0 +1,67 @@
+package gitlab_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing client creation with a valid configuration (gitlab.com)
+func TestClientWithConfig(t *testing.T) {
+	cfg := &gitlab.Config{
+		Token:   "random-gibbrich-as-token",
+		Project: "ignorant05/uniflow",
+	}
+
+	client, err := gitlab.NewClient(context.Background(), cfg)
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/api/v4/", client.BaseURL.String())
+}
+
+// Testing client creation with a self-managed instance
+func TestClientWithSelfManagedURL(t *testing.T) {
+	for _, baseURL := range []string{"https://gitlab.company.com", "https://gitlab.company.com/api/v4/"} {
+		cfg := &gitlab.Config{
+			Token:   "random-gibbrich-as-token",
+			BaseURL: baseURL,
+		}
+
+		client, err := gitlab.NewClient(context.Background(), cfg)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://gitlab.company.com/api/v4/", client.BaseURL.String())
+	}
+}
+
+// Testing client creation with token from env
+func TestClientWithTokenFromEnv(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "token-from-env")
+
+	client, err := gitlab.NewClient(context.Background(), &gitlab.Config{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "token-from-env", client.Config.Token)
+}
+
+// Testing client creation without token
+func TestClientWithoutToken(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+
+	_, err := gitlab.NewClient(context.Background(), &gitlab.Config{})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "GITLAB_TOKEN")
+}
+
+// Testing client creation from profile with invalid gitlab field
+func TestClientFromProfile_Failure(t *testing.T) {
+	_, err := gitlab.NewClientFromProfile(context.Background(), &config.Profile{})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Gitlab isn't configured for this profile")
+}
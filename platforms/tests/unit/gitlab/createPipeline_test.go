@@ -0,0 +1,114 @@
+package gitlab_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/gitlab"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing adapter TriggerWorkflow passes inputs as pipeline variables
+func TestTriggerWorkflowWithVariables_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/ignorant05%2Funiflow/pipeline", r.URL.EscapedPath())
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "random-gibbrich-as-token", r.Header.Get("PRIVATE-TOKEN"))
+
+		var body gitlab.CreatePipelineRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "main", body.Ref)
+		require.Len(t, body.Variables, 2)
+		assert.Equal(t, "ENVIRONMENT", body.Variables[0].Key)
+		assert.Equal(t, "production", body.Variables[0].Value)
+		assert.Equal(t, "REPLICAS", body.Variables[1].Key)
+		assert.Equal(t, "3", body.Variables[1].Value)
+
+		w.WriteHeader(http.StatusCreated)
+		err := json.NewEncoder(w).Encode(gitlab.Pipeline{
+			ID:     1234,
+			IID:    56,
+			Status: "created",
+			WebURL: "https://gitlab.com/ignorant05/uniflow/-/pipelines/1234",
+		})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{
+		Branch: "main",
+		Inputs: map[string]interface{}{"ENVIRONMENT": "production", "REPLICAS": 3},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), resp.RunID)
+	assert.Equal(t, 56, resp.RunNumber)
+	assert.Equal(t, "queued", resp.Status)
+}
+
+// Testing adapter TriggerWorkflow falls back to the default branch
+func TestTriggerWorkflowDefaultBranch_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/ignorant05%2Funiflow":
+			response = gitlab.Project{ID: 1, DefaultBranch: "develop"}
+		case "/api/v4/projects/ignorant05%2Funiflow/pipeline":
+			var body gitlab.CreatePipelineRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "develop", body.Ref)
+
+			response = gitlab.Pipeline{ID: 1235, Status: "pending"}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1235), resp.RunID)
+}
+
+// Testing CreatePipeline, (Failure: invalid ref)
+func TestCreatePipeline_InvalidRef(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":{"base":["Reference not found"]}}`))
+	})
+
+	defer server.Close()
+
+	_, err := client.CreatePipeline("ignorant05/uniflow", "nonexistent", nil)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Reference not found")
+
+	var apiErr *gitlab.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+}
@@ -0,0 +1,103 @@
+package gitlab_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/gitlab"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing ListWorkflowRuns with status filter
+func TestListWorkflowRuns_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/ignorant05%2Funiflow/pipelines", r.URL.EscapedPath())
+		assert.Equal(t, "main", r.URL.Query().Get("ref"))
+		assert.Equal(t, "", r.URL.Query().Get("status"))
+
+		err := json.NewEncoder(w).Encode([]*gitlab.Pipeline{
+			{ID: 3, IID: 3, Status: "running", Ref: "main"},
+			{ID: 2, IID: 2, Status: "failed", Ref: "main", SHA: "a1b2c3d", Source: "web", User: &gitlab.User{Username: "ignorant05"}},
+			{ID: 1, IID: 1, Status: "success", Ref: "main"},
+		})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	runs, err := adapter.ListWorkflowRuns(client.Ctx, &types.ListWorkflowRunsRequest{Branch: "main", Status: "completed", Limit: 1})
+
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, int64(2), runs[0].RunID)
+	assert.Equal(t, "failure", runs[0].Conclusion)
+	assert.Equal(t, "a1b2c3d", runs[0].CommitSHA)
+	assert.Equal(t, "ignorant05", runs[0].Actor)
+	assert.Equal(t, "web", runs[0].Event)
+}
+
+// Testing ListWorkflowJobs maps pipeline jobs, oldest first
+func TestListWorkflowJobs_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/ignorant05%2Funiflow/pipelines/42/jobs", r.URL.EscapedPath())
+
+		err := json.NewEncoder(w).Encode([]*gitlab.Job{
+			{ID: 12, Name: "deploy", Stage: "deploy", Status: "manual"},
+			{ID: 11, Name: "test", Stage: "test", Status: "running"},
+			{ID: 10, Name: "build", Stage: "build", Status: "success"},
+		})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	jobs, err := adapter.ListWorkflowJobs(client.Ctx, &types.ListWokflowJobsRequest{RunID: 42})
+
+	require.NoError(t, err)
+	require.Len(t, jobs, 3)
+	assert.Equal(t, "build", jobs[0].Name)
+	assert.Equal(t, "success", jobs[0].Conclusion)
+	assert.Equal(t, "in_progress", jobs[1].Status)
+	assert.Equal(t, "waiting", jobs[2].Status)
+}
+
+// Testing GetStatus of a cancelled pipeline
+func TestGetStatus_Cancelled(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/ignorant05%2Funiflow/pipelines/42", r.URL.EscapedPath())
+
+		err := json.NewEncoder(w).Encode(gitlab.Pipeline{ID: 42, IID: 7, Status: "canceled", Duration: 90})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	status, err := adapter.GetStatus(client.Ctx, &types.StatusRequest{RunID: 42})
+
+	require.NoError(t, err)
+	assert.Equal(t, "completed", status.Status)
+	assert.Equal(t, "cancelled", status.Conclusion)
+	assert.Equal(t, float64(90), status.Duration.Seconds())
+}
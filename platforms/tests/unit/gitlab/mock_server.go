@@ -0,0 +1,27 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab"
+	"github.com/stretchr/testify/require"
+)
+
+// Setting up client with mock server
+func SetupTestClientWithMockServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *gitlab.Client) {
+	server := httptest.NewServer(handler)
+
+	cfg := &gitlab.Config{
+		Token:   "random-gibbrich-as-token",
+		BaseURL: server.URL,
+		Project: "ignorant05/uniflow",
+	}
+
+	client, err := gitlab.NewClient(context.Background(), cfg)
+	require.NoError(t, err)
+
+	return server, client
+}
@@ -0,0 +1,132 @@
+package gitlab_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/gitlab"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing adapter Rerun retries the failed jobs in place
+func TestRerunFailedOnly_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/ignorant05%2Funiflow/pipelines/1234/retry", r.URL.EscapedPath())
+		assert.Equal(t, "POST", r.Method)
+
+		w.WriteHeader(http.StatusCreated)
+		err := json.NewEncoder(w).Encode(gitlab.Pipeline{
+			ID:     1234,
+			IID:    56,
+			Status: "pending",
+			WebURL: "https://gitlab.com/ignorant05/uniflow/-/pipelines/1234",
+		})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.Rerun(client.Ctx, &types.RerunRequest{RunID: 1234, FailedOnly: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), resp.RunID)
+	assert.Equal(t, 56, resp.RunNumber)
+}
+
+// Testing adapter Rerun creates a new pipeline on the same ref, with the same variables
+func TestRerunWithDebug_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines/1234":
+			response = gitlab.Pipeline{ID: 1234, Ref: "release"}
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines/1234/variables":
+			response = []*gitlab.PipelineVariable{{Key: "ENVIRONMENT", Value: "production"}}
+		case "/api/v4/projects/ignorant05%2Funiflow/pipeline":
+			var body gitlab.CreatePipelineRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "release", body.Ref)
+			require.Len(t, body.Variables, 2)
+			assert.Equal(t, "CI_DEBUG_TRACE", body.Variables[0].Key)
+			assert.Equal(t, "true", body.Variables[0].Value)
+			assert.Equal(t, "ENVIRONMENT", body.Variables[1].Key)
+
+			w.WriteHeader(http.StatusCreated)
+			response = gitlab.Pipeline{ID: 1240, IID: 57, Status: "created"}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.Rerun(client.Ctx, &types.RerunRequest{RunID: 1234, Debug: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1240), resp.RunID)
+	assert.Equal(t, 57, resp.RunNumber)
+}
+
+// Testing adapter Rerun, (Failure: debug logging of retried jobs)
+func TestRerunFailedOnlyWithDebug_NotSupported(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	_, err = adapter.Rerun(client.Ctx, &types.RerunRequest{RunID: 1234, FailedOnly: true, Debug: true})
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_supported", platformErr.Code)
+}
+
+// Testing IsProtectedBranch, unprotected branches aren't found
+func TestIsProtectedBranch(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/ignorant05%2Funiflow/protected_branches/main":
+			_, _ = w.Write([]byte(`{"name":"main"}`))
+		case "/api/v4/projects/ignorant05%2Funiflow/protected_branches/feature%2Flogin":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"404 Not found"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+		}
+	})
+
+	defer server.Close()
+
+	protected, err := client.IsProtectedBranch("ignorant05/uniflow", "main")
+	require.NoError(t, err)
+	assert.True(t, protected)
+
+	protected, err = client.IsProtectedBranch("ignorant05/uniflow", "feature/login")
+	require.NoError(t, err)
+	assert.False(t, protected)
+}
@@ -0,0 +1,178 @@
+package gitlab_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/gitlab"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/gitlab"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing StreamLogs delivers cleaned trace lines job by job
+func TestStreamLogs_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines/42":
+			response = gitlab.Pipeline{ID: 42, Status: "failed"}
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines/42/jobs":
+			response = []*gitlab.Job{
+				{ID: 11, Name: "test", Status: "failed"},
+				{ID: 10, Name: "build", Status: "success"},
+				{ID: 12, Name: "deploy", Status: "skipped"},
+			}
+		case "/api/v4/projects/ignorant05%2Funiflow/jobs/10/trace":
+			_, _ = w.Write([]byte("section_start:1700000000:build_script\r\x1b[0K\x1b[32;1mBuilding\x1b[0;m\n"))
+			return
+		case "/api/v4/projects/ignorant05%2Funiflow/jobs/11/trace":
+			_, _ = w.Write([]byte("Running tests\nERROR: 2 tests failed\n"))
+			return
+		case "/api/v4/projects/ignorant05%2Funiflow/jobs/12/trace":
+			return
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	var lines []*types.LogLine
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line)
+		return nil
+	}
+
+	err = adapter.StreamLogs(client.Ctx, &types.LogsStreamRequest{RunID: 42}, &callback)
+
+	require.NoError(t, err)
+	require.Len(t, lines, 3)
+	assert.Equal(t, "Building", lines[0].Content)
+	assert.Equal(t, "build", lines[0].JobName)
+	assert.Equal(t, "test", lines[2].JobName)
+	assert.Equal(t, "error", lines[2].Level)
+}
+
+// Testing StreamLogs with tail
+func TestStreamLogs_Tail(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines":
+			response = []*gitlab.Pipeline{{ID: 42, Status: "success"}}
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines/42":
+			response = gitlab.Pipeline{ID: 42, Status: "success"}
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines/42/jobs":
+			response = []*gitlab.Job{{ID: 10, Name: "build", Status: "success"}}
+		case "/api/v4/projects/ignorant05%2Funiflow/jobs/10/trace":
+			_, _ = w.Write([]byte("line1\nline2\nline3\nline4"))
+			return
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	var lines []string
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line.Content)
+		return nil
+	}
+
+	err = adapter.StreamLogs(client.Ctx, &types.LogsStreamRequest{Tail: 2}, &callback)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line3", "line4"}, lines)
+}
+
+// Testing StreamLogs follows a canceling pipeline, reading only the new trace bytes of the requested jobs
+func TestStreamLogs_FollowIncremental(t *testing.T) {
+	polls := 0
+	var ranges []string
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		// a poll is a jobs listing, the pipeline is looked up before it
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines/42":
+			status := "canceling"
+			if polls > 0 {
+				status = "canceled"
+			}
+			response = gitlab.Pipeline{ID: 42, Status: status}
+		case "/api/v4/projects/ignorant05%2Funiflow/pipelines/42/jobs":
+			polls++
+			status := "canceling"
+			if polls > 1 {
+				status = "canceled"
+			}
+			response = []*gitlab.Job{
+				{ID: 10, Name: "build", Status: status},
+				{ID: 11, Name: "test", Status: status},
+			}
+		case "/api/v4/projects/ignorant05%2Funiflow/jobs/10/trace":
+			ranges = append(ranges, r.Header.Get("Range"))
+			if polls == 1 {
+				_, _ = w.Write([]byte("line1\nline"))
+				return
+			}
+
+			w.Header().Set("Content-Range", "bytes 6-11/12")
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write([]byte("line2\n"))
+			return
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewGitlabAdapter(client)
+	require.NoError(t, err)
+
+	var lines []string
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		assert.Equal(t, int64(10), line.JobID)
+		lines = append(lines, line.Content)
+		return nil
+	}
+
+	err = adapter.StreamLogs(client.Ctx, &types.LogsStreamRequest{RunID: 42, Follow: true, JobIDs: []int64{10}}, &callback)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, []string{"", "bytes=6-"}, ranges)
+	assert.Equal(t, []string{"line1", "line2"}, lines)
+}
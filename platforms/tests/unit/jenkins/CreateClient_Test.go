@@ -1 +0,0 @@
-package jenkins_test
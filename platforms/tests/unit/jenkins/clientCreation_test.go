@@ -0,0 +1,88 @@
+package jenkins_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing client creation with a valid configuration
+func TestClientWithConfig(t *testing.T) {
+	cfg := &jenkins.Config{
+		BaseURL:        "https://jenkins.company.com",
+		Username:       "ignorant05",
+		APIToken:       "random-gibbrich-as-token",
+		TimeoutSeconds: 10,
+	}
+
+	client, err := jenkins.NewClient(context.Background(), cfg)
+
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.Equal(t, "https://jenkins.company.com/", client.BaseURL.String())
+	assert.Equal(t, float64(10), client.HTTPClient.Timeout.Seconds())
+}
+
+// Testing client creation with api token from env
+func TestClientWithTokenFromEnv(t *testing.T) {
+	t.Setenv("JENKINS_API_TOKEN", "token-from-env")
+
+	cfg := &jenkins.Config{
+		BaseURL:  "https://jenkins.company.com",
+		Username: "ignorant05",
+	}
+
+	client, err := jenkins.NewClient(context.Background(), cfg)
+
+	require.NoError(t, err)
+	assert.Equal(t, "token-from-env", client.Config.APIToken)
+}
+
+// Testing client creation without base URL
+func TestClientWithoutBaseURL(t *testing.T) {
+	_, err := jenkins.NewClient(context.Background(), &jenkins.Config{})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "No jenkins base URL configured")
+}
+
+// Testing client creation with an unreadable CA certificate
+func TestClientWithInvalidCACert(t *testing.T) {
+	cfg := &jenkins.Config{
+		BaseURL:    "https://jenkins.company.com",
+		CACertPath: "/nonexistent/ca.pem",
+	}
+
+	_, err := jenkins.NewClient(context.Background(), cfg)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed to read CA certificate")
+}
+
+// Testing client creation from profile
+func TestClientFromProfile_Success(t *testing.T) {
+	profile := &config.Profile{
+		Platforms: map[string]interface{}{
+			"jenkins": map[string]interface{}{"base_url": "https://jenkins.company.com"},
+		},
+	}
+
+	client, err := jenkins.NewClientFromProfile(context.Background(), profile)
+
+	require.NoError(t, err)
+	assert.NotNil(t, client)
+}
+
+// Testing client creation from profile with invalid jenkins field
+func TestClientFromProfile_Failure(t *testing.T) {
+	profile := &config.Profile{}
+
+	_, err := jenkins.NewClientFromProfile(context.Background(), profile)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Jenkins isn't configured for this profile")
+}
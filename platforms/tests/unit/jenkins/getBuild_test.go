@@ -0,0 +1,225 @@
+package jenkins_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/jenkins"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing GetStatus, completed build
+func TestGetStatus_Completed(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/job/release/42/api/json", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		err := json.NewEncoder(w).Encode(jenkins.Build{
+			Number:    42,
+			Result:    "ABORTED",
+			Timestamp: 1700000000000,
+			Duration:  60000,
+			URL:       "https://jenkins.company.com/job/release/42/",
+		})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	status, err := adapter.GetStatus(client.Ctx, &types.StatusRequest{RunID: 42})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), status.RunID)
+	assert.Equal(t, "completed", status.Status)
+	assert.Equal(t, "cancelled", status.Conclusion)
+	assert.Equal(t, float64(60), status.Duration.Seconds())
+}
+
+// Testing GetStatus, running build
+func TestGetStatus_InProgress(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/job/release/lastBuild/api/json", r.URL.Path)
+
+		err := json.NewEncoder(w).Encode(jenkins.Build{Number: 43, Building: true})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	status, err := adapter.GetStatus(client.Ctx, &types.StatusRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "in_progress", status.Status)
+	assert.Equal(t, "", status.Conclusion)
+}
+
+// Testing ListWorkflowRuns with limit and status filter
+func TestListWorkflowRuns_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/job/release/api/json", r.URL.Path)
+		assert.Contains(t, r.URL.Query().Get("tree"), "builds[")
+
+		err := json.NewEncoder(w).Encode(jenkins.Job{
+			Builds: []*jenkins.Build{
+				{Number: 3, Building: true},
+				{
+					Number: 2,
+					Result: "SUCCESS",
+					Actions: []*jenkins.BuildAction{
+						{Causes: []*jenkins.Cause{{UserID: "ignorant05", ShortDescription: "Started by user ignorant05"}}},
+						{LastBuiltRevision: &jenkins.Revision{SHA1: "a1b2c3d", Branch: []*jenkins.Branch{{Name: "refs/remotes/origin/main"}}}},
+					},
+				},
+				{Number: 1, Result: "FAILURE"},
+			},
+		})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	runs, err := adapter.ListWorkflowRuns(client.Ctx, &types.ListWorkflowRunsRequest{Status: "completed", Limit: 1})
+
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, int64(2), runs[0].RunID)
+	assert.Equal(t, "success", runs[0].Conclusion)
+	assert.Equal(t, "main", runs[0].Branch)
+	assert.Equal(t, "a1b2c3d", runs[0].CommitSHA)
+	assert.Equal(t, "ignorant05", runs[0].Actor)
+}
+
+// Testing ListWorkflowJobs maps pipeline stages onto jobs
+func TestListWorkflowJobs_Stages(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+
+		switch r.URL.Path {
+		case "/job/release/42/api/json":
+			response = jenkins.Build{Number: 42, Building: true}
+		case "/job/release/42/wfapi/describe":
+			response = jenkins.PipelineRun{
+				Stages: []*jenkins.PipelineStage{
+					{ID: "6", Name: "Build", Status: "SUCCESS"},
+					{ID: "12", Name: "Deploy", Status: "IN_PROGRESS"},
+				},
+			}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	jobs, err := adapter.ListWorkflowJobs(client.Ctx, &types.ListWokflowJobsRequest{RunID: 42})
+
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "Build", jobs[0].Name)
+	assert.Equal(t, "success", jobs[0].Conclusion)
+	assert.Equal(t, int64(12), jobs[1].ID)
+	assert.Equal(t, "in_progress", jobs[1].Status)
+}
+
+// Testing ListWorkflowRuns reads the older builds when the limit exceeds a page
+func TestListWorkflowRuns_Pages(t *testing.T) {
+	var trees []string
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		tree := r.URL.Query().Get("tree")
+		trees = append(trees, tree)
+
+		var job jenkins.Job
+		if strings.HasPrefix(tree, "builds[") {
+			for number := int64(150); number > 50; number-- {
+				job.Builds = append(job.Builds, &jenkins.Build{Number: number, Result: "SUCCESS"})
+			}
+		} else {
+			for number := int64(50); number > 0; number-- {
+				job.AllBuilds = append(job.AllBuilds, &jenkins.Build{Number: number, Result: "SUCCESS"})
+			}
+		}
+
+		if err := json.NewEncoder(w).Encode(job); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	runs, err := adapter.ListWorkflowRuns(client.Ctx, &types.ListWorkflowRunsRequest{Limit: 120})
+
+	require.NoError(t, err)
+	require.Len(t, runs, 120)
+	assert.Equal(t, int64(31), runs[119].RunID)
+
+	require.Len(t, trees, 2)
+	assert.True(t, strings.HasSuffix(trees[0], "]{0,100}"))
+	assert.True(t, strings.HasPrefix(trees[1], "allBuilds["))
+	assert.True(t, strings.HasSuffix(trees[1], "]{100,200}"))
+}
+
+// Testing ListWorkflows identifies jobs by name (not by position)
+func TestListWorkflows_StableIDs(t *testing.T) {
+	jobs := []*jenkins.Job{{Name: "release", FullName: "release"}, {Name: "deploy", FullName: "folder/deploy"}}
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewEncoder(w).Encode(jenkins.Job{Jobs: jobs}); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	workflows, err := adapter.ListWorkflows(client.Ctx, &types.ListWorkflowsRequest{})
+	require.NoError(t, err)
+	require.Len(t, workflows, 2)
+	assert.NotEqual(t, workflows[0].ID, workflows[1].ID)
+	assert.Positive(t, workflows[1].ID)
+
+	// a new job listed first doesn't change the others' IDs
+	jobs = append([]*jenkins.Job{{Name: "lint", FullName: "lint"}}, jobs...)
+
+	reordered, err := adapter.ListWorkflows(client.Ctx, &types.ListWorkflowsRequest{})
+	require.NoError(t, err)
+	require.Len(t, reordered, 3)
+	assert.Equal(t, workflows[0].ID, reordered[1].ID)
+	assert.Equal(t, workflows[1].ID, reordered[2].ID)
+}
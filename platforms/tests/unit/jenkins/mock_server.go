@@ -0,0 +1,28 @@
+package jenkins
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins"
+	"github.com/stretchr/testify/require"
+)
+
+// Setting up client with mock server
+func SetupTestClientWithMockServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *jenkins.Client) {
+	server := httptest.NewServer(handler)
+
+	cfg := &jenkins.Config{
+		BaseURL:  server.URL,
+		Username: "ignorant05",
+		APIToken: "random-gibbrich-as-token",
+		JobName:  "release",
+	}
+
+	client, err := jenkins.NewClient(context.Background(), cfg)
+	require.NoError(t, err)
+
+	return server, client
+}
@@ -0,0 +1,158 @@
+package jenkins_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/jenkins"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing Cancel, build of the job of the run
+func TestCancel_JobOfRun(t *testing.T) {
+	stopped := false
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crumbIssuer/api/json":
+			w.WriteHeader(http.StatusNotFound)
+		case "/job/nightly/7/stop":
+			assert.Equal(t, "POST", r.Method)
+			stopped = true
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	err = adapter.Cancel(client.Ctx, &types.Run{RunID: 7, WorkflowName: "nightly"})
+
+	require.NoError(t, err)
+	assert.True(t, stopped)
+}
+
+// Testing Rerun, build of the job of the request
+func TestRerun_JobOfRequest(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body any
+
+		switch r.URL.Path {
+		case "/crumbIssuer/api/json":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case "/job/nightly/7/api/json":
+			body = jenkins.Build{Number: 7, URL: "http://" + r.Host + "/job/nightly/7/"}
+		case "/job/nightly/api/json":
+			body = jenkins.Job{Name: "nightly"}
+		case "/job/nightly/build":
+			assert.Equal(t, "POST", r.Method)
+
+			w.Header().Set("Location", "http://"+r.Host+"/queue/item/9/")
+			w.WriteHeader(http.StatusCreated)
+			return
+		case "/queue/item/9/api/json":
+			body = jenkins.QueueItem{ID: 9, Executable: &jenkins.BuildRef{Number: 8, URL: "http://" + r.Host + "/job/nightly/8/"}}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.Rerun(client.Ctx, &types.RerunRequest{RunID: 7, WorkflowName: "nightly"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), resp.RunID)
+}
+
+// Testing Cancel and Rerun, no job known
+func TestCancelRerun_UnknownJob(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	defer server.Close()
+
+	client.Config.JobName = ""
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	err = adapter.Cancel(client.Ctx, &types.Run{RunID: 7})
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_configured", platformErr.Code)
+
+	_, err = adapter.Rerun(client.Ctx, &types.RerunRequest{RunID: 7})
+
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_configured", platformErr.Code)
+}
+
+// Testing Rerun, stops waiting for the queued build once ctx is done
+func TestRerun_QueueContext(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body any
+
+		switch r.URL.Path {
+		case "/crumbIssuer/api/json":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case "/job/release/7/api/json":
+			body = jenkins.Build{Number: 7}
+		case "/job/release/api/json":
+			body = jenkins.Job{Name: "release"}
+		case "/job/release/build":
+			w.Header().Set("Location", "http://"+r.Host+"/queue/item/9/")
+			w.WriteHeader(http.StatusCreated)
+			return
+		case "/queue/item/9/api/json":
+			body = jenkins.QueueItem{ID: 9, Why: "Waiting for next available executor"}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(body); err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = adapter.Rerun(ctx, &types.RerunRequest{RunID: 7})
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "rerun_failed", platformErr.Code)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
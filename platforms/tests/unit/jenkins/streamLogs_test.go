@@ -0,0 +1,182 @@
+package jenkins_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/jenkins"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing GetProgressiveConsoleText reads offsets from headers
+func TestGetProgressiveConsoleText_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/job/release/42/logText/progressiveText", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("start"))
+
+		w.Header().Set("X-Text-Size", "25")
+		w.Header().Set("X-More-Data", "true")
+		_, _ = w.Write([]byte("Building release\n"))
+	})
+
+	defer server.Close()
+
+	chunk, err := client.GetProgressiveConsoleText("release", 42, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Building release\n", chunk.Text)
+	assert.Equal(t, int64(25), chunk.NextStart)
+	assert.True(t, chunk.MoreData)
+}
+
+// Testing StreamLogs delivers complete lines across chunks
+func TestStreamLogs_Follow(t *testing.T) {
+	chunks := []string{"Started by user\nBuil", "ding release\nERROR: tests failed\n"}
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/job/release/42/api/json":
+			if err := json.NewEncoder(w).Encode(jenkins.Build{Number: 42, Building: true}); err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/release/42/logText/progressiveText":
+			start, _ := strconv.Atoi(r.URL.Query().Get("start"))
+
+			idx, offset := 0, 0
+			for idx < len(chunks) && offset < start {
+				offset += len(chunks[idx])
+				idx++
+			}
+
+			w.Header().Set("X-Text-Size", strconv.Itoa(offset+len(chunks[idx])))
+			if idx < len(chunks)-1 {
+				w.Header().Set("X-More-Data", "true")
+			}
+			_, _ = w.Write([]byte(chunks[idx]))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	var lines []*types.LogLine
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line)
+		return nil
+	}
+
+	err = adapter.StreamLogs(client.Ctx, &types.LogsStreamRequest{RunID: 42, Follow: true}, &callback)
+
+	require.NoError(t, err)
+	require.Len(t, lines, 3)
+	assert.Equal(t, "Building release", lines[1].Content)
+	assert.Equal(t, "error", lines[2].Level)
+	assert.Equal(t, "release", lines[2].JobName)
+}
+
+// Testing StreamLogs with tail
+func TestStreamLogs_Tail(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/job/release/lastBuild/api/json":
+			if err := json.NewEncoder(w).Encode(jenkins.Build{Number: 42}); err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/release/42/logText/progressiveText":
+			_, _ = w.Write([]byte("line1\nline2\nline3\nline4"))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	var lines []string
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line.Content)
+		return nil
+	}
+
+	err = adapter.StreamLogs(client.Ctx, &types.LogsStreamRequest{Tail: 2}, &callback)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line3", "line4"}, lines)
+}
+
+// Testing StreamLogs streams the requested job (not the configured one)
+func TestStreamLogs_WorkflowName(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/job/folder/job/deploy/7/api/json":
+			if err := json.NewEncoder(w).Encode(jenkins.Build{Number: 7}); err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/folder/job/deploy/7/logText/progressiveText":
+			_, _ = w.Write([]byte("Deploying\n"))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	var lines []*types.LogLine
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line)
+		return nil
+	}
+
+	err = adapter.StreamLogs(client.Ctx, &types.LogsStreamRequest{RunID: 7, WorkflowName: "folder/deploy"}, &callback)
+
+	require.NoError(t, err)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "folder/deploy", lines[0].JobName)
+}
+
+// Testing ListWorkflowRunLogs reports the written file and its size
+func TestListWorkflowRunLogs_Size(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/job/release/42/api/json":
+			err := json.NewEncoder(w).Encode(jenkins.Build{Number: 42, URL: "http://" + r.Host + "/job/release/42/"})
+			if err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/release/42/consoleText":
+			_, _ = w.Write([]byte("Started by user\nFinished: SUCCESS\n"))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "release-42.log")
+	resp, err := adapter.ListWorkflowRunLogs(client.Ctx, &types.LogsRequest{RunID: 42, DownloadPath: path})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{path}, resp.Files)
+	assert.Equal(t, int64(len("Started by user\nFinished: SUCCESS\n")), resp.Size)
+}
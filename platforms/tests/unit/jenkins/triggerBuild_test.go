@@ -0,0 +1,201 @@
+package jenkins_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	errorhandling "github.com/ignorant05/Uniflow/internal/errorHandling"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	"github.com/ignorant05/Uniflow/platforms/configurations/jenkins"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/jenkins"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Testing TriggerBuild with parameters, success
+func TestTriggerBuildWithParameters_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crumbIssuer/api/json":
+			w.WriteHeader(http.StatusNotFound)
+		case "/job/release/api/json":
+			err := json.NewEncoder(w).Encode(jenkins.Job{Name: "release"})
+			if err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/release/buildWithParameters":
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "v1.2.3", r.URL.Query().Get("VERSION"))
+
+			user, pass, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "ignorant05", user)
+			assert.Equal(t, "random-gibbrich-as-token", pass)
+
+			w.Header().Set("Location", "http://"+r.Host+"/queue/item/7/")
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	queueURL, err := client.TriggerBuild("release", map[string]string{"VERSION": "v1.2.3"})
+
+	require.NoError(t, err)
+	assert.Contains(t, queueURL, "/queue/item/7/")
+}
+
+// Testing TriggerBuild without parameters on a non parameterized job
+func TestTriggerBuildWithoutParameters_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crumbIssuer/api/json":
+			err := json.NewEncoder(w).Encode(jenkins.Crumb{Crumb: "abc", CrumbRequestField: "Jenkins-Crumb"})
+			if err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/release/api/json":
+			err := json.NewEncoder(w).Encode(jenkins.Job{Name: "release"})
+			if err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/release/build":
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "abc", r.Header.Get("Jenkins-Crumb"))
+
+			w.Header().Set("Location", "http://"+r.Host+"/queue/item/8/")
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	queueURL, err := client.TriggerBuild("release", nil)
+
+	require.NoError(t, err)
+	assert.Contains(t, queueURL, "/queue/item/8/")
+}
+
+// Testing TriggerBuild, (Failure: non existent job)
+func TestTriggerBuild_NonExistentJob(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	defer server.Close()
+
+	_, err := client.TriggerBuild("nonexistent", nil)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Failed to get job nonexistent")
+}
+
+// Testing adapter TriggerWorkflow follows the queue item to a build number
+func TestTriggerWorkflowFollowsQueue_Success(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crumbIssuer/api/json":
+			w.WriteHeader(http.StatusNotFound)
+		case "/job/folder/job/release/api/json":
+			err := json.NewEncoder(w).Encode(jenkins.Job{
+				Name:     "release",
+				Property: []*jenkins.Property{{ParameterDefinitions: []*jenkins.ParameterDefinition{{Name: "VERSION"}}}},
+			})
+			if err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/folder/job/release/buildWithParameters":
+			w.Header().Set("Location", "http://"+r.Host+"/queue/item/9/")
+			w.WriteHeader(http.StatusCreated)
+		case "/queue/item/9/api/json":
+			err := json.NewEncoder(w).Encode(jenkins.QueueItem{
+				ID:         9,
+				Executable: &jenkins.BuildRef{Number: 42, URL: "http://" + r.Host + "/job/folder/job/release/42/"},
+			})
+			if err != nil {
+				errorhandling.HandleError(err)
+			}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{WorkflowName: "folder/release"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), resp.RunID)
+	assert.Contains(t, resp.URL, "/job/folder/job/release/42/")
+}
+
+// Testing adapter TriggerWorkflow doesn't bound the queue wait with the run timeout
+func TestTriggerWorkflowQueueIgnoresRunTimeout(t *testing.T) {
+	polls := 0
+
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/crumbIssuer/api/json":
+			w.WriteHeader(http.StatusNotFound)
+		case "/job/release/api/json":
+			if err := json.NewEncoder(w).Encode(jenkins.Job{Name: "release"}); err != nil {
+				errorhandling.HandleError(err)
+			}
+		case "/job/release/build":
+			w.Header().Set("Location", "http://"+r.Host+"/queue/item/9/")
+			w.WriteHeader(http.StatusCreated)
+		case "/queue/item/9/api/json":
+			polls++
+
+			item := jenkins.QueueItem{ID: 9, Why: "Waiting for next available executor"}
+			if polls > 1 {
+				item.Executable = &jenkins.BuildRef{Number: 42}
+			}
+
+			if err := json.NewEncoder(w).Encode(item); err != nil {
+				errorhandling.HandleError(err)
+			}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	defer server.Close()
+
+	adapter, err := adapters.NewJenkinsAdapter(client)
+	require.NoError(t, err)
+
+	resp, err := adapter.TriggerWorkflow(client.Ctx, &types.TriggerRequest{Wait: true, Timeout: time.Nanosecond})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), resp.RunID)
+	assert.Equal(t, 2, polls)
+}
+
+// Testing WaitForQueueItem, (Failure: cancelled queue item)
+func TestWaitForQueueItem_Cancelled(t *testing.T) {
+	server, client := mock.SetupTestClientWithMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(jenkins.QueueItem{ID: 10, Cancelled: true})
+		if err != nil {
+			errorhandling.HandleError(err)
+		}
+	})
+
+	defer server.Close()
+
+	_, err := client.WaitForQueueItem(client.Ctx, server.URL+"/queue/item/10/", 0)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "was cancelled")
+}
@@ -0,0 +1,116 @@
+package multiplex_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runsClient is a platform client replaying the log lines of runs
+type runsClient struct {
+	platforms.PlatformClient
+
+	logs       map[int64][]string
+	conclusion map[int64]string
+
+	// started is closed once every run is streamed (streams are concurrent)
+	started chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newRunsClient(streams int, logs map[int64][]string, conclusion map[int64]string) *runsClient {
+	client := &runsClient{logs: logs, conclusion: conclusion, started: make(chan struct{})}
+	client.wg.Add(streams)
+
+	go func() {
+		client.wg.Wait()
+		close(client.started)
+	}()
+
+	return client
+}
+
+func (c *runsClient) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	c.wg.Done()
+	<-c.started
+
+	contents, ok := c.logs[req.RunID]
+	if !ok {
+		return errors.New("run not found")
+	}
+
+	for _, content := range contents {
+		if err := (*callback)(&types.LogLine{JobName: "build", Content: content}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *runsClient) GetStatus(ctx context.Context, req *types.StatusRequest) (*types.Status, error) {
+	return &types.Status{RunID: req.RunID, Status: "completed", Conclusion: c.conclusion[req.RunID]}, nil
+}
+
+// Testing MultiplexLogs streams the runs concurrently and reports each of them
+func TestMultiplexLogs(t *testing.T) {
+	client := newRunsClient(3, map[int64][]string{
+		1: {"a1", "a2", "a3"},
+		2: {"b1", "b2"},
+	}, map[int64]string{1: "success", 2: "failure"})
+
+	streams := []*platforms.RunStream{
+		{Run: &types.Run{RunID: 1}, Name: "build"},
+		{Run: &types.Run{RunID: 2}, Name: "deploy"},
+		{Run: &types.Run{RunID: 3}, Name: "release"},
+	}
+
+	lines := make(map[string][]string)
+	var callback platforms.MultiplexCallback = func(stream *platforms.RunStream, line *types.LogLine) error {
+		lines[stream.Name] = append(lines[stream.Name], line.Content)
+		return nil
+	}
+
+	var done []string
+	results := platforms.MultiplexLogs(context.Background(), client, streams, types.LogsStreamRequest{Follow: true}, callback, func(result *platforms.StreamResult) {
+		done = append(done, result.Stream.Name)
+	})
+
+	// the lines of each run keep their order
+	assert.Equal(t, []string{"a1", "a2", "a3"}, lines["build"])
+	assert.Equal(t, []string{"b1", "b2"}, lines["deploy"])
+	assert.ElementsMatch(t, []string{"build", "deploy", "release"}, done)
+
+	require.Len(t, results, 3)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "success", results[0].Status.Conclusion)
+	assert.Equal(t, "failure", results[1].Status.Conclusion)
+
+	// a failed stream doesn't stop the others
+	assert.Error(t, results[2].Err)
+	assert.Same(t, streams[2], results[2].Stream)
+}
+
+// Testing MultiplexLogs stops a stream whose callback fails
+func TestMultiplexLogs_CallbackError(t *testing.T) {
+	client := newRunsClient(1, map[int64][]string{1: {"a1", "a2"}}, nil)
+
+	count := 0
+	var callback platforms.MultiplexCallback = func(stream *platforms.RunStream, line *types.LogLine) error {
+		count++
+		return errors.New("closed pipe")
+	}
+
+	results := platforms.MultiplexLogs(context.Background(), client, []*platforms.RunStream{{Run: &types.Run{RunID: 1}}}, types.LogsStreamRequest{}, callback, nil)
+
+	assert.Equal(t, 1, count)
+	require.Len(t, results, 1)
+	assert.EqualError(t, results[0].Err, "closed pipe")
+}
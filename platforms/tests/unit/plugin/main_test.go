@@ -0,0 +1,18 @@
+package plugin_test
+
+import (
+	"os"
+	"testing"
+
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/plugin"
+)
+
+// The test binary doubles as the mock plugin executable
+func TestMain(m *testing.M) {
+	if os.Getenv(mock.MOCK_PLUGIN_ENV_VAR) == "1" {
+		mock.ServeMockPlugin(os.Stdin, os.Stdout)
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
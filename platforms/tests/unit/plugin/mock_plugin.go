@@ -0,0 +1,122 @@
+package plugin
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/platforms/configurations/plugin"
+	"github.com/ignorant05/Uniflow/types"
+	"github.com/stretchr/testify/require"
+)
+
+// MOCK_PLUGIN_ENV_VAR makes the test binary serve the plugin protocol instead of running tests
+const MOCK_PLUGIN_ENV_VAR = "UNIFLOW_MOCK_PLUGIN"
+
+// Setting up a uniflow-platform-<name> executable (the test binary itself) on PATH
+func SetupTestPlugin(t *testing.T, name string) {
+	dir := t.TempDir()
+
+	binary, err := os.Executable()
+	require.NoError(t, err)
+
+	script := fmt.Sprintf("#!/bin/sh\n%s=1 exec %q\n", MOCK_PLUGIN_ENV_VAR, binary)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "uniflow-platform-"+name), []byte(script), 0755))
+
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// SLOW_RUN_ID is the run whose status takes a while (the other requests are answered meanwhile)
+const SLOW_RUN_ID = 1
+
+// ServeMockPlugin serves the plugin protocol until shutdown (or end of input)
+// NOTE: GetStatus requests are answered concurrently
+func ServeMockPlugin(in io.Reader, out io.Writer) {
+	reader := bufio.NewReader(in)
+
+	var mu sync.Mutex
+	encoder := json.NewEncoder(out)
+	encode := func(msg interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		_ = encoder.Encode(msg)
+	}
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+
+		var req struct {
+			ID     *int64          `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := json.Unmarshal(line, &req); err != nil {
+			return
+		}
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+
+		switch req.Method {
+		case "initialize":
+			var params plugin.InitializeParams
+			_ = json.Unmarshal(req.Params, &params)
+
+			if params.Config["fail"] == true {
+				resp["error"] = map[string]interface{}{"code": -32000, "message": "invalid configuration"}
+				break
+			}
+			resp["result"] = plugin.InitializeResult{Name: params.Platform, Version: "1.0.0"}
+		case "TriggerWorkflow":
+			var params types.TriggerRequest
+			_ = json.Unmarshal(req.Params, &params)
+
+			resp["result"] = types.TriggerResponse{RunID: 42, RunNumber: 7, Status: "queued", URL: "https://ci.company.com/" + params.WorkflowName + "/42"}
+		case "StreamLogs":
+			for _, content := range []string{"Building release", "ERROR: tests failed"} {
+				encode(map[string]interface{}{
+					"jsonrpc": "2.0",
+					"method":  "LogLine",
+					"params":  types.LogLine{Content: content, JobName: "build"},
+				})
+			}
+			resp["result"] = nil
+		case "GetStatus":
+			var params types.StatusRequest
+			_ = json.Unmarshal(req.Params, &params)
+
+			go func() {
+				if params.RunID == SLOW_RUN_ID {
+					time.Sleep(500 * time.Millisecond)
+				}
+
+				resp["result"] = types.Status{RunID: params.RunID, Status: "completed"}
+				encode(resp)
+			}()
+			continue
+		case "Cancel":
+			resp["error"] = map[string]interface{}{
+				"code":    -32000,
+				"message": "run 42 was not found",
+				"data":    types.PlatformError{Code: "not_found", StatusCode: 404},
+			}
+		case "shutdown":
+			resp["result"] = nil
+			encode(resp)
+			return
+		default:
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found: " + req.Method}
+		}
+
+		encode(resp)
+	}
+}
@@ -0,0 +1,161 @@
+package plugin_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/internal/config"
+	"github.com/ignorant05/Uniflow/platforms"
+	adapters "github.com/ignorant05/Uniflow/platforms/adapters"
+	mock "github.com/ignorant05/Uniflow/platforms/tests/unit/plugin"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pluginConfig(section config.PluginConfig) *config.Config {
+	return &config.Config{
+		DefaultPlatform: "inhouse",
+		Profiles:        map[string]*config.Profile{"default": {Plugins: map[string]config.PluginConfig{"inhouse": section}}},
+	}
+}
+
+// Testing plugins on PATH are supported platforms
+func TestPlugin_Discovery(t *testing.T) {
+	mock.SetupTestPlugin(t, "inhouse")
+
+	assert.True(t, platforms.IsPlatformSupported("inhouse"))
+	assert.Contains(t, platforms.ListSupportedPlatforms(), "inhouse")
+	assert.False(t, platforms.IsPlatformSupported("travis"))
+}
+
+// Testing config validation accepts a plugin as default platform
+func TestPlugin_Validate(t *testing.T) {
+	errs := pluginConfig(config.PluginConfig{"url": "https://ci.company.com"}).Validate()
+
+	assert.Empty(t, errs)
+}
+
+// Testing the factory falls back to the plugin and forwards requests
+func TestPlugin_TriggerWorkflow(t *testing.T) {
+	mock.SetupTestPlugin(t, "inhouse")
+
+	client, err := platforms.NewFactory(pluginConfig(nil)).CreateClientForProfile(context.Background(), "", "")
+	require.NoError(t, err)
+	require.IsType(t, &adapters.PluginAdapter{}, client)
+
+	adapter := client.(*adapters.PluginAdapter)
+	defer adapter.Client.Close()
+
+	assert.Equal(t, "inhouse", adapter.Client.Info.Name)
+
+	resp, err := adapter.TriggerWorkflow(context.Background(), &types.TriggerRequest{WorkflowName: "release"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), resp.RunID)
+	assert.Equal(t, "https://ci.company.com/release/42", resp.URL)
+}
+
+// Testing StreamLogs delivers LogLine notifications
+func TestPlugin_StreamLogs(t *testing.T) {
+	mock.SetupTestPlugin(t, "inhouse")
+
+	client, err := platforms.NewFactory(pluginConfig(nil)).CreateClientForProfile(context.Background(), "inhouse", "")
+	require.NoError(t, err)
+	defer client.(*adapters.PluginAdapter).Client.Close()
+
+	var lines []string
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line.JobName+": "+line.Content)
+		return nil
+	}
+
+	err = client.StreamLogs(context.Background(), &types.LogsStreamRequest{RunID: 42}, &callback)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"build: Building release", "build: ERROR: tests failed"}, lines)
+}
+
+// Testing the calls to a plugin don't wait for each other
+func TestPlugin_ConcurrentCalls(t *testing.T) {
+	mock.SetupTestPlugin(t, "inhouse")
+
+	client, err := platforms.NewFactory(pluginConfig(nil)).CreateClientForProfile(context.Background(), "inhouse", "")
+	require.NoError(t, err)
+	defer client.(*adapters.PluginAdapter).Client.Close()
+
+	var mu sync.Mutex
+	var order []int64
+	var wg sync.WaitGroup
+
+	for _, runID := range []int64{mock.SLOW_RUN_ID, 2, 3} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			status, err := client.GetStatus(context.Background(), &types.StatusRequest{RunID: runID})
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, runID, status.RunID, "the response of the request")
+
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, status.RunID)
+		}()
+
+		// the slow request is sent first
+		if runID == mock.SLOW_RUN_ID {
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+
+	var lines []string
+	var callback types.LogCallback = func(line *types.LogLine) error {
+		lines = append(lines, line.Content)
+		return nil
+	}
+	require.NoError(t, client.StreamLogs(context.Background(), &types.LogsStreamRequest{RunID: 42}, &callback))
+	assert.Len(t, lines, 2)
+
+	wg.Wait()
+
+	require.Len(t, order, 3)
+	assert.Equal(t, mock.SLOW_RUN_ID, int(order[2]), "the slow request doesn't hold the others")
+}
+
+// Testing plugin errors are converted into platform errors
+func TestPlugin_Errors(t *testing.T) {
+	mock.SetupTestPlugin(t, "inhouse")
+
+	client, err := platforms.NewFactory(pluginConfig(nil)).CreateClientForProfile(context.Background(), "inhouse", "")
+	require.NoError(t, err)
+	defer client.(*adapters.PluginAdapter).Client.Close()
+
+	var platformErr *types.PlatformError
+
+	err = client.Cancel(context.Background(), &types.Run{RunID: 42})
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_found", platformErr.Code)
+	assert.Equal(t, 404, platformErr.StatusCode)
+	assert.Equal(t, "inhouse", platformErr.Platform)
+
+	_, err = client.GetRepositoryInfo(context.Background())
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "not_supported", platformErr.Code)
+}
+
+// Testing a plugin failing to initialize
+func TestPlugin_InitializeFailure(t *testing.T) {
+	mock.SetupTestPlugin(t, "inhouse")
+
+	_, err := platforms.NewFactory(pluginConfig(config.PluginConfig{"fail": true})).CreateClientForProfile(context.Background(), "inhouse", "")
+
+	var platformErr *types.PlatformError
+	require.ErrorAs(t, err, &platformErr)
+	assert.Equal(t, "plugin_failed", platformErr.Code)
+	assert.Contains(t, platformErr.Message, "invalid configuration")
+}
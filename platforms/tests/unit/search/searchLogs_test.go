@@ -0,0 +1,114 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// logsClient is a platform client replaying the log lines of runs
+type logsClient struct {
+	platforms.PlatformClient
+
+	logs map[int64][]*types.LogLine
+
+	mu     sync.Mutex
+	active int
+	peak   int
+}
+
+func (c *logsClient) StreamLogs(ctx context.Context, req *types.LogsStreamRequest, callback *types.LogCallback) error {
+	c.mu.Lock()
+	c.active++
+	c.peak = max(c.peak, c.active)
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.active--
+		c.mu.Unlock()
+	}()
+
+	lines, ok := c.logs[req.RunID]
+	if !ok {
+		return errors.New("logs expired")
+	}
+
+	for _, line := range lines {
+		if err := (*callback)(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func lines(job, step string, contents ...string) []*types.LogLine {
+	var logLines []*types.LogLine
+	for _, content := range contents {
+		logLines = append(logLines, &types.LogLine{JobName: job, Step: step, Content: content})
+	}
+
+	return logLines
+}
+
+func contents(logLines []*types.LogLine) []string {
+	var result []string
+	for _, line := range logLines {
+		result = append(result, line.Content)
+	}
+
+	return result
+}
+
+// Testing SearchLogs reports the matches with their context, in run order
+func TestSearchLogs_Context(t *testing.T) {
+	runs := []*types.Run{{RunID: 2, RunNumber: 12}, {RunID: 1, RunNumber: 11}}
+	client := &logsClient{logs: map[int64][]*types.LogLine{
+		1: lines("test", "Run tests", "ok a", "FAIL b", "ok c"),
+		2: append(lines("build", "Build", "compile", "link"), lines("test", "Run tests", "setup", "FAIL a", "teardown")...),
+	}}
+
+	matches, failures := platforms.SearchLogs(context.Background(), client, runs, regexp.MustCompile("^FAIL"), platforms.SearchOptions{Before: 1, After: 2})
+
+	assert.Empty(t, failures)
+	require.Len(t, matches, 2)
+
+	assert.Equal(t, 12, matches[0].Run.RunNumber)
+	assert.Equal(t, "FAIL a", matches[0].Line.Content)
+	assert.Equal(t, "Run tests", matches[0].Line.Step)
+	// the context doesn't cross jobs
+	assert.Equal(t, []string{"setup"}, contents(matches[0].Before))
+	assert.Equal(t, []string{"teardown"}, contents(matches[0].After))
+
+	assert.Equal(t, 11, matches[1].Run.RunNumber)
+	assert.Equal(t, []string{"ok a"}, contents(matches[1].Before))
+	assert.Equal(t, []string{"ok c"}, contents(matches[1].After))
+}
+
+// Testing SearchLogs keeps searching when a run fails, with a bounded number of workers
+func TestSearchLogs_Failures(t *testing.T) {
+	var runs []*types.Run
+	client := &logsClient{logs: map[int64][]*types.LogLine{}}
+	for id := int64(1); id <= 10; id++ {
+		runs = append(runs, &types.Run{RunID: id, RunNumber: int(id)})
+		if id != 5 {
+			client.logs[id] = lines("test", "", "panic: nil map")
+		}
+	}
+
+	matches, failures := platforms.SearchLogs(context.Background(), client, runs, regexp.MustCompile("panic"), platforms.SearchOptions{Workers: 3})
+
+	assert.Len(t, matches, 9)
+	require.Len(t, failures, 1)
+	assert.Equal(t, 5, failures[0].Run.RunNumber)
+	assert.LessOrEqual(t, client.peak, 3)
+}
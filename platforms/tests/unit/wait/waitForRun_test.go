@@ -0,0 +1,63 @@
+package wait_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ignorant05/Uniflow/platforms"
+	"github.com/ignorant05/Uniflow/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusClient is a platform client replaying statuses
+type statusClient struct {
+	platforms.PlatformClient
+
+	statuses []*types.Status
+	requests []*types.StatusRequest
+}
+
+func (c *statusClient) GetStatus(ctx context.Context, req *types.StatusRequest) (*types.Status, error) {
+	c.requests = append(c.requests, req)
+
+	status := c.statuses[0]
+	if len(c.statuses) > 1 {
+		c.statuses = c.statuses[1:]
+	}
+
+	return status, nil
+}
+
+// Testing WaitForRun reports transitions until completion and pins the run
+func TestWaitForRun_Completed(t *testing.T) {
+	client := &statusClient{statuses: []*types.Status{
+		{RunID: 42, Status: "in_progress"},
+		{RunID: 42, Status: "completed", Conclusion: "failure"},
+	}}
+
+	var transitions []string
+	status, err := platforms.WaitForRun(context.Background(), client, &types.StatusRequest{Name: "deploy.yml"}, time.Minute, func(previous, current *types.Status) {
+		transitions = append(transitions, current.Status+"/"+current.Conclusion)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "failure", status.Conclusion)
+	assert.Equal(t, []string{"in_progress/", "completed/failure"}, transitions)
+	require.Len(t, client.requests, 2)
+	assert.Equal(t, int64(0), client.requests[0].RunID)
+	assert.Equal(t, int64(42), client.requests[1].RunID)
+}
+
+// Testing WaitForRun gives up after the timeout
+func TestWaitForRun_Timeout(t *testing.T) {
+	client := &statusClient{statuses: []*types.Status{{RunID: 42, Status: "queued"}}}
+
+	status, err := platforms.WaitForRun(context.Background(), client, &types.StatusRequest{RunID: 42}, 50*time.Millisecond, nil)
+
+	assert.ErrorIs(t, err, types.ErrTimeout)
+	require.NotNil(t, status)
+	assert.Equal(t, "queued", status.Status)
+}
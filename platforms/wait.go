@@ -0,0 +1,77 @@
+package platforms
+
+import (
+	"context"
+	"time"
+
+	"github.com/ignorant05/Uniflow/platforms/constants"
+	"github.com/ignorant05/Uniflow/types"
+)
+
+// TransitionCallback is called each time the status of a waited run changes (previous is nil on the first poll)
+type TransitionCallback func(previous, current *types.Status)
+
+// WaitForRun polls the status of a run (with backoff) until it completes
+//
+// Parameters:
+//   - ctx: the context variable
+//   - client: platform client
+//   - req: the run to wait for
+//   - timeout: maximum waiting time (0 waits forever)
+//   - onTransition: called on status changes (optional)
+//
+// Returns an error if:
+//   - the status can't be retrieved
+//   - the timeout is exceeded (types.ErrTimeout, with the last status)
+//
+// Example:
+// status, err := platforms.WaitForRun(ctx, client, &types.StatusRequest{RunID: 42}, 20*time.Minute, nil)
+func WaitForRun(ctx context.Context, client PlatformClient, req *types.StatusRequest, timeout time.Duration, onTransition TransitionCallback) (*types.Status, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var previous *types.Status
+	interval := constants.WAIT_INITIAL_INTERVAL
+
+	for {
+		current, err := client.GetStatus(ctx, req)
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return previous, types.ErrTimeout
+			}
+
+			return previous, err
+		}
+
+		if previous == nil || previous.Status != current.Status || previous.Conclusion != current.Conclusion {
+			if onTransition != nil {
+				onTransition(previous, current)
+			}
+		}
+		previous = current
+
+		// pinning the run, "latest" may be another run on the next poll
+		if req.RunID == 0 && current.RunID != 0 {
+			req = &types.StatusRequest{Name: req.Name, RunID: current.RunID}
+		}
+
+		if types.IsCompleted(current.Status) {
+			return current, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return previous, types.ErrTimeout
+			}
+
+			return previous, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		interval = min(time.Duration(float64(interval)*constants.WAIT_BACKOFF_FACTOR), constants.WAIT_MAX_INTERVAL)
+	}
+}
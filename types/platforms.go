@@ -34,6 +34,10 @@ type TriggerRequest struct {
 
 	// Timeout indicates the timeout you want to set (maximum waiting time)
 	Timeout time.Duration
+
+	// CorrelationInput is the name of a workflow input receiving a unique correlation ID (optional)
+	// NOTE: the workflow should use it in its run-name, so the dispatched run can be told apart
+	CorrelationInput string
 }
 
 type TriggerResponse struct {
@@ -52,6 +56,9 @@ type TriggerResponse struct {
 
 	// QueuedAt is for when the run is queued
 	QueuedAt time.Time
+
+	// CorrelationID is the correlation ID injected in the inputs (if requested)
+	CorrelationID string
 }
 
 type StatusRequest struct {
@@ -87,6 +94,9 @@ type Status struct {
 	// URL is the web-URL to view the run
 	URL string
 
+	// Branch is the git reference the run ran on (if the platform reports it)
+	Branch string
+
 	// QueuedAt is for when the run is queued
 	QueuedAt time.Time
 
@@ -108,6 +118,9 @@ type Run struct {
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	URL         string
+
+	// WorkflowName is the name of the run's workflow (empty if the platform doesn't report it)
+	WorkflowName string
 }
 
 type ListWorkflowsRequest struct {
@@ -134,6 +147,9 @@ type ListWorkflowRunsRequest struct {
 }
 
 type ListWokflowJobsRequest struct {
+	// RunID is the run whose jobs are listed (optional, platforms fall back to the latest run)
+	RunID int64
+
 	// WorkflowName is the name or path of the workflow you want to trigger
 	// Example: "deploy.yaml"
 	WorkflowName string
@@ -168,6 +184,135 @@ type LogsStreamRequest struct {
 	// Tail retusn only the N lines of logs
 	// Example: "tail 10" returns only the last 10 logs
 	Tail int
+
+	// JobIDs limits the logs to these jobs (optional, all jobs if empty)
+	JobIDs []int64
+
+	// WorkflowName is the workflow (or job) of the run, for platforms numbering runs per job (optional)
+	// Example: Jenkins falls back to the configured job_name
+	WorkflowName string
+
+	// Branch is the branch of a multibranch job (optional)
+	Branch string
+}
+
+// RerunRequest contains parameters for rerunning a run.
+type RerunRequest struct {
+	// RunID is the run to rerun
+	RunID int64
+
+	// WorkflowName is the workflow of the run (required by platforms numbering the runs per workflow, eg: jenkins jobs)
+	WorkflowName string
+
+	// FailedOnly only reruns the failed jobs (and the jobs depending on them)
+	FailedOnly bool
+
+	// Debug enables debug logging for the rerun (if the platform supports it)
+	Debug bool
+}
+
+// RerunResponse contains the rerun information.
+type RerunResponse struct {
+	// RunID is the rerun (the same run on platforms rerunning in place)
+	RunID int64
+
+	RunNumber int
+
+	// URL is the web-URL to view the rerun (optional)
+	URL string
+}
+
+// PendingApproval is a run waiting for a review before going on (eg: deployment to a protected environment).
+type PendingApproval struct {
+	RunID     int64
+	RunNumber int
+
+	WorkflowName string
+	Branch       string
+
+	// Environment is what the approval is for (eg: github environment, jenkins input step, gitlab manual job)
+	Environment string
+
+	// Reviewers are the users and teams allowed to review
+	Reviewers []string
+
+	// CanApprove tells whether the authenticated user is one of the reviewers
+	CanApprove bool
+
+	// WaitingSince is when the run started waiting (zero if unknown)
+	WaitingSince time.Time
+
+	// URL is the web-URL to review the run (optional)
+	URL string
+}
+
+// ListApprovalsRequest contains parameters for listing pending approvals.
+type ListApprovalsRequest struct {
+	// Name is the workflow of the runs (optional)
+	Name string
+
+	// RunID only lists the approvals of a run (optional)
+	RunID int64
+}
+
+// ReviewApprovalRequest contains parameters for approving or rejecting pending approvals.
+type ReviewApprovalRequest struct {
+	RunID int64
+
+	// Environments are the reviewed environments (all the pending ones if empty)
+	Environments []string
+
+	// Approve approves the environments (rejects them otherwise)
+	Approve bool
+
+	Comment string
+}
+
+// Artifact is a file (or archive of files) produced by a run.
+type Artifact struct {
+	ID   int64
+	Name string
+
+	// Size is the artifact size in bytes (0 if unknown)
+	Size int64
+
+	// Expired artifacts can't be downloaded anymore
+	Expired bool
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// ListArtifactsRequest contains parameters for listing the artifacts of a run.
+type ListArtifactsRequest struct {
+	RunID int64
+}
+
+// DownloadArtifactRequest contains parameters for downloading an artifact.
+type DownloadArtifactRequest struct {
+	RunID    int64
+	Artifact *Artifact
+
+	// Dest is the destination directory (the current directory if empty)
+	Dest string
+
+	// Extract unpacks the artifact into Dest/<name> (the archive isn't kept)
+	Extract bool
+
+	// Progress reports the downloaded bytes (optional)
+	Progress func(downloaded int64) `json:"-"`
+}
+
+// DownloadArtifactResponse contains the downloaded artifact information.
+type DownloadArtifactResponse struct {
+	// Path is the downloaded archive, or the extracted directory
+	Path string
+
+	// Size is the downloaded size in bytes
+	Size int64
+
+	// Files is the number of extracted files (with Extract)
+	Files int
 }
 
 type LogsRequest struct {
@@ -178,6 +323,9 @@ type LogsRequest struct {
 
 	DownloadPath string
 
+	// Extract unpacks the downloaded archive into a directory, with an index (if the platform downloads an archive)
+	Extract bool
+
 	// Tail retusn only the N lines of logs
 	// Example: "tail 10" returns only the last 10 logs
 	Tail int
@@ -189,6 +337,18 @@ type LogsResponse struct {
 
 	// URL is the web-URL to view logs
 	URL string
+
+	// Path is where the logs were saved (file, archive or directory)
+	Path string
+
+	// Archive is the downloaded archive, when it's kept next to the extracted Path
+	Archive string
+
+	// Files are the files written by the download (the only ones redacted in place)
+	Files []string
+
+	// Size is the downloaded size in bytes
+	Size int64
 }
 
 type StreamLogsRequest struct {
@@ -215,8 +375,42 @@ type LogLine struct {
 	// JobName is the job that generated this line
 	JobName string
 
+	// JobID is the ID of the job that generated this line, as listed by ListWorkflowJobs (0 if unknown)
+	JobID int64
+
 	// Level is the log level (info, error, etc...)
 	Level string
+
+	// Step is the step that generated this line (if the platform reports steps)
+	Step string
+
+	// Group is the title of the collapsible group this line belongs to (if any)
+	Group string
+
+	// Marker is set on group boundaries (LOG_GROUP_START opens Group, LOG_GROUP_END closes it)
+	Marker string
+
+	// Annotation is set on annotated lines (eg: "::error file=main.go,line=3::message")
+	Annotation *LogAnnotation
+}
+
+// Log line markers
+const (
+	LOG_GROUP_START = "group"
+	LOG_GROUP_END   = "endgroup"
+)
+
+// LogAnnotation is a message attached to a source location
+type LogAnnotation struct {
+	// Level is one of: error, warning, notice
+	Level string
+
+	Title string
+
+	// File, Line and Column locate the annotation (optional)
+	File   string
+	Line   int
+	Column int
 }
 
 // Workflow represents an available workflow/pipeline/job.
@@ -240,6 +434,20 @@ type Workflow struct {
 	WithDispatch bool
 }
 
+// WorkflowInput represents an input declared by a workflow (eg: on.workflow_dispatch.inputs)
+type WorkflowInput struct {
+	Name        string
+	Description string
+
+	// Type is one of: string, boolean, number, choice, environment (default: string)
+	Type     string
+	Required bool
+	Default  interface{}
+
+	// Options are the allowed values of a choice input
+	Options []string
+}
+
 // WorkflowJob represents a repository action workflow job
 type WorkflowJob struct {
 	ID           int64
@@ -251,6 +459,21 @@ type WorkflowJob struct {
 	RunURL       string
 	URL          string
 	HTMLURL      string
+
+	// Steps are the job steps, in execution order (if the platform reports them)
+	Steps []*WorkflowStep
+
+	// StartedAt and CompletedAt time the job (zero if unknown, or not started or completed yet)
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+// WorkflowStep represents a step of a workflow job
+type WorkflowStep struct {
+	Number     int64
+	Name       string
+	Status     string
+	Conclusion string
 }
 
 var (